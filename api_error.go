@@ -0,0 +1,178 @@
+package celcoin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/contbank/grok"
+	"github.com/tidwall/gjson"
+)
+
+// ErrorClass ... indica se é seguro repetir a operação que falhou
+type ErrorClass string
+
+const (
+	// ErrorClassRetryable ... a Celcoin não processou a requisição; ela pode ser repetida
+	ErrorClassRetryable ErrorClass = "RETRYABLE"
+	// ErrorClassTerminal ... a requisição foi recusada; repeti-la sem alterações falhará novamente
+	ErrorClassTerminal ErrorClass = "TERMINAL"
+	// ErrorClassAmbiguous ... não é possível saber se a Celcoin processou a operação (ex.: timeout em um cash-out).
+	// Consulte o status da operação antes de repetir, ou repita com a mesma chave de idempotência.
+	ErrorClassAmbiguous ErrorClass = "AMBIGUOUS"
+)
+
+// celcoinErrorPaths ... caminhos do código e da mensagem de erro nos formatos de resposta da Celcoin
+var celcoinErrorPaths = [][2]string{
+	{"error.errorCode", "error.message"},
+	{"erro.errorCode", "erro.message"},
+	{"errors.0.code", "errors.0.messages.0"},
+	{"errorCode", "message"},
+	{"code", "message"},
+}
+
+// CelcoinAPIError ... detalhes do erro de uma operação dos serviços. Preserva o código e a mensagem originais
+// da Celcoin e desembrulha para o *grok.Error mapeado (errors.As/errors.Is).
+// Por padrão as operações retornam o próprio *grok.Error e os detalhes ficam em ResponseMeta.Error; com
+// Config.WrapErrors o erro retornado é o *CelcoinAPIError. O grok.ResolveError compara o tipo exato, então
+// use GrokError antes de repassá-lo.
+type CelcoinAPIError struct {
+	Service   string
+	Operation string
+	Method    string
+	// StatusCode ... status HTTP da última resposta da Celcoin; zero quando não houve resposta
+	StatusCode int
+	// CelcoinCode e CelcoinMessage ... errorCode e mensagem originais da resposta da Celcoin
+	CelcoinCode    string
+	CelcoinMessage string
+	RequestID      string
+	Class          ErrorClass
+	Err            error
+}
+
+// Error ...
+func (e *CelcoinAPIError) Error() string {
+	message := fmt.Sprintf("celcoin %s.%s: %v", e.Service, e.Operation, e.Err)
+	if len(e.CelcoinCode) > 0 {
+		message += fmt.Sprintf(" (celcoin error %s: %s)", e.CelcoinCode, e.CelcoinMessage)
+	}
+	return message
+}
+
+// Unwrap ...
+func (e *CelcoinAPIError) Unwrap() error {
+	return e.Err
+}
+
+// GrokError ... erro Contbank mapeado, ou nil quando a falha não veio de um *grok.Error (ex.: erro de rede)
+func (e *CelcoinAPIError) GrokError() *grok.Error {
+	var grokErr *grok.Error
+	if errors.As(e.Err, &grokErr) {
+		return grokErr
+	}
+	return nil
+}
+
+// Retryable ...
+func (e *CelcoinAPIError) Retryable() bool {
+	return e.Class == ErrorClassRetryable
+}
+
+// Terminal ...
+func (e *CelcoinAPIError) Terminal() bool {
+	return e.Class == ErrorClassTerminal
+}
+
+// Ambiguous ... a operação pode ter sido efetivada apesar do erro
+func (e *CelcoinAPIError) Ambiguous() bool {
+	return e.Class == ErrorClassAmbiguous
+}
+
+// ClassifyError ... classificação do erro. Sem Config.WrapErrors o erro retornado não carrega a chamada HTTP:
+// exceto pelos erros que indicam que nada foi enviado (circuito aberto, rate limit, falhas de token), ele é
+// classificado como AMBIGUOUS, pois pode vir de um POST que a Celcoin processou. Prefira ResponseMeta.Error.Class,
+// que considera o método e o status.
+func ClassifyError(err error) ErrorClass {
+	var apiErr *CelcoinAPIError
+	if errors.As(err, &apiErr) {
+		return apiErr.Class
+	}
+	if class, ok := classifyUnsentError(err); ok {
+		return class
+	}
+	return ErrorClassAmbiguous
+}
+
+// classifyError ... sent indica que ao menos uma requisição HTTP foi enviada à Celcoin
+func classifyError(method string, statusCode int, sent bool, err error) ErrorClass {
+	if class, ok := classifyUnsentError(err); ok {
+		return class
+	}
+
+	if !sent {
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return ErrorClassRetryable
+		}
+		return ErrorClassTerminal
+	}
+
+	safe := method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions
+	switch {
+	case statusCode == 0:
+		// Sem resposta: a requisição pode ter chegado à Celcoin
+		if safe {
+			return ErrorClassRetryable
+		}
+		return ErrorClassAmbiguous
+	case statusCode == http.StatusTooManyRequests || statusCode == http.StatusServiceUnavailable:
+		return ErrorClassRetryable
+	case statusCode == http.StatusRequestTimeout || statusCode == http.StatusInternalServerError ||
+		statusCode == http.StatusBadGateway || statusCode == http.StatusGatewayTimeout:
+		if safe {
+			return ErrorClassRetryable
+		}
+		return ErrorClassAmbiguous
+	case statusCode < http.StatusBadRequest:
+		// A Celcoin aceitou a requisição, mas a resposta não pôde ser tratada
+		if safe {
+			return ErrorClassTerminal
+		}
+		return ErrorClassAmbiguous
+	}
+	return ErrorClassTerminal
+}
+
+// classifyUnsentError ... erros que por si só indicam se a requisição chegou à Celcoin
+func classifyUnsentError(err error) (ErrorClass, bool) {
+	if errors.Is(err, ErrCircuitOpen) || errors.Is(err, ErrRateLimited) {
+		return ErrorClassRetryable, true
+	}
+
+	var transportErr *TransportError
+	if errors.As(err, &transportErr) {
+		switch transportErr.Step {
+		case TransportStepRequestToken:
+			return ErrorClassRetryable, true
+		case TransportStepTokenRejected:
+			if transportErr.StatusCode == http.StatusTooManyRequests || transportErr.StatusCode >= http.StatusInternalServerError {
+				return ErrorClassRetryable, true
+			}
+		}
+		return ErrorClassTerminal, true
+	}
+	return "", false
+}
+
+// celcoinErrorFromBody ... errorCode e mensagem originais de uma resposta de erro da Celcoin
+func celcoinErrorFromBody(body []byte) (string, string) {
+	if !gjson.ValidBytes(body) {
+		return "", ""
+	}
+	for _, paths := range celcoinErrorPaths {
+		if code := gjson.GetBytes(body, paths[0]).String(); len(code) > 0 {
+			return code, gjson.GetBytes(body, paths[1]).String()
+		}
+	}
+	return "", ""
+}
@@ -0,0 +1,218 @@
+package celcoin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/contbank/grok"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+// APIErrorTestSuite ...
+type APIErrorTestSuite struct {
+	suite.Suite
+	assert *assert.Assertions
+	status int
+	body   string
+	server *httptest.Server
+}
+
+// TestAPIErrorTestSuite ...
+func TestAPIErrorTestSuite(t *testing.T) {
+	suite.Run(t, new(APIErrorTestSuite))
+}
+
+// SetupTest ...
+func (s *APIErrorTestSuite) SetupTest() {
+	s.assert = assert.New(s.T())
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(s.status)
+		w.Write([]byte(s.body))
+	}))
+}
+
+// TearDownTest ...
+func (s *APIErrorTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+// balance ...
+func (s *APIErrorTestSuite) balance(wrapErrors bool) BalanceInterface {
+	return NewBalance(http.DefaultClient, Session{APIEndpoint: s.server.URL, Logger: NewNopLogger(), WrapErrors: wrapErrors})
+}
+
+// TestReturnsGrokError ...
+func (s *APIErrorTestSuite) TestReturnsGrokError() {
+	s.status = http.StatusBadRequest
+	s.body = `{"status":"ERROR","version":"1.0.0","error":{"errorCode":"CBE999","message":"Conta inválida"}}`
+	var meta ResponseMeta
+	ctx := WithResponseMeta(WithRequestID(context.Background(), "request-123"), &meta)
+
+	_, err := s.balance(false).Balance(ctx, "123456")
+	s.Require().Error(err)
+
+	// O erro mantém o tipo retornado pelo serviço
+	celcoinErr, ok := err.(*Error)
+	s.Require().True(ok, "erro deve ser do tipo *Error")
+
+	apiErr := meta.Error
+	s.Require().NotNil(apiErr)
+	s.assert.Equal("balance", apiErr.Service)
+	s.assert.Equal("Balance", apiErr.Operation)
+	s.assert.Equal(http.MethodGet, apiErr.Method)
+	s.assert.Equal(http.StatusBadRequest, apiErr.StatusCode)
+	s.assert.Equal("CBE999", apiErr.CelcoinCode)
+	s.assert.Equal("Conta inválida", apiErr.CelcoinMessage)
+	s.assert.Equal("request-123", apiErr.RequestID)
+	s.assert.True(apiErr.Terminal())
+	s.assert.Same(celcoinErr.GrokError, apiErr.GrokError())
+}
+
+// TestWrapErrors ...
+func (s *APIErrorTestSuite) TestWrapErrors() {
+	s.status = http.StatusBadRequest
+	s.body = `{"status":"ERROR","version":"1.0.0","error":{"errorCode":"CBE999","message":"Conta inválida"}}`
+	ctx := WithRequestID(context.Background(), "request-123")
+
+	_, err := s.balance(true).Balance(ctx, "123456")
+	s.Require().Error(err)
+
+	var apiErr *CelcoinAPIError
+	s.Require().True(errors.As(err, &apiErr))
+	s.assert.Equal("CBE999", apiErr.CelcoinCode)
+	s.assert.Equal("request-123", apiErr.RequestID)
+	s.assert.Equal(ErrorClassTerminal, ClassifyError(err))
+	s.assert.Contains(err.Error(), "CBE999")
+
+	var grokErr *grok.Error
+	s.Require().True(errors.As(err, &grokErr))
+	s.assert.Same(grokErr, apiErr.GrokError())
+}
+
+// TestErrorsIs ...
+func (s *APIErrorTestSuite) TestErrorsIs() {
+	s.status = http.StatusNotFound
+	s.body = `{}`
+
+	var meta ResponseMeta
+	_, err := s.balance(false).Balance(WithResponseMeta(context.Background(), &meta), "123456")
+	s.assert.ErrorIs(err, ErrEntryNotFound)
+	s.Require().NotNil(meta.Error)
+	s.assert.Equal(ErrorClassTerminal, meta.Error.Class)
+	// Sem WrapErrors o erro não carrega a chamada HTTP
+	s.assert.Equal(ErrorClassAmbiguous, ClassifyError(err))
+}
+
+// TestRetryableServerError ...
+func (s *APIErrorTestSuite) TestRetryableServerError() {
+	s.status = http.StatusInternalServerError
+	s.body = `{"status":"ERROR","error":{"errorCode":"CBE001","message":"erro interno"}}`
+	var meta ResponseMeta
+
+	_, err := s.balance(false).Balance(WithResponseMeta(context.Background(), &meta), "123456")
+	s.Require().Error(err)
+	s.Require().NotNil(meta.Error)
+	s.assert.Equal(ErrorClassRetryable, meta.Error.Class)
+}
+
+// TestValidationErrorIsTerminal ...
+func (s *APIErrorTestSuite) TestValidationErrorIsTerminal() {
+	var meta ResponseMeta
+	_, err := NewStatement(http.DefaultClient, Session{APIEndpoint: "://invalid", Logger: NewNopLogger()}).
+		GetStatements(WithResponseMeta(context.Background(), &meta), nil)
+	s.Require().Error(err)
+
+	s.Require().NotNil(meta.Error)
+	s.assert.Zero(meta.Error.StatusCode)
+	s.assert.True(meta.Error.Terminal())
+}
+
+// TestClassifyError ...
+func TestClassifyError(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		statusCode int
+		sent       bool
+		err        error
+		expected   ErrorClass
+	}{
+		{"circuit open", http.MethodPost, 0, false, &CircuitOpenError{Family: "/baas/v2"}, ErrorClassRetryable},
+		{"rate limited", http.MethodPost, 0, false, ErrRateLimited, ErrorClassRetryable},
+		{"token request failed", http.MethodPost, 0, false, newTransportError(TransportStepRequestToken, errors.New("eof")), ErrorClassRetryable},
+		{"token rejected", http.MethodPost, 0, false, &TransportError{Step: TransportStepTokenRejected, StatusCode: http.StatusUnauthorized}, ErrorClassTerminal},
+		{"token server error", http.MethodPost, 0, false, &TransportError{Step: TransportStepTokenRejected, StatusCode: http.StatusBadGateway}, ErrorClassRetryable},
+		{"validation", "", 0, false, grok.NewError(http.StatusBadRequest, "INVALID", "invalid"), ErrorClassTerminal},
+		{"canceled before sending", "", 0, false, context.Canceled, ErrorClassRetryable},
+		{"get without response", http.MethodGet, 0, true, context.DeadlineExceeded, ErrorClassRetryable},
+		{"post without response", http.MethodPost, 0, true, context.DeadlineExceeded, ErrorClassAmbiguous},
+		{"post too many requests", http.MethodPost, http.StatusTooManyRequests, true, ErrDefaultPix, ErrorClassRetryable},
+		{"post service unavailable", http.MethodPost, http.StatusServiceUnavailable, true, ErrDefaultPix, ErrorClassRetryable},
+		{"post internal error", http.MethodPost, http.StatusInternalServerError, true, ErrDefaultPix, ErrorClassAmbiguous},
+		{"post gateway timeout", http.MethodPost, http.StatusGatewayTimeout, true, ErrDefaultPix, ErrorClassAmbiguous},
+		{"get gateway timeout", http.MethodGet, http.StatusGatewayTimeout, true, ErrDefaultPix, ErrorClassRetryable},
+		{"post bad request", http.MethodPost, http.StatusBadRequest, true, ErrDefaultPix, ErrorClassTerminal},
+		{"post undecodable success", http.MethodPost, http.StatusOK, true, errors.New("unexpected EOF"), ErrorClassAmbiguous},
+		{"get undecodable success", http.MethodGet, http.StatusOK, true, errors.New("unexpected EOF"), ErrorClassTerminal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, classifyError(tt.method, tt.statusCode, tt.sent, tt.err))
+		})
+	}
+}
+
+// TestClassifyErrorWithoutCallDetails ...
+func TestClassifyErrorWithoutCallDetails(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected ErrorClass
+	}{
+		{"circuit open", &CircuitOpenError{Family: "/baas/v2"}, ErrorClassRetryable},
+		{"rate limited", ErrRateLimited, ErrorClassRetryable},
+		{"token rejected", &TransportError{Step: TransportStepTokenRejected, StatusCode: http.StatusUnauthorized}, ErrorClassTerminal},
+		{"grok error", ErrDefaultPix, ErrorClassAmbiguous},
+		{"url error", &url.Error{Op: "Post", URL: "https://celcoin", Err: context.DeadlineExceeded}, ErrorClassAmbiguous},
+		{"deadline exceeded", context.DeadlineExceeded, ErrorClassAmbiguous},
+		{"wrapped", &CelcoinAPIError{Class: ErrorClassTerminal, Err: ErrDefaultPix}, ErrorClassTerminal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ClassifyError(tt.err))
+		})
+	}
+}
+
+// TestCelcoinErrorFromBody ...
+func TestCelcoinErrorFromBody(t *testing.T) {
+	tests := []struct {
+		body    string
+		code    string
+		message string
+	}{
+		{`{"status":"ERROR","error":{"errorCode":"CBE073","message":"conta obrigatória"}}`, "CBE073", "conta obrigatória"},
+		{`{"status":400,"erro":{"errorCode":"DDA001","message":"usuário inválido"}}`, "DDA001", "usuário inválido"},
+		{`{"errors":[{"code":"PIX01","messages":["chave inválida"]}]}`, "PIX01", "chave inválida"},
+		{`{"code":"CIE001","message":"erro"}`, "CIE001", "erro"},
+		{`{"status":"ERROR"}`, "", ""},
+		{`<html>bad gateway</html>`, "", ""},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprint(i), func(t *testing.T) {
+			code, message := celcoinErrorFromBody([]byte(tt.body))
+			assert.Equal(t, tt.code, code)
+			assert.Equal(t, tt.message, message)
+		})
+	}
+}
@@ -24,37 +24,39 @@ func NewAuthentication(
 	session Session) *Authentication {
 	return &Authentication{
 		session:    session,
-		httpClient: NewLoggingHTTPClient(httpClient),
+		httpClient: newSessionLoggingHTTPClient(httpClient, session),
 	}
 }
 
-func (a *Authentication) login(ctx context.Context) (*AuthenticationResponse, error) {
-	u, err := url.Parse(a.session.LoginEndpoint)
+func (a *Authentication) login(ctx context.Context, session Session) (*AuthenticationResponse, error) {
+	u, err := url.Parse(session.LoginEndpoint)
 	if err != nil {
 		return nil, err
 	}
 
-	if a.session.Mtls {
+	if session.Mtls {
 		u.Path = path.Join(u.Path, LoginMtlsPath)
 	} else {
 		u.Path = path.Join(u.Path, LoginPath)
 	}
 	endpoint := u.String()
 
+	clientID, clientSecret := session.clientCredentials()
 	formData := url.Values{
 		"grant_type": {"client_credentials"},
-		"client_id":  {a.session.ClientID},
+		"client_id":  {clientID},
 	}
 
-	if !a.session.Mtls {
-		formData.Add("client_secret", a.session.ClientSecret)
+	if !session.Mtls {
+		formData.Add("client_secret", clientSecret)
 	}
 
-	if len(a.session.Scopes) > 0 {
-		formData.Add("scope", a.session.Scopes)
+	if len(session.Scopes) > 0 {
+		formData.Add("scope", session.Scopes)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, strings.NewReader(formData.Encode()))
+	// O endpoint de token não usa o token do transporte OAuth do httpClient
+	req, err := http.NewRequestWithContext(withoutAuthorization(ctx), "POST", endpoint, strings.NewReader(formData.Encode()))
 	if err != nil {
 		return nil, err
 	}
@@ -101,20 +103,29 @@ func (a *Authentication) login(ctx context.Context) (*AuthenticationResponse, er
 	return nil, ErrDefaultLogin
 }
 
-// Token ...
+// Token ... retorna o token do TokenStore da sessão, compartilhado com o transporte OAuth2.
+// Em sessões com CredentialResolver, o token é o do tenant do contexto.
 func (a Authentication) Token(ctx context.Context) (string, error) {
-	if token, found := a.session.Cache.Get("token"); found {
-		return token.(string), nil
+	session := a.session
+	if session.CredentialResolver != nil {
+		tenant, credentials, err := session.resolveTenant(ctx)
+		if err != nil {
+			return "", err
+		}
+		session = *session.tenantSession(tenant, *credentials)
 	}
 
-	response, err := a.login(ctx)
+	token, err := obtainToken(ctx, session.logger("authentication"), session.tokenStore(), tokenStoreKey(&session), 10*time.Second,
+		func() (string, time.Time, error) {
+			response, err := a.login(ctx, session)
+			if err != nil {
+				return "", time.Time{}, err
+			}
+			return response.AccessToken, time.Now().Add(time.Second * time.Duration(response.ExpiresIn)), nil
+		})
 	if err != nil {
 		return "", err
 	}
 
-	duration := time.Second * time.Duration(int64(response.ExpiresIn-10))
-	bearerToken := fmt.Sprintf("%s %s", "Bearer", response.AccessToken)
-	a.session.Cache.Set("token", bearerToken, duration)
-
-	return bearerToken, nil
+	return fmt.Sprintf("%s %s", "Bearer", token.AccessToken), nil
 }
@@ -7,10 +7,13 @@ import (
 	"net/http"
 	"net/url"
 	"path"
-
-	"github.com/sirupsen/logrus"
 )
 
+// BalanceInterface define a interface para a consulta de saldo.
+type BalanceInterface interface {
+	Balance(ctx context.Context, accountNumber string) (*BalanceResponse, error)
+}
+
 // Balance ...
 type Balance struct {
 	session        Session
@@ -18,27 +21,31 @@ type Balance struct {
 	httpClient     *LoggingHTTPClient
 }
 
+var _ BalanceInterface = (*Balance)(nil)
+
 // NewBalance ...
-func NewBalance(httpClient *http.Client, session Session) *Balance {
+func NewBalance(httpClient *http.Client, session Session) BalanceInterface {
 	return &Balance{
 		session:        session,
-		httpClient:     NewLoggingHTTPClient(httpClient),
+		httpClient:     newSessionLoggingHTTPClient(httpClient, session),
 		authentication: NewAuthentication(httpClient, session),
 	}
 }
 
 // Balance ...
-func (c *Balance) Balance(ctx context.Context, accountNumber string) (*BalanceResponse, error) {
-	requestID, _ := ctx.Value("Request-Id").(string)
-	fields := logrus.Fields{
+func (c *Balance) Balance(ctx context.Context, accountNumber string) (_ *BalanceResponse, err error) {
+	ctx, op := c.session.startOperation(ctx, "balance", "Balance", BalancePath)
+	defer op.end(&err)
+	logger := op.logger
+	requestID := RequestIDFrom(ctx)
+	fields := Fields{
 		"request_id": requestID,
 		"interface":  "Balance",
-		"service":    "Balance",
 	}
 
 	u, err := url.Parse(c.session.APIEndpoint)
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error parsing api endpoint")
 		return nil, err
 	}
@@ -52,19 +59,19 @@ func (c *Balance) Balance(ctx context.Context, accountNumber string) (*BalanceRe
 	u.RawQuery = q.Encode()
 	endpoint := u.String()
 
-	logrus.WithFields(fields).WithField("celcoin_endpoint", endpoint).
+	logger.WithFields(fields).WithField("celcoin_endpoint", endpoint).
 		Info("requesting balance")
 
 	req, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error new request")
 		return nil, err
 	}
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error http client")
 		return nil, err
 	}
@@ -77,12 +84,12 @@ func (c *Balance) Balance(ctx context.Context, accountNumber string) (*BalanceRe
 		var response *BalanceResponse
 
 		if err := json.Unmarshal(respBody, &response); err != nil {
-			logrus.WithFields(fields).WithError(err).
+			logger.WithFields(fields).WithError(err).
 				Error("error decoding json response")
 			return nil, ErrDefaultBalance
 		}
 
-		logrus.WithFields(fields).WithField("celcoin_response", response).
+		logger.WithFields(fields).WithField("celcoin_response", response).
 			Info("received celcoin response")
 
 		return response, nil
@@ -94,14 +101,14 @@ func (c *Balance) Balance(ctx context.Context, accountNumber string) (*BalanceRe
 
 	var errResponse *ErrorDefaultResponse
 	if err := json.Unmarshal(respBody, &errResponse); err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error decoding json response")
 		return nil, ErrDefaultBalance
 	}
 
 	if errResponse.Error != nil {
 		err := FindBalanceError(*errResponse.Error.ErrorCode, *errResponse.Error.Message)
-		logrus.WithField("celcoin_error", errResponse.Error).WithFields(fields).WithError(err).
+		logger.WithField("celcoin_error", errResponse.Error).WithField("celcoin_status", resp.StatusCode).WithFields(fields).WithError(err).
 			Error("celcoin get balance error")
 		return nil, err
 	}
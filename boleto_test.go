@@ -11,6 +11,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/contbank/celcoin-sdk"
+	"github.com/contbank/grok"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
@@ -38,7 +39,7 @@ type BoletoTestSuite struct {
 	client        *http.Client
 
 	session *celcoin.Session
-	boletos *celcoin.Boletos
+	boletos celcoin.BoletosInterface
 }
 
 func TestBoletoTestSuite(t *testing.T) {
@@ -202,3 +203,49 @@ func (s *BoletoTestSuite) TestCreateQueryDownloadCancel() {
 	err = s.boletos.CancelBoleto(s.ctx, queryResult.TransactionID, "Cliente desistiu do contrato.")
 	s.assert.NoError(err)
 }
+
+// TestBoletoErrorsUseCatalog ...
+func (s *BoletoTestSuite) TestBoletoErrorsUseCatalog() {
+	errorBody := []byte(`{"version":"1.0.0","status":"ERROR","error":{"errorCode":"CSE002","message":"Não foi encontrado registro para o identificador informado."}}`)
+	for i := 0; i < 4; i++ {
+		s.mockTransport.On("RoundTrip", mock.Anything).Return(&http.Response{
+			StatusCode: http.StatusBadRequest,
+			Body:       ioutil.NopCloser(bytes.NewReader(errorBody)),
+		}, nil).Once()
+	}
+
+	var meta celcoin.ResponseMeta
+	ctx := celcoin.WithResponseMeta(s.ctx, &meta)
+
+	_, err := s.boletos.QueryBoleto(ctx, "5a0f8148-03cb-430a-aec9-558e83e17352")
+	s.assertChargeNotFound(err)
+	s.Require().NotNil(meta.Error)
+	s.assert.Equal(http.StatusBadRequest, meta.StatusCode)
+	s.assert.Equal("CSE002", meta.Error.CelcoinCode)
+	s.assert.Equal(celcoin.ErrorClassTerminal, meta.Error.Class)
+
+	s.assertChargeNotFound(s.boletos.CancelBoleto(ctx, "5a0f8148-03cb-430a-aec9-558e83e17352", "Cliente desistiu do contrato."))
+	s.assertChargeNotFound(s.boletos.DownloadBoletoPDF(ctx, "5a0f8148-03cb-430a-aec9-558e83e17352", &bytes.Buffer{}))
+	_, err = s.boletos.GetCharge(ctx, &celcoin.ChargeRequest{TransactionID: celcoin.String("5a0f8148-03cb-430a-aec9-558e83e17352")})
+	s.assertChargeNotFound(err)
+}
+
+// TestBoletoErrorWithoutCode ...
+func (s *BoletoTestSuite) TestBoletoErrorWithoutCode() {
+	s.mockTransport.On("RoundTrip", mock.Anything).Return(&http.Response{
+		StatusCode: http.StatusBadGateway,
+		Body:       ioutil.NopCloser(bytes.NewReader([]byte(`<html>bad gateway</html>`))),
+	}, nil).Once()
+
+	_, err := s.boletos.QueryBoleto(s.ctx, "5a0f8148-03cb-430a-aec9-558e83e17352")
+	s.assert.Equal(celcoin.ErrDefaultBoletos, err)
+}
+
+// assertChargeNotFound ...
+func (s *BoletoTestSuite) assertChargeNotFound(err error) {
+	s.Require().Error(err)
+	grokErr, ok := err.(*grok.Error)
+	s.Require().True(ok, "erro deve ser do tipo *grok.Error")
+	s.assert.Equal("CHARGE_NOT_FOUND", grokErr.Key)
+	s.assert.Equal(http.StatusBadRequest, grokErr.Code)
+}
@@ -11,44 +11,60 @@ import (
 	"path"
 
 	"github.com/contbank/grok"
-	"github.com/sirupsen/logrus"
 )
 
+// BoletosInterface define a interface para emissão, cancelamento, consulta e download de boletos.
+type BoletosInterface interface {
+	CreateBoleto(ctx context.Context, req CreateBoletoRequest) (*CreateBoletoResponse, error)
+	CancelBoleto(ctx context.Context, transactionID string, reason string) error
+	QueryBoleto(ctx context.Context, transactionID string) (*QueryBoletoResponse, error)
+	DownloadBoletoPDF(ctx context.Context, transactionID string, writer io.Writer) error
+	GetCharge(ctx context.Context, request *ChargeRequest) (*ChargeResponse, error)
+}
+
 // Boletos provides methods to create, cancel, query, and download boleto (charge) documents.
 type Boletos struct {
 	session    Session
 	httpClient *LoggingHTTPClient
 }
 
+var _ BoletosInterface = (*Boletos)(nil)
+
+// boletoErrors ... respostas de erro das APIs de cobrança, convertidas pelo catálogo no domínio charge
+var boletoErrors = envelopeErrors{find: ignoreMessage(FindChargeError), fallback: ErrDefaultBoletos}
+
 // NewBoletos creates and returns a new instance of Boletos using the given httpClient and session.
-func NewBoletos(httpClient *http.Client, session Session) *Boletos {
+func NewBoletos(httpClient *http.Client, session Session) BoletosInterface {
 	return &Boletos{
 		session:    session,
-		httpClient: NewLoggingHTTPClient(httpClient),
+		httpClient: newSessionLoggingHTTPClient(httpClient, session),
 	}
 }
 
 // CreateBoleto sends a request to create a new boleto (charge).
 // It expects a CreateBoletoRequest and returns the unwrapped CreateBoletoResponse.
-func (b *Boletos) CreateBoleto(ctx context.Context, req CreateBoletoRequest) (*CreateBoletoResponse, error) {
+func (b *Boletos) CreateBoleto(ctx context.Context, req CreateBoletoRequest) (_ *CreateBoletoResponse, err error) {
+	ctx, op := b.session.startOperation(ctx, "boletos", "CreateBoleto", BaasV2ChargePath)
+	defer op.end(&err)
+	logger := op.logger
 	// Validate the request payload.
 	if err := grok.Validator.Struct(req); err != nil {
-		logrus.WithError(err).Error("CreateBoleto: validation error")
+		logger.WithError(err).Error("CreateBoleto: validation error")
 		return nil, grok.FromValidationErros(err)
 	}
 
 	// Build the endpoint URL: {APIEndpoint}/baas/v2/charge
 	endpoint := fmt.Sprintf("%s/baas/v2/charge", b.session.APIEndpoint)
-	logrus.WithField("endpoint", endpoint).Info("CreateBoleto endpoint")
+	logger.WithField("endpoint", endpoint).Info("CreateBoleto endpoint")
 
 	payload, err := json.Marshal(req)
 	if err != nil {
-		return nil, fmt.Errorf("CreateBoleto: error serializing request: %v", err)
+		return nil, fmt.Errorf("CreateBoleto: error serializing request: %w", err)
 	}
 
 	httpReq, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewReader(payload))
 	if err != nil {
-		return nil, fmt.Errorf("CreateBoleto: error creating HTTP request: %v", err)
+		return nil, fmt.Errorf("CreateBoleto: error creating HTTP request: %w", err)
 	}
 
 	httpReq.Header.Set("Content-Type", "application/json")
@@ -57,18 +73,18 @@ func (b *Boletos) CreateBoleto(ctx context.Context, req CreateBoletoRequest) (*C
 
 	resp, err := b.httpClient.Do(httpReq)
 	if err != nil {
-		logrus.WithError(err).Error("CreateBoleto: error performing HTTP request")
+		logger.WithError(err).Error("CreateBoleto: error performing HTTP request")
 		return nil, err
 	}
 	defer resp.Body.Close()
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("CreateBoleto: error reading response body: %v", err)
+		return nil, fmt.Errorf("CreateBoleto: error reading response body: %w", err)
 	}
 
 	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("CreateBoleto: request failed with status %d, body: %s", resp.StatusCode, string(respBody))
+		return nil, boletoErrors.mapError(logger, resp.StatusCode, respBody)
 	}
 
 	// Unwrap the response envelope.
@@ -78,27 +94,30 @@ func (b *Boletos) CreateBoleto(ctx context.Context, req CreateBoletoRequest) (*C
 		Status  string               `json:"status"`
 	}
 	if err := json.Unmarshal(respBody, &envelope); err != nil {
-		return nil, fmt.Errorf("CreateBoleto: error unmarshaling response: %v", err)
+		return nil, fmt.Errorf("CreateBoleto: error unmarshaling response: %w", err)
 	}
 
 	return &envelope.Body, nil
 }
 
 // CancelBoleto sends a request to cancel an existing boleto given its transaction ID and a cancellation reason.
-func (b *Boletos) CancelBoleto(ctx context.Context, transactionID string, reason string) error {
+func (b *Boletos) CancelBoleto(ctx context.Context, transactionID string, reason string) (err error) {
+	ctx, op := b.session.startOperation(ctx, "boletos", "CancelBoleto", BaasV2ChargePath)
+	defer op.end(&err)
+	logger := op.logger
 	cancelPayload := CancelInput{Reason: reason}
 	payload, err := json.Marshal(cancelPayload)
 	if err != nil {
-		return fmt.Errorf("CancelBoleto: error serializing request: %v", err)
+		return fmt.Errorf("CancelBoleto: error serializing request: %w", err)
 	}
 
 	// Build the endpoint URL: {APIEndpoint}/baas/v2/charge/{transactionID}
 	endpoint := fmt.Sprintf("%s/baas/v2/charge/%s", b.session.APIEndpoint, transactionID)
-	logrus.WithField("endpoint", endpoint).Info("CancelBoleto endpoint")
+	logger.WithField("endpoint", endpoint).Info("CancelBoleto endpoint")
 
 	httpReq, err := http.NewRequestWithContext(ctx, "DELETE", endpoint, bytes.NewReader(payload))
 	if err != nil {
-		return fmt.Errorf("CancelBoleto: error creating HTTP request: %v", err)
+		return fmt.Errorf("CancelBoleto: error creating HTTP request: %w", err)
 	}
 
 	httpReq.Header.Set("Content-Type", "application/json")
@@ -107,13 +126,13 @@ func (b *Boletos) CancelBoleto(ctx context.Context, transactionID string, reason
 
 	resp, err := b.httpClient.Do(httpReq)
 	if err != nil {
-		return fmt.Errorf("CancelBoleto: HTTP request error: %v", err)
+		return fmt.Errorf("CancelBoleto: HTTP request error: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
 		respBody, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("CancelBoleto: request failed with status %d, body: %s", resp.StatusCode, string(respBody))
+		return boletoErrors.mapError(logger, resp.StatusCode, respBody)
 	}
 
 	return nil
@@ -121,10 +140,13 @@ func (b *Boletos) CancelBoleto(ctx context.Context, transactionID string, reason
 
 // QueryBoleto queries a boleto by its transaction ID.
 // It returns a QueryBoletoResponse containing the charge status.
-func (b *Boletos) QueryBoleto(ctx context.Context, transactionID string) (*QueryBoletoResponse, error) {
+func (b *Boletos) QueryBoleto(ctx context.Context, transactionID string) (_ *QueryBoletoResponse, err error) {
+	ctx, op := b.session.startOperation(ctx, "boletos", "QueryBoleto", BaasV2ChargePath)
+	defer op.end(&err)
+	logger := op.logger
 	base, err := url.Parse(b.session.APIEndpoint)
 	if err != nil {
-		return nil, fmt.Errorf("QueryBoleto: error parsing API endpoint: %v", err)
+		return nil, fmt.Errorf("QueryBoleto: error parsing API endpoint: %w", err)
 	}
 	base.Path = path.Join(base.Path, "baas/v2/charge")
 	q := base.Query()
@@ -132,28 +154,28 @@ func (b *Boletos) QueryBoleto(ctx context.Context, transactionID string) (*Query
 	base.RawQuery = q.Encode()
 	endpoint := base.String()
 
-	logrus.WithField("endpoint", endpoint).Info("QueryBoleto endpoint")
+	logger.WithField("endpoint", endpoint).Info("QueryBoleto endpoint")
 
 	httpReq, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
 	if err != nil {
-		return nil, fmt.Errorf("QueryBoleto: error creating HTTP request: %v", err)
+		return nil, fmt.Errorf("QueryBoleto: error creating HTTP request: %w", err)
 	}
 	httpReq.Header.Set("Content-Type", "application/json")
 	// Authentication handled by httpClient.
 
 	resp, err := b.httpClient.Do(httpReq)
 	if err != nil {
-		return nil, fmt.Errorf("QueryBoleto: HTTP request error: %v", err)
+		return nil, fmt.Errorf("QueryBoleto: HTTP request error: %w", err)
 	}
 	defer resp.Body.Close()
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("QueryBoleto: error reading response body: %v", err)
+		return nil, fmt.Errorf("QueryBoleto: error reading response body: %w", err)
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("QueryBoleto: request failed with status %d, body: %s", resp.StatusCode, string(respBody))
+		return nil, boletoErrors.mapError(logger, resp.StatusCode, respBody)
 	}
 
 	var envelope struct {
@@ -162,39 +184,42 @@ func (b *Boletos) QueryBoleto(ctx context.Context, transactionID string) (*Query
 		Status  string              `json:"status"`
 	}
 	if err := json.Unmarshal(respBody, &envelope); err != nil {
-		return nil, fmt.Errorf("QueryBoleto: error unmarshaling response: %v", err)
+		return nil, fmt.Errorf("QueryBoleto: error unmarshaling response: %w", err)
 	}
 
 	return &envelope.Body, nil
 }
 
 // DownloadBoletoPDF downloads the boleto PDF file and writes its content to the provided writer.
-func (b *Boletos) DownloadBoletoPDF(ctx context.Context, transactionID string, writer io.Writer) error {
+func (b *Boletos) DownloadBoletoPDF(ctx context.Context, transactionID string, writer io.Writer) (err error) {
+	ctx, op := b.session.startOperation(ctx, "boletos", "DownloadBoletoPDF", BaasV2ChargePath)
+	defer op.end(&err)
+	logger := op.logger
 	// Build the endpoint URL: {APIEndpoint}/baas/v2/charge/pdf/{transactionID}
 	endpoint := fmt.Sprintf("%s/baas/v2/charge/pdf/%s", b.session.APIEndpoint, transactionID)
-	logrus.WithField("endpoint", endpoint).Info("DownloadBoletoPDF endpoint")
+	logger.WithField("endpoint", endpoint).Info("DownloadBoletoPDF endpoint")
 
 	httpReq, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
 	if err != nil {
-		return fmt.Errorf("DownloadBoletoPDF: error creating HTTP request: %v", err)
+		return fmt.Errorf("DownloadBoletoPDF: error creating HTTP request: %w", err)
 	}
 	httpReq.Header.Set("Content-Type", "application/json")
 	// Authentication is handled automatically.
 
 	resp, err := b.httpClient.Do(httpReq)
 	if err != nil {
-		return fmt.Errorf("DownloadBoletoPDF: HTTP request error: %v", err)
+		return fmt.Errorf("DownloadBoletoPDF: HTTP request error: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
 		respBody, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("DownloadBoletoPDF: request failed with status %d, body: %s", resp.StatusCode, string(respBody))
+		return boletoErrors.mapError(logger, resp.StatusCode, respBody)
 	}
 
 	_, err = io.Copy(writer, resp.Body)
 	if err != nil {
-		return fmt.Errorf("DownloadBoletoPDF: error writing PDF to writer: %v", err)
+		return fmt.Errorf("DownloadBoletoPDF: error writing PDF to writer: %w", err)
 	}
 
 	return nil
@@ -202,13 +227,15 @@ func (b *Boletos) DownloadBoletoPDF(ctx context.Context, transactionID string, w
 
 // GetCharge ...
 func (r *Boletos) GetCharge(ctx context.Context,
-	request *ChargeRequest) (*ChargeResponse, error) {
+	request *ChargeRequest) (_ *ChargeResponse, err error) {
+	ctx, op := r.session.startOperation(ctx, "boletos", "GetCharge", BaasV2ChargePath)
+	defer op.end(&err)
+	logger := op.logger
 
-	requestID, _ := ctx.Value("Request-Id").(string)
-	fields := logrus.Fields{
+	requestID := RequestIDFrom(ctx)
+	fields := Fields{
 		"request_id": requestID,
 		"interface":  "GetCharge",
-		"service":    "charge",
 	}
 
 	if request != nil && request.TransactionID != nil && len(*request.TransactionID) > 0 {
@@ -221,7 +248,7 @@ func (r *Boletos) GetCharge(ctx context.Context,
 
 	u, err := url.Parse(r.session.APIEndpoint)
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error parsing charge api endpoint")
 		return nil, err
 	}
@@ -241,9 +268,9 @@ func (r *Boletos) GetCharge(ctx context.Context,
 	u.RawQuery = q.Encode()
 	endpoint := u.String()
 
-	req, err := http.NewRequest("GET", endpoint, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error creating charge request")
 		return nil, err
 	}
@@ -252,7 +279,7 @@ func (r *Boletos) GetCharge(ctx context.Context,
 
 	resp, err := r.httpClient.Do(req)
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error making charge request")
 		return nil, err
 	}
@@ -260,30 +287,18 @@ func (r *Boletos) GetCharge(ctx context.Context,
 
 	bodyBytes, err := io.ReadAll(resp.Body)
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error reading charge response body")
 		return nil, err
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		var errResponse *ErrorDefaultResponse
-		if err := json.Unmarshal(bodyBytes, &errResponse); err != nil {
-			logrus.WithFields(fields).WithError(err).
-				Error("error unmarshal charge response")
-			return nil, err
-		}
-
-		if errResponse != nil && errResponse.Error != nil && len(*errResponse.Error.ErrorCode) > 0 {
-			err := FindChargeError(*errResponse.Error.ErrorCode, &resp.StatusCode)
-			logrus.WithFields(fields).WithError(err).
-				Error("error getting charge response")
-			return nil, err
-		}
+		return nil, boletoErrors.mapError(logger.WithFields(fields), resp.StatusCode, bodyBytes)
 	}
 
 	var chargeResponse *ChargeResponse
 	if err := json.Unmarshal(bodyBytes, &chargeResponse); err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error unmarshal charge response")
 		return nil, err
 	}
@@ -0,0 +1,276 @@
+package celcoin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CircuitState ... estado do circuit breaker de uma família de endpoints
+type CircuitState string
+
+const (
+	// CircuitClosed ... chamadas passam normalmente
+	CircuitClosed CircuitState = "CLOSED"
+	// CircuitOpen ... chamadas falham imediatamente com ErrCircuitOpen
+	CircuitOpen CircuitState = "OPEN"
+	// CircuitHalfOpen ... poucas chamadas de teste decidem se o circuito fecha ou volta a abrir
+	CircuitHalfOpen CircuitState = "HALF_OPEN"
+)
+
+// CircuitBreakerPolicy ... configuração do CircuitBreakerTransport
+type CircuitBreakerPolicy struct {
+	// Families ... prefixos de path que formam uma família (ex.: BaasV2ChargePath, PixPaymentV2Path).
+	// Paths sem prefixo correspondente usam os dois primeiros segmentos (ex.: "/baas/v2").
+	Families []string
+	// FailureThreshold ... falhas consecutivas que abrem o circuito (padrão 5)
+	FailureThreshold int
+	// OpenTimeout ... tempo aberto antes de permitir chamadas de teste (padrão 30s)
+	OpenTimeout time.Duration
+	// HalfOpenProbes ... chamadas de teste simultâneas no estado HALF_OPEN (padrão 1)
+	HalfOpenProbes int
+	// SuccessThreshold ... chamadas de teste bem-sucedidas que fecham o circuito (padrão 1)
+	SuccessThreshold int
+	// IsFailure ... classifica o resultado da chamada; o padrão considera erros de rede, timeouts e status 5xx.
+	// Chamadas de teste canceladas, expiradas ou barradas pelo limite local não são contabilizadas.
+	IsFailure func(resp *http.Response, err error) bool
+	// OnStateChange ... chamado a cada mudança de estado, útil para alertas
+	OnStateChange func(family string, from, to CircuitState)
+}
+
+// CircuitOpenError ... erro retornado enquanto o circuito da família está aberto; errors.Is(err, ErrCircuitOpen) é verdadeiro
+type CircuitOpenError struct {
+	Family string
+	Until  time.Time
+}
+
+// Error ...
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf("celcoin circuit breaker open for %s until %s", e.Family, e.Until.Format(time.RFC3339))
+}
+
+// Unwrap ...
+func (e *CircuitOpenError) Unwrap() error {
+	return ErrCircuitOpen
+}
+
+// CircuitBreakerTransport ... RoundTripper que interrompe as chamadas a uma família de endpoints degradada
+type CircuitBreakerTransport struct {
+	transport http.RoundTripper
+	policy    CircuitBreakerPolicy
+	mutex     sync.Mutex
+	circuits  map[string]*circuit
+}
+
+// circuit ... estado de uma família de endpoints
+type circuit struct {
+	state     CircuitState
+	failures  int
+	successes int
+	probes    int
+	openedAt  time.Time
+	// generation ... incrementada a cada mudança de estado; resultados de chamadas admitidas em outra geração são ignorados
+	generation uint64
+}
+
+// NewCircuitBreakerTransport ...
+func NewCircuitBreakerTransport(transport http.RoundTripper, policy CircuitBreakerPolicy) *CircuitBreakerTransport {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	if policy.FailureThreshold <= 0 {
+		policy.FailureThreshold = 5
+	}
+	if policy.OpenTimeout <= 0 {
+		policy.OpenTimeout = 30 * time.Second
+	}
+	if policy.HalfOpenProbes <= 0 {
+		policy.HalfOpenProbes = 1
+	}
+	if policy.SuccessThreshold <= 0 {
+		policy.SuccessThreshold = 1
+	}
+	if policy.IsFailure == nil {
+		policy.IsFailure = defaultIsFailure
+	}
+	return &CircuitBreakerTransport{
+		transport: transport,
+		policy:    policy,
+		circuits:  make(map[string]*circuit),
+	}
+}
+
+// RoundTrip ...
+func (t *CircuitBreakerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	family := t.family(req.URL.Path)
+	generation, err := t.allow(family)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := t.transport.RoundTrip(req)
+	t.record(family, generation, t.policy.IsFailure(resp, err), interrupted(err))
+	return resp, err
+}
+
+// CloseIdleConnections ...
+func (t *CircuitBreakerTransport) CloseIdleConnections() {
+	if closer, ok := t.transport.(interface{ CloseIdleConnections() }); ok {
+		closer.CloseIdleConnections()
+	}
+}
+
+// State ... estado atual do circuito da família
+func (t *CircuitBreakerTransport) State(family string) CircuitState {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	c, found := t.circuits[family]
+	if !found {
+		return CircuitClosed
+	}
+	if c.state == CircuitOpen && time.Since(c.openedAt) >= t.policy.OpenTimeout {
+		return CircuitHalfOpen
+	}
+	return c.state
+}
+
+// family ... prefixo configurado mais longo ou os dois primeiros segmentos do path
+func (t *CircuitBreakerTransport) family(path string) string {
+	selected := ""
+	for _, prefix := range t.policy.Families {
+		if !strings.HasPrefix(prefix, "/") {
+			prefix = "/" + prefix
+		}
+		if strings.HasPrefix(path, prefix) && len(prefix) > len(selected) {
+			selected = prefix
+		}
+	}
+	if len(selected) > 0 {
+		return selected
+	}
+
+	segments := strings.SplitN(strings.TrimPrefix(path, "/"), "/", 3)
+	if len(segments) > 2 {
+		segments = segments[:2]
+	}
+	return "/" + strings.Join(segments, "/")
+}
+
+// allow ... decide se a chamada pode seguir, promovendo OPEN para HALF_OPEN após o OpenTimeout.
+// Retorna a geração do circuito em que a chamada foi admitida.
+func (t *CircuitBreakerTransport) allow(family string) (uint64, error) {
+	t.mutex.Lock()
+	c, found := t.circuits[family]
+	if !found {
+		c = &circuit{state: CircuitClosed}
+		t.circuits[family] = c
+	}
+
+	var changed func()
+	if c.state == CircuitOpen && time.Since(c.openedAt) >= t.policy.OpenTimeout {
+		changed = t.transitionLocked(family, c, CircuitHalfOpen)
+	}
+
+	var err error
+	switch c.state {
+	case CircuitOpen:
+		err = &CircuitOpenError{Family: family, Until: c.openedAt.Add(t.policy.OpenTimeout)}
+	case CircuitHalfOpen:
+		if c.probes >= t.policy.HalfOpenProbes {
+			err = &CircuitOpenError{Family: family, Until: time.Now()}
+		} else {
+			c.probes++
+		}
+	}
+	generation := c.generation
+	t.mutex.Unlock()
+
+	if changed != nil {
+		changed()
+	}
+	return generation, err
+}
+
+// record ... contabiliza o resultado e muda o estado quando os limites são atingidos. Resultados de chamadas
+// admitidas antes da última mudança de estado (ex.: uma chamada lenta do CLOSED que termina no HALF_OPEN) são descartados.
+// Chamadas interrompidas não contam como sucesso; no HALF_OPEN também não contam como falha e apenas liberam a vaga.
+func (t *CircuitBreakerTransport) record(family string, generation uint64, failed, interrupted bool) {
+	t.mutex.Lock()
+	c := t.circuits[family]
+	if c.generation != generation {
+		t.mutex.Unlock()
+		return
+	}
+
+	var changed func()
+	switch c.state {
+	case CircuitClosed:
+		if !failed {
+			if !interrupted {
+				c.failures = 0
+			}
+			break
+		}
+		c.failures++
+		if c.failures >= t.policy.FailureThreshold {
+			changed = t.transitionLocked(family, c, CircuitOpen)
+		}
+	case CircuitHalfOpen:
+		c.probes--
+		if interrupted {
+			// A chamada de teste não chegou a uma resposta da Celcoin; o circuito continua HALF_OPEN
+			break
+		}
+		if failed {
+			changed = t.transitionLocked(family, c, CircuitOpen)
+			break
+		}
+		c.successes++
+		if c.successes >= t.policy.SuccessThreshold {
+			changed = t.transitionLocked(family, c, CircuitClosed)
+		}
+	}
+	t.mutex.Unlock()
+
+	if changed != nil {
+		changed()
+	}
+}
+
+// transitionLocked ... muda o estado e retorna a notificação a ser feita fora do lock
+func (t *CircuitBreakerTransport) transitionLocked(family string, c *circuit, to CircuitState) func() {
+	from := c.state
+	c.state = to
+	c.failures = 0
+	c.successes = 0
+	c.probes = 0
+	c.generation++
+	if to == CircuitOpen {
+		c.openedAt = time.Now()
+	}
+
+	if t.policy.OnStateChange == nil {
+		return nil
+	}
+	return func() { t.policy.OnStateChange(family, from, to) }
+}
+
+// interrupted ... a chamada foi cancelada pelo chamador, excedeu o prazo do contexto ou foi barrada pelo limite
+// local, sem uma resposta da Celcoin
+func interrupted(err error) bool {
+	return err != nil && (errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) ||
+		errors.Is(err, ErrRateLimited))
+}
+
+// defaultIsFailure ... erros de rede, timeouts e status 5xx; cancelamentos do chamador e limites locais não contam
+func defaultIsFailure(resp *http.Response, err error) bool {
+	if err != nil {
+		return !errors.Is(err, context.Canceled) && !errors.Is(err, ErrRateLimited)
+	}
+	return resp.StatusCode >= http.StatusInternalServerError
+}
@@ -0,0 +1,342 @@
+package celcoin_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/contbank/celcoin-sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+// CircuitBreakerTestSuite ...
+type CircuitBreakerTestSuite struct {
+	suite.Suite
+	assert      *assert.Assertions
+	ctx         context.Context
+	calls       int32
+	status      int32
+	server      *httptest.Server
+	mutex       sync.Mutex
+	transitions []string
+}
+
+// TestCircuitBreakerTestSuite ...
+func TestCircuitBreakerTestSuite(t *testing.T) {
+	suite.Run(t, new(CircuitBreakerTestSuite))
+}
+
+// SetupTest ...
+func (s *CircuitBreakerTestSuite) SetupTest() {
+	s.assert = assert.New(s.T())
+	s.ctx = context.Background()
+	s.calls = 0
+	s.status = http.StatusServiceUnavailable
+	s.transitions = nil
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&s.calls, 1)
+		w.WriteHeader(int(atomic.LoadInt32(&s.status)))
+	}))
+}
+
+// TearDownTest ...
+func (s *CircuitBreakerTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *CircuitBreakerTestSuite) newTransport(policy celcoin.CircuitBreakerPolicy) *celcoin.CircuitBreakerTransport {
+	policy.OnStateChange = func(family string, from, to celcoin.CircuitState) {
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+		s.transitions = append(s.transitions, family+":"+string(from)+"->"+string(to))
+	}
+	return celcoin.NewCircuitBreakerTransport(http.DefaultTransport, policy)
+}
+
+func (s *CircuitBreakerTestSuite) get(transport http.RoundTripper, path string) (int, error) {
+	req, err := http.NewRequestWithContext(s.ctx, http.MethodGet, s.server.URL+path, nil)
+	s.Require().NoError(err)
+
+	resp, err := (&http.Client{Transport: transport}).Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	return resp.StatusCode, nil
+}
+
+// TestOpensAfterFailureThreshold ...
+func (s *CircuitBreakerTestSuite) TestOpensAfterFailureThreshold() {
+	transport := s.newTransport(celcoin.CircuitBreakerPolicy{FailureThreshold: 3, OpenTimeout: time.Minute})
+
+	for i := 0; i < 3; i++ {
+		status, err := s.get(transport, celcoin.BaasV2ChargePath)
+		s.Require().NoError(err)
+		s.assert.Equal(http.StatusServiceUnavailable, status)
+	}
+
+	_, err := s.get(transport, celcoin.BaasV2ChargePath)
+	s.assert.ErrorIs(err, celcoin.ErrCircuitOpen)
+
+	var openErr *celcoin.CircuitOpenError
+	s.Require().True(errors.As(err, &openErr))
+	s.assert.Equal("/baas/v2", openErr.Family)
+	s.assert.Equal(int32(3), atomic.LoadInt32(&s.calls))
+	s.assert.Equal(celcoin.CircuitOpen, transport.State("/baas/v2"))
+	s.assert.Equal([]string{"/baas/v2:CLOSED->OPEN"}, s.transitions)
+}
+
+// TestSuccessResetsFailures ...
+func (s *CircuitBreakerTestSuite) TestSuccessResetsFailures() {
+	transport := s.newTransport(celcoin.CircuitBreakerPolicy{FailureThreshold: 2, OpenTimeout: time.Minute})
+
+	for i := 0; i < 3; i++ {
+		atomic.StoreInt32(&s.status, http.StatusBadGateway)
+		_, err := s.get(transport, celcoin.StatementPath)
+		s.Require().NoError(err)
+
+		atomic.StoreInt32(&s.status, http.StatusOK)
+		_, err = s.get(transport, celcoin.StatementPath)
+		s.Require().NoError(err)
+	}
+
+	s.assert.Empty(s.transitions)
+}
+
+// TestClientErrorsAreNotFailures ...
+func (s *CircuitBreakerTestSuite) TestClientErrorsAreNotFailures() {
+	atomic.StoreInt32(&s.status, http.StatusBadRequest)
+	transport := s.newTransport(celcoin.CircuitBreakerPolicy{FailureThreshold: 1, OpenTimeout: time.Minute})
+
+	for i := 0; i < 3; i++ {
+		_, err := s.get(transport, celcoin.BaasV2ChargePath)
+		s.Require().NoError(err)
+	}
+	s.assert.Equal(int32(3), atomic.LoadInt32(&s.calls))
+}
+
+// TestFamiliesAreIndependent ...
+func (s *CircuitBreakerTestSuite) TestFamiliesAreIndependent() {
+	transport := s.newTransport(celcoin.CircuitBreakerPolicy{
+		Families:         []string{celcoin.PixDictPath},
+		FailureThreshold: 1,
+		OpenTimeout:      time.Minute,
+	})
+
+	_, err := s.get(transport, celcoin.PixDictPath+"/123")
+	s.Require().NoError(err)
+
+	_, err = s.get(transport, celcoin.PixDictPath+"/456")
+	s.assert.ErrorIs(err, celcoin.ErrCircuitOpen)
+
+	_, err = s.get(transport, celcoin.BalancePath)
+	s.assert.NoError(err)
+}
+
+// TestHalfOpenProbe ...
+func (s *CircuitBreakerTestSuite) TestHalfOpenProbe() {
+	transport := s.newTransport(celcoin.CircuitBreakerPolicy{FailureThreshold: 1, OpenTimeout: 50 * time.Millisecond})
+
+	_, err := s.get(transport, celcoin.BaasV2ChargePath)
+	s.Require().NoError(err)
+
+	// A chamada de teste falha e o circuito volta a abrir
+	time.Sleep(60 * time.Millisecond)
+	_, err = s.get(transport, celcoin.BaasV2ChargePath)
+	s.Require().NoError(err)
+	_, err = s.get(transport, celcoin.BaasV2ChargePath)
+	s.assert.ErrorIs(err, celcoin.ErrCircuitOpen)
+
+	// A chamada de teste passa e o circuito fecha
+	atomic.StoreInt32(&s.status, http.StatusOK)
+	time.Sleep(60 * time.Millisecond)
+	status, err := s.get(transport, celcoin.BaasV2ChargePath)
+	s.Require().NoError(err)
+	s.assert.Equal(http.StatusOK, status)
+	s.assert.Equal(celcoin.CircuitClosed, transport.State("/baas/v2"))
+
+	s.assert.Equal([]string{
+		"/baas/v2:CLOSED->OPEN",
+		"/baas/v2:OPEN->HALF_OPEN",
+		"/baas/v2:HALF_OPEN->OPEN",
+		"/baas/v2:OPEN->HALF_OPEN",
+		"/baas/v2:HALF_OPEN->CLOSED",
+	}, s.transitions)
+}
+
+// TestHalfOpenLimitsProbes ...
+func (s *CircuitBreakerTestSuite) TestHalfOpenLimitsProbes() {
+	release := make(chan struct{})
+	s.server.Close()
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&s.calls, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		<-release
+	}))
+
+	transport := s.newTransport(celcoin.CircuitBreakerPolicy{FailureThreshold: 1, OpenTimeout: 10 * time.Millisecond})
+
+	_, err := s.get(transport, celcoin.StatementPath)
+	s.Require().NoError(err)
+	time.Sleep(20 * time.Millisecond)
+
+	done := make(chan error)
+	go func() {
+		_, err := s.get(transport, celcoin.StatementPath)
+		done <- err
+	}()
+	s.Eventually(func() bool { return atomic.LoadInt32(&s.calls) == 2 }, time.Second, time.Millisecond)
+
+	// Apenas uma chamada de teste por vez
+	_, err = s.get(transport, celcoin.StatementPath)
+	s.assert.ErrorIs(err, celcoin.ErrCircuitOpen)
+
+	close(release)
+	s.assert.NoError(<-done)
+	s.assert.Equal(celcoin.CircuitClosed, transport.State("/baas-walletreports/v1"))
+}
+
+// TestInterruptedProbeKeepsHalfOpen ...
+func (s *CircuitBreakerTestSuite) TestInterruptedProbeKeepsHalfOpen() {
+	hang := int32(0)
+	s.server.Close()
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&s.calls, 1)
+		if atomic.LoadInt32(&hang) == 1 {
+			<-r.Context().Done()
+			return
+		}
+		w.WriteHeader(int(atomic.LoadInt32(&s.status)))
+	}))
+
+	transport := s.newTransport(celcoin.CircuitBreakerPolicy{FailureThreshold: 1, OpenTimeout: 10 * time.Millisecond})
+
+	_, err := s.get(transport, celcoin.StatementPath)
+	s.Require().NoError(err)
+	time.Sleep(20 * time.Millisecond)
+	atomic.StoreInt32(&hang, 1)
+
+	// Chamadas de teste canceladas ou expiradas não fecham nem reabrem o circuito
+	ctx, cancel := context.WithCancel(s.ctx)
+	go func() {
+		s.Eventually(func() bool { return atomic.LoadInt32(&s.calls) == 2 }, time.Second, time.Millisecond)
+		cancel()
+	}()
+	s.ctx = ctx
+	_, err = s.get(transport, celcoin.StatementPath)
+	s.assert.ErrorIs(err, context.Canceled)
+	s.assert.Equal(celcoin.CircuitHalfOpen, transport.State("/baas-walletreports/v1"))
+
+	ctx, cancel = context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	s.ctx = ctx
+	_, err = s.get(transport, celcoin.StatementPath)
+	s.assert.ErrorIs(err, context.DeadlineExceeded)
+	s.assert.Equal(celcoin.CircuitHalfOpen, transport.State("/baas-walletreports/v1"))
+
+	// A vaga de teste foi liberada: a próxima chamada é admitida e fecha o circuito
+	atomic.StoreInt32(&hang, 0)
+	atomic.StoreInt32(&s.status, http.StatusOK)
+	s.ctx = context.Background()
+	status, err := s.get(transport, celcoin.StatementPath)
+	s.Require().NoError(err)
+	s.assert.Equal(http.StatusOK, status)
+	s.assert.Equal(celcoin.CircuitClosed, transport.State("/baas-walletreports/v1"))
+	s.assert.Equal([]string{
+		"/baas-walletreports/v1:CLOSED->OPEN",
+		"/baas-walletreports/v1:OPEN->HALF_OPEN",
+		"/baas-walletreports/v1:HALF_OPEN->CLOSED",
+	}, s.transitions)
+}
+
+// TestStaleResultIsIgnored ...
+func (s *CircuitBreakerTestSuite) TestStaleResultIsIgnored() {
+	slow := make(chan struct{})
+	probe := make(chan struct{})
+	s.server.Close()
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch atomic.AddInt32(&s.calls, 1) {
+		case 1:
+			<-slow
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case 2:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			<-probe
+		}
+	}))
+
+	transport := s.newTransport(celcoin.CircuitBreakerPolicy{FailureThreshold: 1, OpenTimeout: 10 * time.Millisecond})
+
+	// Chamada admitida no CLOSED que só termina depois do HALF_OPEN
+	stale := make(chan error)
+	go func() {
+		_, err := s.get(transport, celcoin.StatementPath)
+		stale <- err
+	}()
+	s.Eventually(func() bool { return atomic.LoadInt32(&s.calls) == 1 }, time.Second, time.Millisecond)
+
+	_, err := s.get(transport, celcoin.StatementPath)
+	s.Require().NoError(err)
+	time.Sleep(20 * time.Millisecond)
+
+	done := make(chan error)
+	go func() {
+		_, err := s.get(transport, celcoin.StatementPath)
+		done <- err
+	}()
+	s.Eventually(func() bool { return atomic.LoadInt32(&s.calls) == 3 }, time.Second, time.Millisecond)
+
+	close(slow)
+	s.assert.NoError(<-stale)
+	s.assert.Equal(celcoin.CircuitHalfOpen, transport.State("/baas-walletreports/v1"))
+
+	close(probe)
+	s.assert.NoError(<-done)
+	s.assert.Equal(celcoin.CircuitClosed, transport.State("/baas-walletreports/v1"))
+	s.assert.Equal([]string{
+		"/baas-walletreports/v1:CLOSED->OPEN",
+		"/baas-walletreports/v1:OPEN->HALF_OPEN",
+		"/baas-walletreports/v1:HALF_OPEN->CLOSED",
+	}, s.transitions)
+}
+
+// TestOpenCircuitIsNotRetried ...
+func (s *CircuitBreakerTestSuite) TestOpenCircuitIsNotRetried() {
+	s.server.Close()
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, celcoin.LoginPath) {
+			w.Write([]byte(`{"access_token":"token","expires_in":3600}`))
+			return
+		}
+		atomic.AddInt32(&s.calls, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+
+	client, err := celcoin.NewClient(celcoin.Config{
+		ClientID:      celcoin.String("test-client-id"),
+		ClientSecret:  celcoin.String("test-client-secret"),
+		APIEndpoint:   celcoin.String(s.server.URL),
+		LoginEndpoint: celcoin.String(s.server.URL),
+		RetryPolicy: &celcoin.RetryPolicy{
+			MaxAttempts:     5,
+			InitialInterval: time.Millisecond,
+			RetryableStatus: []int{http.StatusServiceUnavailable},
+		},
+		CircuitBreakerPolicy: &celcoin.CircuitBreakerPolicy{FailureThreshold: 2, OpenTimeout: time.Minute},
+	})
+	s.Require().NoError(err)
+
+	_, err = client.Balance.Balance(s.ctx, "123456")
+	s.assert.Error(err)
+	s.assert.Equal(int32(2), atomic.LoadInt32(&s.calls))
+}
@@ -8,10 +8,19 @@ import (
 	"net/url"
 	"path"
 	"strings"
-
-	"github.com/sirupsen/logrus"
 )
 
+// BusinessInterface define a interface para as contas de pessoa jurídica.
+type BusinessInterface interface {
+	FindAccounts(ctx context.Context, documentNumber *string, accountNumber *string) (*BusinessResponse, error)
+	CreateAccount(ctx context.Context, businessData *BusinessOnboardingRequest) (*BusinessOnboardingResponse, error)
+	CreateAccountMigration(ctx context.Context, businessData *BusinessOnboardingMigrationRequest) (*BusinessOnboardingResponse, error)
+	GetLegalPersonOnboardingProposal(ctx context.Context, proposalId string) (*OnboardingProposalResponseBody, error)
+	GetLegalPersonOnboardingProposalFiles(ctx context.Context, proposalId string) (*OnboardingProposalFilesResponse, error)
+	UpdateAccountStatus(ctx context.Context, accountNumber *string, documentNumber *string, reason *string, status *string) (*UpdateAccountStatusResponse, error)
+	CancelAccount(ctx context.Context, accountNumber *string, documentNumber *string, reason *string) (*CancelAccountResponse, error)
+}
+
 // Business ...
 type Business struct {
 	session        Session
@@ -19,24 +28,28 @@ type Business struct {
 	authentication *Authentication
 }
 
+var _ BusinessInterface = (*Business)(nil)
+
 // NewBusiness ...
-func NewBusiness(httpClient *http.Client, session Session) *Business {
+func NewBusiness(httpClient *http.Client, session Session) BusinessInterface {
 	return &Business{
 		session:        session,
-		httpClient:     NewLoggingHTTPClient(httpClient),
+		httpClient:     newSessionLoggingHTTPClient(httpClient, session),
 		authentication: NewAuthentication(httpClient, session),
 	}
 }
 
 // FindAccounts ...
 func (c *Business) FindAccounts(ctx context.Context,
-	documentNumber *string, accountNumber *string) (*BusinessResponse, error) {
+	documentNumber *string, accountNumber *string) (_ *BusinessResponse, err error) {
+	ctx, op := c.session.startOperation(ctx, "business", "FindAccounts", BusinessPath)
+	defer op.end(&err)
+	logger := op.logger
 
-	requestID, _ := ctx.Value("Request-Id").(string)
-	fields := logrus.Fields{
+	requestID := RequestIDFrom(ctx)
+	fields := Fields{
 		"request_id": requestID,
 		"interface":  "FindAccounts",
-		"service":    "business",
 	}
 
 	if documentNumber != nil {
@@ -49,7 +62,7 @@ func (c *Business) FindAccounts(ctx context.Context,
 
 	u, err := url.Parse(c.session.APIEndpoint)
 	if err != nil {
-		logrus.WithFields(fields).
+		logger.WithFields(fields).
 			WithError(err).Error("error api endpoint")
 		return nil, err
 	}
@@ -68,19 +81,19 @@ func (c *Business) FindAccounts(ctx context.Context,
 	u.RawQuery = q.Encode()
 	endpoint := u.String()
 
-	logrus.WithFields(fields).WithField("celcoin_endpoint", endpoint).
+	logger.WithFields(fields).WithField("celcoin_endpoint", endpoint).
 		Info("requesting business account data")
 
 	req, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error new request")
 		return nil, err
 	}
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error http client")
 		return nil, err
 	}
@@ -93,21 +106,21 @@ func (c *Business) FindAccounts(ctx context.Context,
 		var response BusinessResponse
 
 		if err := json.Unmarshal(respBody, &response); err != nil {
-			logrus.WithFields(fields).WithError(err).
+			logger.WithFields(fields).WithError(err).
 				Error("error unmarshal")
 			return nil, err
 		}
 
-		logrus.WithFields(fields).WithField("celcoin_response", response).
+		logger.WithFields(fields).WithField("celcoin_response", response).
 			Info("received celcoin response")
 
 		return &response, nil
 	} else if resp.StatusCode == http.StatusNotFound {
-		logrus.WithFields(fields).WithError(ErrEntryNotFound).
+		logger.WithFields(fields).WithError(ErrEntryNotFound).
 			Error("error entry not found - FindBusiness")
 		return nil, ErrEntryNotFound
 	} else if resp.StatusCode == http.StatusInternalServerError {
-		logrus.
+		logger.
 			WithFields(fields).
 			Error("internal server error - FindBusiness")
 		return nil, ErrDefaultBusinessAccounts
@@ -116,19 +129,19 @@ func (c *Business) FindAccounts(ctx context.Context,
 	var bodyErr *ErrorResponse
 
 	if err := json.Unmarshal(respBody, &bodyErr); err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error unmarshal")
 		return nil, err
 	}
 
 	if len(bodyErr.Errors) > 0 {
-		logrus.WithFields(fields).
+		logger.WithFields(fields).
 			Error("body error - FindBusiness")
 		errModel := bodyErr.Errors[0]
 		return nil, FindError(errModel.Code, errModel.Messages...)
 	}
 
-	logrus.
+	logger.
 		WithFields(fields).
 		Error("error default business accounts - FindBusiness")
 
@@ -136,110 +149,114 @@ func (c *Business) FindAccounts(ctx context.Context,
 }
 
 // CreateAccount ... cria uma nova conta de cliente
-func (c *Business) CreateAccount(ctx context.Context, businessData *BusinessOnboardingRequest) (*BusinessOnboardingResponse, error) {
-	requestID, _ := ctx.Value("Request-Id").(string)
-	fields := logrus.Fields{
+func (c *Business) CreateAccount(ctx context.Context, businessData *BusinessOnboardingRequest) (_ *BusinessOnboardingResponse, err error) {
+	ctx, op := c.session.startOperation(ctx, "business", "CreateAccount", LegalPersonOnboardingPath)
+	defer op.end(&err)
+	logger := op.logger
+	requestID := RequestIDFrom(ctx)
+	fields := Fields{
 		"request_id": requestID,
 		"interface":  "CreateAccount",
-		"service":    "business",
 	}
 
 	endpoint := c.session.APIEndpoint
 	reqBody, err := json.Marshal(businessData)
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error marshalling request body")
 		return nil, err
 	}
 
-	logrus.WithFields(fields).Info("request body marshalled successfully")
+	logger.WithFields(fields).Info("request body marshalled successfully")
 
 	url, err := url.Parse(endpoint)
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error parsing endpoint")
 		return nil, err
 	}
 
 	url.Path = path.Join(url.Path, LegalPersonOnboardingPath)
 
-	logrus.WithFields(fields).WithField("celcoin_endpoint", url.String()).
+	logger.WithFields(fields).WithField("celcoin_endpoint", url.String()).
 		WithField("celcoin_request", businessData).Info("requesting create business account")
 
 	req, err := http.NewRequestWithContext(ctx, "POST", url.String(), strings.NewReader(string(reqBody)))
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error creating request")
 		return nil, err
 	}
 
 	req.Header.Set("Content-Type", "application/json")
 
-	logrus.WithFields(fields).
+	logger.WithFields(fields).
 		Info("request created successfully")
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error executing request")
 		return nil, err
 	}
 	defer resp.Body.Close()
 
-	logrus.WithFields(fields).
+	logger.WithFields(fields).
 		Info("request executed successfully")
 
 	respBody, _ := ioutil.ReadAll(resp.Body)
 
-	logrus.WithFields(fields).
-		Infof("response received with status code %d", resp.StatusCode)
+	logger.WithFields(fields).
+		WithField("celcoin_status", resp.StatusCode).Info("response received")
 
 	if resp.StatusCode == http.StatusOK {
 		var response BusinessOnboardingResponse
 		if err := json.Unmarshal(respBody, &response); err != nil {
-			logrus.WithFields(fields).WithError(err).
+			logger.WithFields(fields).WithError(err).
 				Error("error unmarshalling response")
 			return nil, err
 		}
 
-		logrus.WithFields(fields).WithField("celcoin_response", response).
+		logger.WithFields(fields).WithField("celcoin_response", response).
 			Info("response with success")
 		return &response, nil
 	}
 
 	var errResponse *ErrorDefaultResponse
 	if err := json.Unmarshal(respBody, &errResponse); err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error unmarshalling error response")
 		return nil, err
 	}
 
 	if errResponse != nil && errResponse.Error != nil && len(*errResponse.Error.ErrorCode) > 0 {
 		err := FindOnboardingError(*errResponse.Error.ErrorCode, &resp.StatusCode)
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error creating business account")
 		return nil, err
 	}
 
-	logrus.WithFields(fields).
+	logger.WithFields(fields).
 		Error("error creating business account")
 	return nil, ErrDefaultBusinessAccounts
 }
 
 // GetLegalPersonOnboardingProposal ... consulta o proposalId
-func (c *Business) GetLegalPersonOnboardingProposal(ctx context.Context, proposalId string) (*OnboardingProposalResponseBody, error) {
-	requestID, _ := ctx.Value("Request-Id").(string)
-	fields := logrus.Fields{
+func (c *Business) GetLegalPersonOnboardingProposal(ctx context.Context, proposalId string) (_ *OnboardingProposalResponseBody, err error) {
+	ctx, op := c.session.startOperation(ctx, "business", "GetLegalPersonOnboardingProposal", ProposalsPath)
+	defer op.end(&err)
+	logger := op.logger
+	requestID := RequestIDFrom(ctx)
+	fields := Fields{
 		"request_id":  requestID,
 		"interface":   "GetLegalPersonOnboardingProposal",
-		"service":     "business",
 		"proposal_id": proposalId,
 	}
 
 	endpoint := c.session.APIEndpoint
 	u, err := url.Parse(endpoint)
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("error parsing endpoint")
+		logger.WithFields(fields).WithError(err).Error("error parsing endpoint")
 		return nil, err
 	}
 
@@ -249,19 +266,19 @@ func (c *Business) GetLegalPersonOnboardingProposal(ctx context.Context, proposa
 	q.Set("proposalId", proposalId)
 	u.RawQuery = q.Encode()
 
-	logrus.WithFields(fields).WithField("celcoin_endpoint", u.String()).
+	logger.WithFields(fields).WithField("celcoin_endpoint", u.String()).
 		Info("legal person proposal request")
 
 	req, err := http.NewRequestWithContext(ctx, "GET", u.String(), nil)
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error creating request")
 		return nil, err
 	}
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("error executing request")
+		logger.WithFields(fields).WithError(err).Error("error executing request")
 		return nil, err
 	}
 
@@ -272,49 +289,51 @@ func (c *Business) GetLegalPersonOnboardingProposal(ctx context.Context, proposa
 	if resp.StatusCode == http.StatusOK {
 		var response OnboardingProposalResponseBody
 		if err := json.Unmarshal(respBody, &response); err != nil {
-			logrus.WithFields(fields).WithError(err).
+			logger.WithFields(fields).WithError(err).
 				Error("error unmarshalling response")
 			return nil, err
 		}
 
-		logrus.WithFields(fields).WithField("celcoin_response", response).
+		logger.WithFields(fields).WithField("celcoin_response", response).
 			Info("response with success")
 		return &response, nil
 	}
 
 	var errResponse *ErrorDefaultResponse
 	if err := json.Unmarshal(respBody, &errResponse); err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error unmarshalling error response")
 		return nil, err
 	}
 
 	if errResponse != nil && errResponse.Error != nil && len(*errResponse.Error.ErrorCode) > 0 {
 		err := FindOnboardingError(*errResponse.Error.ErrorCode, &resp.StatusCode)
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error getting legal person onboarding proposal")
 		return nil, err
 	}
 
-	logrus.WithFields(fields).
+	logger.WithFields(fields).
 		Error("error getting legal person onboarding proposal")
 	return nil, ErrDefaultBusinessAccounts
 }
 
 // GetLegalPersonOnboardingProposalFiles ... consulta os arquivos do proposalId
-func (c *Business) GetLegalPersonOnboardingProposalFiles(ctx context.Context, proposalId string) (*OnboardingProposalFilesResponse, error) {
-	requestID, _ := ctx.Value("Request-Id").(string)
-	fields := logrus.Fields{
+func (c *Business) GetLegalPersonOnboardingProposalFiles(ctx context.Context, proposalId string) (_ *OnboardingProposalFilesResponse, err error) {
+	ctx, op := c.session.startOperation(ctx, "business", "GetLegalPersonOnboardingProposalFiles", ProposalFilesPath)
+	defer op.end(&err)
+	logger := op.logger
+	requestID := RequestIDFrom(ctx)
+	fields := Fields{
 		"request_id":  requestID,
 		"interface":   "GetLegalPersonOnboardingProposalFiles",
-		"service":     "business",
 		"proposal_id": proposalId,
 	}
 
 	endpoint := c.session.APIEndpoint
 	u, err := url.Parse(endpoint)
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error parsing endpoint")
 		return nil, err
 	}
@@ -325,19 +344,19 @@ func (c *Business) GetLegalPersonOnboardingProposalFiles(ctx context.Context, pr
 	q.Set("proposalId", proposalId)
 	u.RawQuery = q.Encode()
 
-	logrus.WithFields(fields).WithField("celcoin_endpoint", u.String()).
+	logger.WithFields(fields).WithField("celcoin_endpoint", u.String()).
 		Info("legal person proposal request")
 
 	req, err := http.NewRequestWithContext(ctx, "GET", u.String(), nil)
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error creating request")
 		return nil, err
 	}
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error executing request")
 		return nil, err
 	}
@@ -345,7 +364,7 @@ func (c *Business) GetLegalPersonOnboardingProposalFiles(ctx context.Context, pr
 
 	respBody, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error reading response body")
 		return nil, err
 	}
@@ -353,43 +372,45 @@ func (c *Business) GetLegalPersonOnboardingProposalFiles(ctx context.Context, pr
 	if resp.StatusCode == http.StatusOK {
 		var response OnboardingProposalFilesResponse
 		if err := json.Unmarshal(respBody, &response); err != nil {
-			logrus.WithFields(fields).WithError(err).
+			logger.WithFields(fields).WithError(err).
 				Error("error unmarshalling response")
 			return nil, err
 		}
 
-		logrus.WithFields(fields).WithField("celcoin_response", response).
+		logger.WithFields(fields).WithField("celcoin_response", response).
 			Info("response with success")
 		return &response, nil
 	}
 
 	var errResponse *ErrorDefaultResponse
 	if err := json.Unmarshal(respBody, &errResponse); err != nil {
-		logrus.WithFields(fields).WithError(err).Error("error unmarshalling error response")
+		logger.WithFields(fields).WithError(err).Error("error unmarshalling error response")
 		return nil, err
 	}
 
 	if errResponse != nil && errResponse.Error != nil && len(*errResponse.Error.ErrorCode) > 0 {
 		err := FindOnboardingError(*errResponse.Error.ErrorCode, &resp.StatusCode)
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error getting legal person onboarding proposal files")
 		return nil, err
 	}
 
-	logrus.WithFields(fields).
+	logger.WithFields(fields).
 		Error("error getting legal person onboarding proposal files")
 	return nil, ErrDefaultBusinessAccounts
 }
 
 // CancelAccount ... consulta os arquivos do proposalId
 func (c *Business) CancelAccount(ctx context.Context,
-	accountNumber *string, documentNumber *string, reason *string) (*CancelAccountResponse, error) {
+	accountNumber *string, documentNumber *string, reason *string) (_ *CancelAccountResponse, err error) {
+	ctx, op := c.session.startOperation(ctx, "business", "CancelAccount", CancelAccountPath)
+	defer op.end(&err)
+	logger := op.logger
 
-	requestID, _ := ctx.Value("Request-Id").(string)
-	fields := logrus.Fields{
+	requestID := RequestIDFrom(ctx)
+	fields := Fields{
 		"request_id": requestID,
 		"interface":  "CancelAccount",
-		"service":    "business",
 	}
 
 	if accountNumber != nil {
@@ -407,7 +428,7 @@ func (c *Business) CancelAccount(ctx context.Context,
 	endpoint := c.session.APIEndpoint
 	u, err := url.Parse(endpoint)
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error parsing endpoint")
 		return nil, err
 	}
@@ -429,19 +450,19 @@ func (c *Business) CancelAccount(ctx context.Context,
 
 	u.RawQuery = q.Encode()
 
-	logrus.WithFields(fields).WithField("celcoin_endpoint", u.String()).
+	logger.WithFields(fields).WithField("celcoin_endpoint", u.String()).
 		Info("celcoin cancel account request")
 
 	req, err := http.NewRequestWithContext(ctx, "DELETE", u.String(), nil)
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error creating request")
 		return nil, err
 	}
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error executing request")
 		return nil, err
 	}
@@ -449,7 +470,7 @@ func (c *Business) CancelAccount(ctx context.Context,
 
 	respBody, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error reading response body")
 		return nil, err
 	}
@@ -457,43 +478,45 @@ func (c *Business) CancelAccount(ctx context.Context,
 	if resp.StatusCode == http.StatusOK {
 		var response CancelAccountResponse
 		if err := json.Unmarshal(respBody, &response); err != nil {
-			logrus.WithFields(fields).WithError(err).
+			logger.WithFields(fields).WithError(err).
 				Error("error unmarshalling response")
 			return nil, err
 		}
 
-		logrus.WithFields(fields).WithField("celcoin_response", response).
+		logger.WithFields(fields).WithField("celcoin_response", response).
 			Info("response with success")
 		return &response, nil
 	}
 
 	var errResponse *ErrorDefaultResponse
 	if err := json.Unmarshal(respBody, &errResponse); err != nil {
-		logrus.WithFields(fields).WithError(err).Error("error unmarshalling error response")
+		logger.WithFields(fields).WithError(err).Error("error unmarshalling error response")
 		return nil, err
 	}
 
 	if errResponse != nil && errResponse.Error != nil && len(*errResponse.Error.ErrorCode) > 0 {
 		err := FindCancelAccountError(*errResponse.Error.ErrorCode, &resp.StatusCode)
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error cancel account")
 		return nil, err
 	}
 
-	logrus.WithFields(fields).
+	logger.WithFields(fields).
 		Error("error cancel account")
 	return nil, ErrDefaultBusinessAccounts
 }
 
 // UpdateAccountStatus ... faz atualização do status da conta
 func (c *Business) UpdateAccountStatus(ctx context.Context,
-	accountNumber *string, documentNumber *string, reason *string, status *string) (*UpdateAccountStatusResponse, error) {
+	accountNumber *string, documentNumber *string, reason *string, status *string) (_ *UpdateAccountStatusResponse, err error) {
+	ctx, op := c.session.startOperation(ctx, "business", "UpdateAccountStatus", UpdateAccountStatusPath)
+	defer op.end(&err)
+	logger := op.logger
 
-	requestID, _ := ctx.Value("Request-Id").(string)
-	fields := logrus.Fields{
+	requestID := RequestIDFrom(ctx)
+	fields := Fields{
 		"request_id": requestID,
 		"interface":  "CancelAccount",
-		"service":    "business",
 	}
 
 	if accountNumber != nil {
@@ -507,7 +530,7 @@ func (c *Business) UpdateAccountStatus(ctx context.Context,
 	endpoint := c.session.APIEndpoint
 	u, err := url.Parse(endpoint)
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error parsing endpoint")
 		return nil, err
 	}
@@ -525,7 +548,7 @@ func (c *Business) UpdateAccountStatus(ctx context.Context,
 
 	u.RawQuery = q.Encode()
 
-	logrus.WithFields(fields).WithField("celcoin_endpoint", u.String()).
+	logger.WithFields(fields).WithField("celcoin_endpoint", u.String()).
 		Info("celcoin update account status request")
 
 	bodyPayload := struct {
@@ -538,14 +561,14 @@ func (c *Business) UpdateAccountStatus(ctx context.Context,
 
 	reqBody, err := json.Marshal(bodyPayload)
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error marshalling request body")
 		return nil, err
 	}
 
 	req, err := http.NewRequestWithContext(ctx, "PUT", u.String(), strings.NewReader(string(reqBody)))
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error creating request")
 		return nil, err
 	}
@@ -554,7 +577,7 @@ func (c *Business) UpdateAccountStatus(ctx context.Context,
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error executing request")
 		return nil, err
 	}
@@ -562,7 +585,7 @@ func (c *Business) UpdateAccountStatus(ctx context.Context,
 
 	respBody, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error reading response body")
 		return nil, err
 	}
@@ -570,121 +593,123 @@ func (c *Business) UpdateAccountStatus(ctx context.Context,
 	if resp.StatusCode == http.StatusOK {
 		var response UpdateAccountStatusResponse
 		if err := json.Unmarshal(respBody, &response); err != nil {
-			logrus.WithFields(fields).WithError(err).
+			logger.WithFields(fields).WithError(err).
 				Error("error unmarshalling response")
 			return nil, err
 		}
 
-		logrus.WithFields(fields).WithField("celcoin_response", response).
+		logger.WithFields(fields).WithField("celcoin_response", response).
 			Info("response with success")
 		return &response, nil
 	}
 
 	var errResponse *ErrorDefaultResponse
 	if err := json.Unmarshal(respBody, &errResponse); err != nil {
-		logrus.WithFields(fields).WithError(err).Error("error unmarshalling error response")
+		logger.WithFields(fields).WithError(err).Error("error unmarshalling error response")
 		return nil, err
 	}
 
 	if errResponse != nil && errResponse.Error != nil && len(*errResponse.Error.ErrorCode) > 0 {
 		err := FindUpdateAccountStatusAccountErrors(*errResponse.Error.ErrorCode, &resp.StatusCode)
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error updating account status")
 		return nil, err
 	}
 
-	logrus.WithFields(fields).
+	logger.WithFields(fields).
 		Error("error updating account status")
 	return nil, ErrDefaultBusinessAccounts
 }
 
 // CreateAccountMigration ... cria uma nova conta de cliente
-func (c *Business) CreateAccountMigration(ctx context.Context, businessData *BusinessOnboardingMigrationRequest) (*BusinessOnboardingResponse, error) {
-	requestID, _ := ctx.Value("Request-Id").(string)
-	fields := logrus.Fields{
+func (c *Business) CreateAccountMigration(ctx context.Context, businessData *BusinessOnboardingMigrationRequest) (_ *BusinessOnboardingResponse, err error) {
+	ctx, op := c.session.startOperation(ctx, "business", "CreateAccountMigration", LegalPersonOnboardingPath)
+	defer op.end(&err)
+	logger := op.logger
+	requestID := RequestIDFrom(ctx)
+	fields := Fields{
 		"request_id": requestID,
 		"interface":  "CreateAccount",
-		"service":    "business",
 	}
 
 	endpoint := c.session.APIEndpoint
 	reqBody, err := json.Marshal(businessData)
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error marshalling request body")
 		return nil, err
 	}
 
-	logrus.WithFields(fields).Info("request body marshalled successfully")
+	logger.WithFields(fields).Info("request body marshalled successfully")
 
 	url, err := url.Parse(endpoint)
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error parsing endpoint")
 		return nil, err
 	}
 
 	url.Path = path.Join(url.Path, LegalPersonOnboardingPath)
 
-	logrus.WithFields(fields).WithField("celcoin_endpoint", url.String()).
+	logger.WithFields(fields).WithField("celcoin_endpoint", url.String()).
 		WithField("celcoin_request", businessData).Info("requesting create business account")
 
 	req, err := http.NewRequestWithContext(ctx, "POST", url.String(), strings.NewReader(string(reqBody)))
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error creating request")
 		return nil, err
 	}
 
 	req.Header.Set("Content-Type", "application/json")
 
-	logrus.WithFields(fields).
+	logger.WithFields(fields).
 		Info("request created successfully")
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error executing request")
 		return nil, err
 	}
 	defer resp.Body.Close()
 
-	logrus.WithFields(fields).
+	logger.WithFields(fields).
 		Info("request executed successfully")
 
 	respBody, _ := ioutil.ReadAll(resp.Body)
 
-	logrus.WithFields(fields).
-		Infof("response received with status code %d", resp.StatusCode)
+	logger.WithFields(fields).
+		WithField("celcoin_status", resp.StatusCode).Info("response received")
 
 	if resp.StatusCode == http.StatusOK {
 		var response BusinessOnboardingResponse
 		if err := json.Unmarshal(respBody, &response); err != nil {
-			logrus.WithFields(fields).WithError(err).
+			logger.WithFields(fields).WithError(err).
 				Error("error unmarshalling response")
 			return nil, err
 		}
 
-		logrus.WithFields(fields).WithField("celcoin_response", response).
+		logger.WithFields(fields).WithField("celcoin_response", response).
 			Info("response with success")
 		return &response, nil
 	}
 
 	var errResponse *ErrorDefaultResponse
 	if err := json.Unmarshal(respBody, &errResponse); err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error unmarshalling error response")
 		return nil, err
 	}
 
 	if errResponse != nil && errResponse.Error != nil && len(*errResponse.Error.ErrorCode) > 0 {
 		err := FindOnboardingError(*errResponse.Error.ErrorCode, &resp.StatusCode)
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error creating business account")
 		return nil, err
 	}
 
-	logrus.WithFields(fields).
+	logger.WithFields(fields).
 		Error("error creating business account")
 	return nil, ErrDefaultBusinessAccounts
 }
@@ -21,7 +21,7 @@ type BusinessTestSuite struct {
 	assert     *assert.Assertions
 	ctx        context.Context
 	session    *Session
-	business   *Business
+	business   BusinessInterface
 	mockClient *MockHTTPClient
 }
 
@@ -0,0 +1,608 @@
+package celcoin
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/tidwall/sjson"
+)
+
+// CassetteMode ... gravação ou reprodução das interações HTTP
+type CassetteMode string
+
+const (
+	// CassetteModeReplay ... reproduz as interações gravadas, sem acessar a rede
+	CassetteModeReplay CassetteMode = "replay"
+	// CassetteModeRecord ... envia as requisições à Celcoin e grava as interações (Save)
+	CassetteModeRecord CassetteMode = "record"
+
+	// CassetteModeEnv ... variável de ambiente com o modo usado quando NewCassette recebe um modo vazio
+	CassetteModeEnv = "CELCOIN_CASSETTE_MODE"
+
+	// cassetteVersion ... versão do formato do arquivo
+	cassetteVersion = 1
+	// cassetteMaxDifferences ... diferenças listadas no erro de requisição não gravada
+	cassetteMaxDifferences = 10
+)
+
+var (
+	// ErrCassetteNotFound ... arquivo do cassette não encontrado no modo replay
+	ErrCassetteNotFound = errors.New("cassette not found")
+	// ErrCassetteMismatch ... nenhuma interação gravada corresponde à requisição
+	ErrCassetteMismatch = errors.New("no cassette interaction matches the request")
+	// ErrInvalidCassetteMode ...
+	ErrInvalidCassetteMode = errors.New("invalid cassette mode")
+
+	// cassetteRedactedFormFields ... além das regras de log, o client_id e os dados pessoais enviados na query
+	// string (ex.: consulta ao DICT) não são gravados; esses parâmetros deixam de diferenciar as requisições
+	cassetteRedactedFormFields = []string{
+		"client_id",
+		"key",
+		"ownerTaxId",
+		"taxId",
+		"documentNumber",
+		"cpf",
+		"cnpj",
+		"email",
+		"name",
+	}
+
+	// cassetteKeptJSONKeys ... chaves mascaradas nos logs, mas mantidas nos cassettes
+	cassetteKeptJSONKeys = []string{"account", "accountNumber", "branch"}
+
+	// cassetteRedactedPaths ... caminhos com dados pessoais em segmentos (a chave Pix na exclusão e a conta na listagem)
+	cassetteRedactedPaths = []string{
+		PixDictPath + "/{key}",
+	}
+)
+
+// Cassette ... grava interações reais com a Celcoin (sandbox) em um arquivo JSON e as reproduz nos testes.
+// Tokens e dados pessoais são mascarados com as regras de Redaction antes da gravação; na reprodução, cada
+// interação é usada uma vez e a requisição precisa corresponder em método, caminho, query e corpo JSON
+// (após o mesmo mascaramento). Informe o cassette em Config.Cassette para usá-lo em qualquer serviço.
+type Cassette struct {
+	// Path ... arquivo do cassette
+	Path string
+	// Mode ... CassetteModeReplay ou CassetteModeRecord
+	Mode CassetteMode
+	// Redaction ... regras de mascaramento de cabeçalhos, query, formulários e JSON (padrão DefaultLogPolicy)
+	Redaction *LogPolicy
+	// RedactPaths ... templates de caminho cujos segmentos {nome} são mascarados, além dos caminhos do SDK que
+	// levam dados pessoais (ex.: PixDictPath+"/{key}"). Como na query, esses segmentos deixam de diferenciar
+	// as requisições.
+	RedactPaths []string
+	// IgnoreJSONPaths ... caminhos gjson/sjson do corpo das requisições ignorados na comparação
+	// (ex.: "clientCode" quando gerado pelo teste)
+	IgnoreJSONPaths []string
+
+	mutex        sync.Mutex
+	interactions []CassetteInteraction
+	replayed     []bool
+}
+
+// CassetteInteraction ... requisição e resposta gravadas
+type CassetteInteraction struct {
+	Request    CassetteRequest  `json:"request"`
+	Response   CassetteResponse `json:"response"`
+	RecordedAt time.Time        `json:"recordedAt"`
+}
+
+// CassetteRequest ...
+type CassetteRequest struct {
+	Method string       `json:"method"`
+	URL    string       `json:"url"`
+	Header http.Header  `json:"header,omitempty"`
+	Body   CassetteBody `json:"body"`
+}
+
+// CassetteResponse ...
+type CassetteResponse struct {
+	StatusCode int          `json:"statusCode"`
+	Header     http.Header  `json:"header,omitempty"`
+	Body       CassetteBody `json:"body"`
+}
+
+// CassetteBody ... corpo gravado: JSON como JSON, texto como string e conteúdo binário (ex.: PDF) em base64
+type CassetteBody struct {
+	JSON   json.RawMessage `json:"json,omitempty"`
+	Text   string          `json:"text,omitempty"`
+	Base64 []byte          `json:"base64,omitempty"`
+}
+
+// cassetteFile ... formato do arquivo do cassette
+type cassetteFile struct {
+	Version      int                   `json:"version"`
+	Interactions []CassetteInteraction `json:"interactions"`
+}
+
+// NewCassette ... cassette do arquivo informado. Com modo vazio, usa CassetteModeEnv ou, sem a variável,
+// CassetteModeReplay. No modo replay o arquivo precisa existir.
+func NewCassette(path string, mode CassetteMode) (*Cassette, error) {
+	if len(mode) == 0 {
+		mode = CassetteMode(strings.ToLower(os.Getenv(CassetteModeEnv)))
+	}
+	if len(mode) == 0 {
+		mode = CassetteModeReplay
+	}
+
+	cassette := &Cassette{Path: path, Mode: mode}
+	switch mode {
+	case CassetteModeRecord:
+		return cassette, nil
+	case CassetteModeReplay:
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrInvalidCassetteMode, mode)
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("%w: %s (record it with %s=%s)", ErrCassetteNotFound, path, CassetteModeEnv, CassetteModeRecord)
+	}
+	if err != nil {
+		return nil, err
+	}
+	var file cassetteFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("cassette %s: %w", path, err)
+	}
+	cassette.interactions = file.Interactions
+	cassette.replayed = make([]bool, len(file.Interactions))
+	return cassette, nil
+}
+
+// Transport ... RoundTripper que grava as requisições enviadas ao transporte base ou as reproduz do cassette
+func (c *Cassette) Transport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &cassetteTransport{cassette: c, base: base}
+}
+
+// Save ... grava o arquivo do cassette no modo record; no modo replay não faz nada
+func (c *Cassette) Save() error {
+	if c.Mode != CassetteModeRecord {
+		return nil
+	}
+
+	c.mutex.Lock()
+	data, err := json.MarshalIndent(cassetteFile{Version: cassetteVersion, Interactions: c.interactions}, "", "  ")
+	c.mutex.Unlock()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(c.Path), 0o755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(c.Path, append(data, '\n'), 0o644)
+}
+
+// Interactions ... interações gravadas ou carregadas
+func (c *Cassette) Interactions() []CassetteInteraction {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return append([]CassetteInteraction(nil), c.interactions...)
+}
+
+// Unplayed ... interações ainda não reproduzidas; útil para verificar se o teste fez todas as chamadas gravadas
+func (c *Cassette) Unplayed() []CassetteInteraction {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	var unplayed []CassetteInteraction
+	for i, interaction := range c.interactions {
+		if !c.replayed[i] {
+			unplayed = append(unplayed, interaction)
+		}
+	}
+	return unplayed
+}
+
+// redaction ...
+func (c *Cassette) redaction() *LogPolicy {
+	policy := DefaultLogPolicy()
+	if c.Redaction != nil {
+		copied := *c.Redaction
+		policy = &copied
+	}
+	policy.RedactFormFields = append(append([]string{}, policy.RedactFormFields...), cassetteRedactedFormFields...)
+	// As contas diferenciam as requisições e, nas respostas, são objetos que precisam ser decodificados
+	policy.keepJSONKeys = cassetteKeptJSONKeys
+	return policy
+}
+
+// redactPath ... URL com os segmentos dos templates de RedactPaths e cassetteRedactedPaths mascarados
+func (c *Cassette) redactPath(u *url.URL) *url.URL {
+	templates := append(append([]string{}, cassetteRedactedPaths...), c.RedactPaths...)
+	return redactPathSegments(u, templates, func(string) bool { return true })
+}
+
+// record ... envia a requisição e grava a interação mascarada
+func (c *Cassette) record(base http.RoundTripper, req *http.Request) (*http.Response, error) {
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(respBody))
+
+	policy := c.redaction()
+	interaction := CassetteInteraction{
+		Request: newCassetteRequest(policy, c.redactPath(req.URL), req, body),
+		Response: CassetteResponse{
+			StatusCode: resp.StatusCode,
+			Header:     policy.redactHeaders(resp.Header),
+			Body:       newCassetteBody(policy, resp.Header.Get("Content-Type"), respBody),
+		},
+		RecordedAt: time.Now().UTC(),
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.interactions = append(c.interactions, interaction)
+	c.replayed = append(c.replayed, true)
+	return resp, nil
+}
+
+// replay ... primeira interação ainda não reproduzida que corresponde à requisição
+func (c *Cassette) replay(req *http.Request) (*http.Response, error) {
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	incoming := newCassetteRequest(c.redaction(), c.redactPath(req.URL), req, body)
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	mismatch := &CassetteMismatchError{Cassette: c.Path, Method: incoming.Method, URL: incoming.URL, Closest: -1}
+	for i, interaction := range c.interactions {
+		differences := c.differences(interaction.Request, incoming)
+		if len(differences) == 0 {
+			if c.replayed[i] {
+				mismatch.Replayed++
+				continue
+			}
+			c.replayed[i] = true
+			return interaction.Response.httpResponse(req), nil
+		}
+		if !c.replayed[i] && closer(interaction.Request, incoming, differences, mismatch) {
+			mismatch.Closest = i
+			mismatch.Differences = differences
+		}
+	}
+	return nil, mismatch
+}
+
+// closer ... a interação é mais parecida com a requisição do que a mais próxima encontrada até agora;
+// interações do mesmo método e caminho têm preferência
+func closer(recorded, incoming CassetteRequest, differences []string, mismatch *CassetteMismatchError) bool {
+	if !sameEndpoint(recorded, incoming) {
+		return false
+	}
+	return mismatch.Closest < 0 || len(differences) < len(mismatch.Differences)
+}
+
+// sameEndpoint ...
+func sameEndpoint(recorded, incoming CassetteRequest) bool {
+	return recorded.Method == incoming.Method && cassettePath(recorded.URL) == cassettePath(incoming.URL)
+}
+
+// differences ... diferenças entre a requisição gravada e a recebida, no formato "campo: recorded x, got y"
+func (c *Cassette) differences(recorded, incoming CassetteRequest) []string {
+	var differences []string
+	if recorded.Method != incoming.Method {
+		differences = append(differences, fmt.Sprintf("method: recorded %s, got %s", recorded.Method, incoming.Method))
+	}
+	if recordedPath, incomingPath := cassettePath(recorded.URL), cassettePath(incoming.URL); recordedPath != incomingPath {
+		differences = append(differences, fmt.Sprintf("path: recorded %s, got %s", recordedPath, incomingPath))
+	}
+
+	recordedQuery, incomingQuery := cassetteQuery(recorded.URL), cassetteQuery(incoming.URL)
+	for _, key := range unionKeys(recordedQuery, incomingQuery) {
+		if !reflect.DeepEqual(recordedQuery[key], incomingQuery[key]) {
+			differences = append(differences, fmt.Sprintf("query.%s: recorded %s, got %s",
+				key, describeValues(recordedQuery[key]), describeValues(incomingQuery[key])))
+		}
+	}
+
+	differences = append(differences, c.bodyDifferences(recorded.Body, incoming.Body)...)
+	if len(differences) > cassetteMaxDifferences {
+		differences = append(differences[:cassetteMaxDifferences],
+			fmt.Sprintf("... and %d more", len(differences)-cassetteMaxDifferences))
+	}
+	return differences
+}
+
+// bodyDifferences ... corpos JSON são comparados campo a campo, ignorando IgnoreJSONPaths
+func (c *Cassette) bodyDifferences(recorded, incoming CassetteBody) []string {
+	if len(recorded.JSON) > 0 && len(incoming.JSON) > 0 {
+		var recordedValue, incomingValue interface{}
+		json.Unmarshal(c.withoutIgnoredPaths(recorded.JSON), &recordedValue)
+		json.Unmarshal(c.withoutIgnoredPaths(incoming.JSON), &incomingValue)
+
+		var differences []string
+		diffJSON("body", recordedValue, incomingValue, &differences)
+		return differences
+	}
+
+	recordedData, incomingData := recorded.bytes(), incoming.bytes()
+	if bytes.Equal(recordedData, incomingData) {
+		return nil
+	}
+	return []string{fmt.Sprintf("body: recorded %s, got %s", describeBody(recordedData), describeBody(incomingData))}
+}
+
+// withoutIgnoredPaths ...
+func (c *Cassette) withoutIgnoredPaths(data json.RawMessage) []byte {
+	str := string(data)
+	for _, path := range c.IgnoreJSONPaths {
+		str, _ = sjson.Delete(str, path)
+	}
+	return []byte(str)
+}
+
+// CassetteMismatchError ... requisição sem interação correspondente no cassette, com as diferenças para a
+// interação gravada mais parecida
+type CassetteMismatchError struct {
+	Cassette string
+	Method   string
+	URL      string
+	// Closest ... índice da interação não reproduzida mais parecida (mesmo método e caminho); -1 quando não há
+	Closest     int
+	Differences []string
+	// Replayed ... interações idênticas que já foram reproduzidas
+	Replayed int
+}
+
+// Error ...
+func (e *CassetteMismatchError) Error() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "cassette %s: no interaction matches %s %s", e.Cassette, e.Method, e.URL)
+	if e.Replayed > 0 {
+		fmt.Fprintf(&sb, " (%d identical interaction(s) already replayed)", e.Replayed)
+	}
+	if e.Closest < 0 {
+		sb.WriteString("; no unplayed interaction with the same method and path")
+		return sb.String()
+	}
+	fmt.Fprintf(&sb, "; closest is interaction #%d: %s", e.Closest, strings.Join(e.Differences, "; "))
+	return sb.String()
+}
+
+// Unwrap ...
+func (e *CassetteMismatchError) Unwrap() error {
+	return ErrCassetteMismatch
+}
+
+// cassetteTransport ...
+type cassetteTransport struct {
+	cassette *Cassette
+	base     http.RoundTripper
+}
+
+// RoundTrip ...
+func (t *cassetteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.cassette.Mode == CassetteModeRecord {
+		return t.cassette.record(t.base, req)
+	}
+	return t.cassette.replay(req)
+}
+
+// CloseIdleConnections ...
+func (t *cassetteTransport) CloseIdleConnections() {
+	if closer, ok := t.base.(interface{ CloseIdleConnections() }); ok {
+		closer.CloseIdleConnections()
+	}
+}
+
+// newCassetteRequest ... requisição mascarada, no formato gravado; u é a URL com o caminho já mascarado
+func newCassetteRequest(policy *LogPolicy, u *url.URL, req *http.Request, body []byte) CassetteRequest {
+	return CassetteRequest{
+		Method: req.Method,
+		URL:    policy.redactURL(u),
+		Header: policy.redactHeaders(req.Header),
+		Body:   newCassetteBody(policy, req.Header.Get("Content-Type"), body),
+	}
+}
+
+// newCassetteBody ... corpo mascarado conforme o Content-Type, sem o limite de tamanho dos logs
+func newCassetteBody(policy *LogPolicy, contentType string, data []byte) CassetteBody {
+	if len(data) == 0 {
+		return CassetteBody{}
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	switch {
+	case mediaType == "application/x-www-form-urlencoded":
+		if values, err := url.ParseQuery(string(data)); err == nil {
+			return CassetteBody{Text: policy.redactValues(values).Encode()}
+		}
+	case json.Valid(data):
+		return CassetteBody{JSON: json.RawMessage(policy.redactJSON(string(data)))}
+	}
+	if utf8.Valid(data) {
+		return CassetteBody{Text: string(data)}
+	}
+	return CassetteBody{Base64: data}
+}
+
+// bytes ...
+func (b CassetteBody) bytes() []byte {
+	switch {
+	case len(b.JSON) > 0:
+		return b.JSON
+	case len(b.Base64) > 0:
+		return b.Base64
+	}
+	return []byte(b.Text)
+}
+
+// httpResponse ... resposta reproduzida para a requisição
+func (r CassetteResponse) httpResponse(req *http.Request) *http.Response {
+	body := r.Body.bytes()
+	header := r.Header.Clone()
+	if header == nil {
+		header = make(http.Header)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", r.StatusCode, http.StatusText(r.StatusCode)),
+		StatusCode:    r.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+// readRequestBody ... lê o corpo e o restaura para o envio
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		defer body.Close()
+		return ioutil.ReadAll(body)
+	}
+
+	data, err := ioutil.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	req.Body = ioutil.NopCloser(bytes.NewReader(data))
+	return data, nil
+}
+
+// cassettePath ...
+func cassettePath(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+	return "/" + strings.Trim(u.Path, "/")
+}
+
+// cassetteQuery ...
+func cassetteQuery(rawURL string) url.Values {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil
+	}
+	return u.Query()
+}
+
+// unionKeys ... chaves das duas queries, ordenadas
+func unionKeys(a, b url.Values) []string {
+	seen := make(map[string]bool)
+	for key := range a {
+		seen[key] = true
+	}
+	for key := range b {
+		seen[key] = true
+	}
+	keys := make([]string, 0, len(seen))
+	for key := range seen {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// diffJSON ... caminhos em que os valores JSON diferem
+func diffJSON(path string, recorded, incoming interface{}, differences *[]string) {
+	recordedObject, recordedIsObject := recorded.(map[string]interface{})
+	incomingObject, incomingIsObject := incoming.(map[string]interface{})
+	if recordedIsObject && incomingIsObject {
+		keys := make(map[string]bool)
+		for key := range recordedObject {
+			keys[key] = true
+		}
+		for key := range incomingObject {
+			keys[key] = true
+		}
+		sorted := make([]string, 0, len(keys))
+		for key := range keys {
+			sorted = append(sorted, key)
+		}
+		sort.Strings(sorted)
+		for _, key := range sorted {
+			diffJSON(path+"."+key, recordedObject[key], incomingObject[key], differences)
+		}
+		return
+	}
+
+	recordedArray, recordedIsArray := recorded.([]interface{})
+	incomingArray, incomingIsArray := incoming.([]interface{})
+	if recordedIsArray && incomingIsArray && len(recordedArray) == len(incomingArray) {
+		for i := range recordedArray {
+			diffJSON(fmt.Sprintf("%s.%d", path, i), recordedArray[i], incomingArray[i], differences)
+		}
+		return
+	}
+
+	if !reflect.DeepEqual(recorded, incoming) {
+		*differences = append(*differences, fmt.Sprintf("%s: recorded %s, got %s", path, describeJSON(recorded), describeJSON(incoming)))
+	}
+}
+
+// describeJSON ...
+func describeJSON(v interface{}) string {
+	if v == nil {
+		return "<absent>"
+	}
+	return truncateDescription(marshal(v))
+}
+
+// describeValues ...
+func describeValues(values []string) string {
+	if values == nil {
+		return "<absent>"
+	}
+	return truncateDescription(strings.Join(values, ","))
+}
+
+// describeBody ...
+func describeBody(data []byte) string {
+	if len(data) == 0 {
+		return "<empty>"
+	}
+	return fmt.Sprintf("%q", truncateDescription(string(data)))
+}
+
+// truncateDescription ... limita os valores exibidos no erro
+func truncateDescription(value string) string {
+	const limit = 80
+	if len(value) <= limit {
+		return value
+	}
+	return truncateUTF8(value, limit) + "..."
+}
@@ -0,0 +1,246 @@
+package celcoin_test
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/contbank/celcoin-sdk"
+)
+
+// CassetteTestSuite ... gravação contra o MockServer e reprodução sem rede
+type CassetteTestSuite struct {
+	suite.Suite
+	assert *assert.Assertions
+	ctx    context.Context
+	server *celcoin.MockServer
+	path   string
+	payer  celcoin.MockAccount
+	key    celcoin.MockPixKey
+}
+
+func TestCassetteTestSuite(t *testing.T) {
+	suite.Run(t, new(CassetteTestSuite))
+}
+
+func (s *CassetteTestSuite) SetupTest() {
+	s.assert = assert.New(s.T())
+	s.ctx = context.Background()
+	s.server = celcoin.NewMockServer()
+	s.path = filepath.Join(s.T().TempDir(), "cassettes", "pix.json")
+
+	s.payer = s.server.AddAccount(celcoin.MockAccount{
+		DocumentNumber: "12345678909",
+		Name:           "Maria Pagadora",
+		Balance:        100,
+	})
+	payee := s.server.AddAccount(celcoin.MockAccount{
+		DocumentNumber: "98765432100",
+		Name:           "Joao Recebedor",
+	})
+	key, err := s.server.AddPixKey(celcoin.MockPixKey{Key: "joao@example.com", KeyType: "EMAIL", Account: payee.Account})
+	s.Require().NoError(err)
+	s.key = key
+}
+
+func (s *CassetteTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+// client ... cliente que usa o cassette
+func (s *CassetteTestSuite) client(cassette *celcoin.Cassette) *celcoin.Client {
+	config := s.server.Config()
+	config.Cassette = cassette
+
+	client, err := celcoin.NewClient(config)
+	s.Require().NoError(err)
+	return client
+}
+
+// record ... grava uma consulta de saldo e uma consulta ao DICT
+func (s *CassetteTestSuite) record() {
+	cassette, err := celcoin.NewCassette(s.path, celcoin.CassetteModeRecord)
+	s.Require().NoError(err)
+	client := s.client(cassette)
+
+	balance, err := client.Balance.Balance(s.ctx, s.payer.Account)
+	s.Require().NoError(err)
+	s.assert.Equal(100.0, balance.Body.Amount)
+
+	entry, err := client.Pix.GetExternalPixKey(s.ctx, s.payer.Account, s.key.Key, s.payer.DocumentNumber)
+	s.Require().NoError(err)
+	s.assert.Equal("Joao Recebedor", entry.Body.Owner.Name)
+
+	s.Require().NoError(cassette.Save())
+}
+
+// replay ...
+func (s *CassetteTestSuite) replay() (*celcoin.Cassette, *celcoin.Client) {
+	cassette, err := celcoin.NewCassette(s.path, celcoin.CassetteModeReplay)
+	s.Require().NoError(err)
+	return cassette, s.client(cassette)
+}
+
+func (s *CassetteTestSuite) TestRecordRedactsCredentialsAndPersonalData() {
+	s.record()
+
+	data, err := ioutil.ReadFile(s.path)
+	s.Require().NoError(err)
+	content := string(data)
+
+	s.assert.Contains(content, celcoin.RedactedValue)
+	s.assert.Contains(content, s.payer.Account)
+	for _, secret := range []string{celcoin.MockClientID, celcoin.MockClientSecret, "Bearer ",
+		s.payer.DocumentNumber, "98765432100", "Joao Recebedor", s.key.Key} {
+		s.assert.NotContains(content, secret)
+	}
+}
+
+func (s *CassetteTestSuite) TestRecordRedactsPathSegments() {
+	cassette, err := celcoin.NewCassette(s.path, celcoin.CassetteModeRecord)
+	s.Require().NoError(err)
+	cassette.RedactPaths = []string{celcoin.PixDictExternalEntryV2Path + "/{account}"}
+	client := s.client(cassette)
+
+	_, err = client.Pix.GetExternalPixKey(s.ctx, s.payer.Account, s.key.Key, s.payer.DocumentNumber)
+	s.Require().NoError(err)
+	s.Require().NoError(client.Pix.DeletePixKey(s.ctx, s.key.Account, s.key.Key))
+	s.Require().NoError(cassette.Save())
+
+	interactions := cassette.Interactions()
+	urls := make([]string, 0, len(interactions))
+	for _, interaction := range interactions {
+		urls = append(urls, interaction.Request.URL)
+		s.assert.NotContains(interaction.Request.URL, s.key.Key)
+		s.assert.NotContains(interaction.Request.URL, s.payer.Account)
+	}
+	s.assert.Contains(strings.Join(urls, " "), celcoin.PixDictExternalEntryV2Path+"/"+celcoin.RedactedValue+"?")
+	s.assert.Contains(strings.Join(urls, " "), celcoin.PixDictPath+"/"+celcoin.RedactedValue)
+
+	// Na reprodução o caminho recebido passa pelo mesmo mascaramento
+	s.server.Close()
+	replay, err := celcoin.NewCassette(s.path, celcoin.CassetteModeReplay)
+	s.Require().NoError(err)
+	replay.RedactPaths = cassette.RedactPaths
+	client = s.client(replay)
+
+	_, err = client.Pix.GetExternalPixKey(s.ctx, s.payer.Account, s.key.Key, s.payer.DocumentNumber)
+	s.Require().NoError(err)
+	s.Require().NoError(client.Pix.DeletePixKey(s.ctx, s.key.Account, s.key.Key))
+	s.assert.Empty(replay.Unplayed())
+}
+
+func (s *CassetteTestSuite) TestReplayDoesNotUseTheNetwork() {
+	s.record()
+	s.server.Close()
+
+	cassette, client := s.replay()
+
+	balance, err := client.Balance.Balance(s.ctx, s.payer.Account)
+	s.Require().NoError(err)
+	s.assert.Equal(100.0, balance.Body.Amount)
+
+	entry, err := client.Pix.GetExternalPixKey(s.ctx, s.payer.Account, s.key.Key, s.payer.DocumentNumber)
+	s.Require().NoError(err)
+	s.assert.NotEmpty(entry.Body.EndToEndId)
+	s.assert.Equal(celcoin.RedactedValue, entry.Body.Owner.Name)
+
+	s.assert.Empty(cassette.Unplayed())
+}
+
+func (s *CassetteTestSuite) TestReplayRejectsUnrecordedRequest() {
+	s.record()
+	s.server.Close()
+
+	_, client := s.replay()
+
+	_, err := client.Balance.Balance(s.ctx, "300000999")
+	s.Require().Error(err)
+	s.assert.True(errors.Is(err, celcoin.ErrCassetteMismatch))
+
+	var mismatch *celcoin.CassetteMismatchError
+	s.Require().True(errors.As(err, &mismatch))
+	s.assert.Contains(mismatch.Error(), "query.account: recorded "+s.payer.Account+", got 300000999")
+}
+
+func (s *CassetteTestSuite) TestReplayUsesEachInteractionOnce() {
+	s.record()
+	s.server.Close()
+
+	_, client := s.replay()
+
+	_, err := client.Balance.Balance(s.ctx, s.payer.Account)
+	s.Require().NoError(err)
+
+	_, err = client.Balance.Balance(s.ctx, s.payer.Account)
+	s.Require().Error(err)
+	s.assert.True(errors.Is(err, celcoin.ErrCassetteMismatch))
+	s.assert.Contains(err.Error(), "already replayed")
+}
+
+func (s *CassetteTestSuite) TestReplayWithoutCassetteFile() {
+	_, err := celcoin.NewCassette(s.path, celcoin.CassetteModeReplay)
+	s.assert.True(errors.Is(err, celcoin.ErrCassetteNotFound))
+}
+
+func (s *CassetteTestSuite) TestModeFromEnvironment() {
+	s.T().Setenv(celcoin.CassetteModeEnv, "record")
+
+	cassette, err := celcoin.NewCassette(s.path, "")
+	s.Require().NoError(err)
+	s.assert.Equal(celcoin.CassetteModeRecord, cassette.Mode)
+
+	s.T().Setenv(celcoin.CassetteModeEnv, "rewind")
+	_, err = celcoin.NewCassette(s.path, "")
+	s.assert.True(errors.Is(err, celcoin.ErrInvalidCassetteMode))
+}
+
+func (s *CassetteTestSuite) TestReplayMatchesJSONBody() {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":"PROCESSING"}`))
+	}))
+	defer upstream.Close()
+
+	post := func(transport http.RoundTripper, body string) (*http.Response, error) {
+		req, err := http.NewRequest(http.MethodPost, upstream.URL+"/baas/v2/pix/payment", strings.NewReader(body))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/json")
+		return transport.RoundTrip(req)
+	}
+
+	recorder, err := celcoin.NewCassette(s.path, celcoin.CassetteModeRecord)
+	s.Require().NoError(err)
+	recorded, err := post(recorder.Transport(nil), `{"amount":10,"clientCode":"first","debitParty":{"account":"300000001"}}`)
+	s.Require().NoError(err)
+	recorded.Body.Close()
+	s.Require().NoError(recorder.Save())
+	upstream.Close()
+
+	cassette, err := celcoin.NewCassette(s.path, celcoin.CassetteModeReplay)
+	s.Require().NoError(err)
+	cassette.IgnoreJSONPaths = []string{"clientCode"}
+	transport := cassette.Transport(nil)
+
+	_, err = post(transport, `{"amount":20,"clientCode":"second","debitParty":{"account":"300000001"}}`)
+	s.Require().Error(err)
+	s.assert.True(errors.Is(err, celcoin.ErrCassetteMismatch))
+	s.assert.Contains(err.Error(), "body.amount: recorded 10, got 20")
+	s.assert.NotContains(err.Error(), "clientCode")
+
+	resp, err := post(transport, `{"debitParty":{"account":"300000001"},"clientCode":"second","amount":10}`)
+	s.Require().NoError(err)
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	s.Require().NoError(err)
+	s.assert.Equal(http.StatusOK, resp.StatusCode)
+	s.assert.JSONEq(`{"status":"PROCESSING"}`, string(body))
+}
@@ -0,0 +1,403 @@
+package celcoin
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/youmark/pkcs8"
+	"golang.org/x/crypto/pkcs12"
+)
+
+// DefaultCertificateReloadInterval ... intervalo padrão de releitura do certificado quando um CertificateLoader é configurado
+const DefaultCertificateReloadInterval = 5 * time.Minute
+
+// CertificateLoader ... obtém o certificado mTLS atual (do disco, de um cofre de segredos, ...).
+// É chamado novamente a cada recarga, permitindo rotacionar o certificado sem reiniciar a aplicação.
+type CertificateLoader func() (*Certificate, error)
+
+// StaticCertificateLoader ... CertificateLoader que sempre retorna o mesmo certificado
+func StaticCertificateLoader(cert *Certificate) CertificateLoader {
+	return func() (*Certificate, error) {
+		return cert, nil
+	}
+}
+
+// CertificateFiles ... caminhos dos arquivos do certificado mTLS no disco.
+// Informe Certificate e PrivateKey (PEM) ou PKCS12 (.p12/.pfx); CertificateChain e RootCA são opcionais.
+type CertificateFiles struct {
+	Certificate      string
+	CertificateChain string
+	PrivateKey       string
+	PKCS12           string
+	RootCA           string
+	Passphrase       string
+}
+
+// NewFileCertificateLoader ... CertificateLoader que relê os arquivos a cada recarga
+func NewFileCertificateLoader(files CertificateFiles) CertificateLoader {
+	return func() (*Certificate, error) {
+		read := func(path string) (string, error) {
+			if len(path) == 0 {
+				return "", nil
+			}
+			data, err := ioutil.ReadFile(path)
+			return string(data), err
+		}
+
+		cert := &Certificate{Passphrase: files.Passphrase}
+		var err error
+		if cert.Certificate, err = read(files.Certificate); err != nil {
+			return nil, newTransportError(TransportStepLoadCertificate, err)
+		}
+		if cert.CertificateChain, err = read(files.CertificateChain); err != nil {
+			return nil, newTransportError(TransportStepLoadCertificateChain, err)
+		}
+		if cert.PrivateKey, err = read(files.PrivateKey); err != nil {
+			return nil, newTransportError(TransportStepLoadPrivateKey, err)
+		}
+		if cert.RootCA, err = read(files.RootCA); err != nil {
+			return nil, newTransportError(TransportStepLoadRootCA, err)
+		}
+		if len(files.PKCS12) > 0 {
+			data, err := ioutil.ReadFile(files.PKCS12)
+			if err != nil {
+				return nil, newTransportError(TransportStepLoadPKCS12, err)
+			}
+			cert.PKCS12 = base64.StdEncoding.EncodeToString(data)
+		}
+		return cert, nil
+	}
+}
+
+// CertificateReloader ... mantém o certificado cliente do mTLS e o recarrega periodicamente ou sob demanda.
+// Se uma recarga falhar, o certificado anterior continua em uso.
+type CertificateReloader struct {
+	loader    CertificateLoader
+	interval  time.Duration
+	rootCAs   *x509.CertPool
+	mutex     sync.RWMutex
+	current   *tls.Certificate
+	checkedAt time.Time
+	onReload  []func()
+	logger    Logger
+}
+
+// NewCertificateReloader ... carrega o certificado imediatamente e, com interval > 0, o relê a cada interval.
+// Com interval zero a recarga acontece apenas via Reload.
+func NewCertificateReloader(loader CertificateLoader, interval time.Duration) (*CertificateReloader, error) {
+	if loader == nil {
+		return nil, newTransportError(TransportStepLoadCertificate, errors.New("certificate loader is required"))
+	}
+
+	cert, err := loader()
+	if err != nil {
+		return nil, err
+	}
+
+	tlsCert, err := loadX509KeyPair(cert)
+	if err != nil {
+		return nil, err
+	}
+
+	// A CA do servidor é definida na criação; rotações trocam apenas o certificado cliente
+	rootCAs, err := loadRootCAs(cert)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CertificateReloader{
+		loader:    loader,
+		interval:  interval,
+		rootCAs:   rootCAs,
+		current:   &tlsCert,
+		checkedAt: time.Now(),
+		logger:    NewLogrusLogger(nil),
+	}, nil
+}
+
+// Reload ... relê o certificado pelo CertificateLoader. Conexões ociosas são fechadas quando o certificado muda,
+// para que as próximas conexões já usem o novo certificado.
+func (r *CertificateReloader) Reload() error {
+	cert, err := r.loader()
+	if err != nil {
+		return err
+	}
+
+	tlsCert, err := loadX509KeyPair(cert)
+	if err != nil {
+		return err
+	}
+
+	r.mutex.Lock()
+	changed := !bytes.Equal(r.current.Certificate[0], tlsCert.Certificate[0])
+	r.current = &tlsCert
+	r.checkedAt = time.Now()
+	callbacks := r.onReload
+	r.mutex.Unlock()
+
+	if changed {
+		r.logger.WithField("subject", tlsCert.Leaf.Subject.String()).
+			Info("mtls certificate reloaded")
+		for _, callback := range callbacks {
+			callback()
+		}
+	}
+	return nil
+}
+
+// GetClientCertificate ... callback usado pelo tls.Config para apresentar o certificado atual ao servidor
+func (r *CertificateReloader) GetClientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	if r.reloadDue() {
+		if err := r.Reload(); err != nil {
+			r.logger.WithError(err).Error("error reloading mtls certificate, keeping current certificate")
+		}
+	}
+
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	return r.current, nil
+}
+
+// reloadDue ... indica se o intervalo de recarga passou, marcando a verificação para evitar recargas simultâneas
+func (r *CertificateReloader) reloadDue() bool {
+	if r.interval <= 0 {
+		return false
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if time.Since(r.checkedAt) < r.interval {
+		return false
+	}
+	r.checkedAt = time.Now()
+	return true
+}
+
+// subscribe ... registra uma função chamada sempre que o certificado mudar
+func (r *CertificateReloader) subscribe(callback func()) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.onReload = append(r.onReload, callback)
+}
+
+// newMtlsTransport ... transporte TLS que verifica o servidor (pool do sistema ou CA fixada) e apresenta o certificado do reloader
+func newMtlsTransport(reloader *CertificateReloader) *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{
+		MinVersion:           tls.VersionTLS12,
+		RootCAs:              reloader.rootCAs,
+		GetClientCertificate: reloader.GetClientCertificate,
+	}
+	reloader.subscribe(transport.CloseIdleConnections)
+	return transport
+}
+
+// loadRootCAs ... retorna a CA fixada do certificado ou nil, que faz o TLS usar o pool do sistema
+func loadRootCAs(cert *Certificate) (*x509.CertPool, error) {
+	if cert == nil || len(cert.RootCA) == 0 {
+		return nil, nil
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM([]byte(cert.RootCA)) {
+		return nil, newTransportError(TransportStepLoadRootCA, errors.New("root CA does not contain a valid PEM certificate"))
+	}
+	return pool, nil
+}
+
+// loadX509KeyPair ... monta o par certificado/chave para o TLS a partir de PEM (chave opcionalmente
+// criptografada com Passphrase) ou de um bundle PKCS#12, incluindo a cadeia de intermediários
+func loadX509KeyPair(cert *Certificate) (tls.Certificate, error) {
+	if cert == nil {
+		return tls.Certificate{}, newTransportError(TransportStepLoadCertificate, errors.New("certificate is required"))
+	}
+
+	var certificates [][]byte
+	var key crypto.PrivateKey
+	var err error
+
+	if len(cert.PKCS12) > 0 {
+		certificates, key, err = decodePKCS12(cert.PKCS12, cert.Passphrase)
+		if err != nil {
+			return tls.Certificate{}, newTransportError(TransportStepLoadPKCS12, err)
+		}
+	} else {
+		certificates, err = decodeCertificates(cert.Certificate)
+		if err != nil {
+			return tls.Certificate{}, newTransportError(TransportStepLoadCertificate, err)
+		}
+		key, err = decodePrivateKey(cert.PrivateKey, cert.Passphrase)
+		if err != nil {
+			return tls.Certificate{}, newTransportError(TransportStepLoadPrivateKey, err)
+		}
+	}
+
+	if len(cert.CertificateChain) > 0 {
+		chain, err := decodeCertificates(cert.CertificateChain)
+		if err != nil {
+			return tls.Certificate{}, newTransportError(TransportStepLoadCertificateChain, err)
+		}
+		for _, der := range chain {
+			if !containsCertificate(certificates, der) {
+				certificates = append(certificates, der)
+			}
+		}
+	}
+
+	tlsCert, err := buildKeyPair(certificates, key)
+	if err != nil {
+		return tls.Certificate{}, newTransportError(TransportStepKeyPair, err)
+	}
+	return tlsCert, nil
+}
+
+// buildKeyPair ... coloca o certificado da chave privada como folha, seguido do restante da cadeia
+func buildKeyPair(certificates [][]byte, key crypto.PrivateKey) (tls.Certificate, error) {
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return tls.Certificate{}, fmt.Errorf("unsupported private key type %T", key)
+	}
+	public, ok := signer.Public().(interface{ Equal(crypto.PublicKey) bool })
+	if !ok {
+		return tls.Certificate{}, fmt.Errorf("unsupported public key type %T", signer.Public())
+	}
+
+	for i, der := range certificates {
+		leaf, err := x509.ParseCertificate(der)
+		if err != nil {
+			return tls.Certificate{}, err
+		}
+		if !public.Equal(leaf.PublicKey) {
+			continue
+		}
+
+		chain := append([][]byte{der}, certificates[:i]...)
+		chain = append(chain, certificates[i+1:]...)
+		return tls.Certificate{Certificate: chain, PrivateKey: key, Leaf: leaf}, nil
+	}
+	return tls.Certificate{}, errors.New("private key does not match any certificate")
+}
+
+// decodeCertificates ... decodifica todos os blocos CERTIFICATE de um PEM
+func decodeCertificates(data string) ([][]byte, error) {
+	var certificates [][]byte
+	rest := []byte(data)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+			return nil, err
+		}
+		certificates = append(certificates, block.Bytes)
+	}
+
+	if len(certificates) == 0 {
+		return nil, errors.New("certificate is not a valid PEM block")
+	}
+	return certificates, nil
+}
+
+// decodePrivateKey ... decodifica a chave privada PEM, aceitando PKCS#8 criptografado e PEM legado criptografado
+func decodePrivateKey(data, passphrase string) (crypto.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(data))
+	if block == nil {
+		return nil, errors.New("private key is not a valid PEM block")
+	}
+
+	if block.Type == "ENCRYPTED PRIVATE KEY" {
+		if len(passphrase) == 0 {
+			return nil, errors.New("passphrase is required for encrypted private key")
+		}
+		return pkcs8.ParsePKCS8PrivateKey(block.Bytes, []byte(passphrase))
+	}
+
+	der := block.Bytes
+	if x509.IsEncryptedPEMBlock(block) {
+		if len(passphrase) == 0 {
+			return nil, errors.New("passphrase is required for encrypted private key")
+		}
+		var err error
+		if der, err = x509.DecryptPEMBlock(block, []byte(passphrase)); err != nil {
+			return nil, err
+		}
+	}
+	return parsePrivateKey(der)
+}
+
+// decodePKCS12 ... extrai certificados e chave privada de um bundle PKCS#12 em base64
+func decodePKCS12(data, passphrase string) ([][]byte, crypto.PrivateKey, error) {
+	raw, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return nil, nil, fmt.Errorf("pkcs12 is not valid base64: %w", err)
+	}
+
+	blocks, err := pkcs12.ToPEM(raw, passphrase)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var certificates [][]byte
+	var key crypto.PrivateKey
+	for _, block := range blocks {
+		switch block.Type {
+		case "CERTIFICATE":
+			certificates = append(certificates, block.Bytes)
+		case "PRIVATE KEY":
+			if key, err = parsePrivateKey(block.Bytes); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	if len(certificates) == 0 || key == nil {
+		return nil, nil, errors.New("pkcs12 must contain a certificate and a private key")
+	}
+	return certificates, key, nil
+}
+
+// parsePrivateKey ... aceita chaves PKCS#1, PKCS#8 e EC, como o crypto/tls
+func parsePrivateKey(der []byte) (crypto.PrivateKey, error) {
+	if key, err := x509.ParsePKCS1PrivateKey(der); err == nil {
+		return key, nil
+	}
+	if key, err := x509.ParsePKCS8PrivateKey(der); err == nil {
+		switch key := key.(type) {
+		case *rsa.PrivateKey, *ecdsa.PrivateKey, ed25519.PrivateKey:
+			return key, nil
+		default:
+			return nil, fmt.Errorf("unsupported private key type %T", key)
+		}
+	}
+	if key, err := x509.ParseECPrivateKey(der); err == nil {
+		return key, nil
+	}
+	return nil, errors.New("failed to parse private key")
+}
+
+func containsCertificate(certificates [][]byte, der []byte) bool {
+	for _, c := range certificates {
+		if bytes.Equal(c, der) {
+			return true
+		}
+	}
+	return false
+}
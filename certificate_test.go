@@ -0,0 +1,464 @@
+package celcoin_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/contbank/celcoin-sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/youmark/pkcs8"
+)
+
+// CertificateTestSuite ...
+type CertificateTestSuite struct {
+	suite.Suite
+	assert       *assert.Assertions
+	ctx          context.Context
+	root         *testCA
+	intermediate *testCA
+	server       *httptest.Server
+	serverCA     string
+}
+
+// TestCertificateTestSuite ...
+func TestCertificateTestSuite(t *testing.T) {
+	suite.Run(t, new(CertificateTestSuite))
+}
+
+// SetupTest ...
+func (s *CertificateTestSuite) SetupTest() {
+	s.assert = assert.New(s.T())
+	s.ctx = context.Background()
+	s.root = newTestCA(s.T(), "celcoin-sdk-root", nil)
+	s.intermediate = newTestCA(s.T(), "celcoin-sdk-intermediate", s.root)
+
+	// Servidor que só aceita clientes cuja cadeia completa leva à CA raiz
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(s.root.cert)
+
+	s.server = httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, celcoin.LoginPath) {
+			w.Write([]byte(`{"access_token":"mtls-token","expires_in":3600}`))
+			return
+		}
+		w.Write([]byte(r.TLS.PeerCertificates[0].Subject.CommonName))
+	}))
+	s.server.TLS = &tls.Config{
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		ClientCAs:  clientCAs,
+	}
+	s.server.StartTLS()
+	s.serverCA = string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: s.server.Certificate().Raw}))
+}
+
+// TearDownTest ...
+func (s *CertificateTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *CertificateTestSuite) newSession() *celcoin.Session {
+	session, err := celcoin.NewSession(celcoin.Config{
+		ClientID:      celcoin.String("test-client-id"),
+		ClientSecret:  celcoin.String("test-client-secret"),
+		APIEndpoint:   celcoin.String(s.server.URL),
+		LoginEndpoint: celcoin.String(s.server.URL),
+	})
+	s.Require().NoError(err)
+	return session
+}
+
+func (s *CertificateTestSuite) get(client *http.Client) (string, error) {
+	resp, err := client.Get(s.server.URL + "/api")
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	return string(body), err
+}
+
+// TestChainAndPinnedRootCA ...
+func (s *CertificateTestSuite) TestChainAndPinnedRootCA() {
+	certPEM, keyPEM := s.intermediate.issue(s.T(), "client-1")
+
+	client, err := celcoin.CreateMtlsHTTPClient(&celcoin.Certificate{
+		Certificate:      certPEM,
+		CertificateChain: s.intermediate.pem,
+		PrivateKey:       keyPEM,
+		RootCA:           s.serverCA,
+	}, s.newSession())
+	s.Require().NoError(err)
+
+	cn, err := s.get(client)
+	s.assert.NoError(err)
+	s.assert.Equal("client-1", cn)
+}
+
+// TestMissingChainIsRejectedByServer ...
+func (s *CertificateTestSuite) TestMissingChainIsRejectedByServer() {
+	certPEM, keyPEM := s.intermediate.issue(s.T(), "client-1")
+
+	client, err := celcoin.CreateMtlsHTTPClient(&celcoin.Certificate{
+		Certificate: certPEM,
+		PrivateKey:  keyPEM,
+		RootCA:      s.serverCA,
+	}, s.newSession())
+	s.Require().NoError(err)
+
+	_, err = s.get(client)
+	s.assert.Error(err)
+}
+
+// TestServerIsVerifiedAgainstSystemPool ...
+func (s *CertificateTestSuite) TestServerIsVerifiedAgainstSystemPool() {
+	certPEM, keyPEM := s.intermediate.issue(s.T(), "client-1")
+
+	// Sem CA fixada o certificado autoassinado do servidor de teste não é confiável
+	client, err := celcoin.CreateMtlsHTTPClient(&celcoin.Certificate{
+		Certificate:      certPEM,
+		CertificateChain: s.intermediate.pem,
+		PrivateKey:       keyPEM,
+	}, s.newSession())
+	s.Require().NoError(err)
+
+	_, err = s.get(client)
+	s.Require().Error(err)
+
+	var unknownAuthority x509.UnknownAuthorityError
+	s.assert.True(errors.As(err, &unknownAuthority), err.Error())
+}
+
+// TestInvalidRootCA ...
+func (s *CertificateTestSuite) TestInvalidRootCA() {
+	certPEM, keyPEM := s.intermediate.issue(s.T(), "client-1")
+
+	_, err := celcoin.CreateMtlsHTTPClient(&celcoin.Certificate{
+		Certificate: certPEM,
+		PrivateKey:  keyPEM,
+		RootCA:      "invalid",
+	}, s.newSession())
+
+	var transportErr *celcoin.TransportError
+	s.Require().True(errors.As(err, &transportErr))
+	s.assert.Equal(celcoin.TransportStepLoadRootCA, transportErr.Step)
+}
+
+// TestEncryptedPrivateKey ...
+func (s *CertificateTestSuite) TestEncryptedPrivateKey() {
+	certPEM, keyPEM := s.intermediate.issue(s.T(), "client-1")
+	key := parseTestKey(s.T(), keyPEM)
+
+	pkcs8DER, err := pkcs8.ConvertPrivateKeyToPKCS8(key, []byte("secret"))
+	s.Require().NoError(err)
+	encryptedPKCS8 := string(pem.EncodeToMemory(&pem.Block{Type: "ENCRYPTED PRIVATE KEY", Bytes: pkcs8DER}))
+
+	ecDER, err := x509.MarshalECPrivateKey(key)
+	s.Require().NoError(err)
+	legacyBlock, err := x509.EncryptPEMBlock(rand.Reader, "EC PRIVATE KEY", ecDER, []byte("secret"), x509.PEMCipherAES256)
+	s.Require().NoError(err)
+	encryptedLegacy := string(pem.EncodeToMemory(legacyBlock))
+
+	tests := []struct {
+		name       string
+		key        string
+		passphrase string
+		valid      bool
+	}{
+		{"pkcs8", encryptedPKCS8, "secret", true},
+		{"pkcs8 wrong passphrase", encryptedPKCS8, "wrong", false},
+		{"pkcs8 missing passphrase", encryptedPKCS8, "", false},
+		{"legacy pem", encryptedLegacy, "secret", true},
+		{"legacy pem wrong passphrase", encryptedLegacy, "wrong", false},
+		{"legacy pem missing passphrase", encryptedLegacy, "", false},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			client, err := celcoin.CreateMtlsHTTPClient(&celcoin.Certificate{
+				Certificate:      certPEM,
+				CertificateChain: s.intermediate.pem,
+				PrivateKey:       tt.key,
+				Passphrase:       tt.passphrase,
+				RootCA:           s.serverCA,
+			}, s.newSession())
+
+			if tt.valid {
+				s.Require().NoError(err)
+				cn, err := s.get(client)
+				s.assert.NoError(err)
+				s.assert.Equal("client-1", cn)
+				return
+			}
+
+			var transportErr *celcoin.TransportError
+			s.Require().True(errors.As(err, &transportErr))
+			s.assert.Equal(celcoin.TransportStepLoadPrivateKey, transportErr.Step)
+		})
+	}
+}
+
+// TestPKCS12 ...
+func (s *CertificateTestSuite) TestPKCS12() {
+	data, err := ioutil.ReadFile(filepath.Join("testdata", "client.p12"))
+	s.Require().NoError(err)
+	bundle := base64.StdEncoding.EncodeToString(data)
+
+	client, err := celcoin.CreateMtlsHTTPClient(&celcoin.Certificate{
+		PKCS12:     bundle,
+		Passphrase: "celcoin",
+	}, s.newSession())
+	s.assert.NoError(err)
+	s.assert.NotNil(client)
+
+	_, err = celcoin.CreateMtlsHTTPClient(&celcoin.Certificate{
+		PKCS12:     bundle,
+		Passphrase: "wrong",
+	}, s.newSession())
+
+	var transportErr *celcoin.TransportError
+	s.Require().True(errors.As(err, &transportErr))
+	s.assert.Equal(celcoin.TransportStepLoadPKCS12, transportErr.Step)
+}
+
+// TestReloadFromDisk ...
+func (s *CertificateTestSuite) TestReloadFromDisk() {
+	dir := s.T().TempDir()
+	files := celcoin.CertificateFiles{
+		Certificate:      filepath.Join(dir, "client.crt"),
+		CertificateChain: filepath.Join(dir, "chain.crt"),
+		PrivateKey:       filepath.Join(dir, "client.key"),
+		RootCA:           filepath.Join(dir, "ca.crt"),
+	}
+	s.writeCertificate(files, "client-1")
+	s.Require().NoError(ioutil.WriteFile(files.CertificateChain, []byte(s.intermediate.pem), 0600))
+	s.Require().NoError(ioutil.WriteFile(files.RootCA, []byte(s.serverCA), 0600))
+
+	reloader, err := celcoin.NewCertificateReloader(celcoin.NewFileCertificateLoader(files), 0)
+	s.Require().NoError(err)
+
+	client, err := celcoin.CreateReloadableMtlsHTTPClient(reloader, s.newSession())
+	s.Require().NoError(err)
+
+	cn, err := s.get(client)
+	s.Require().NoError(err)
+	s.assert.Equal("client-1", cn)
+
+	// Um arquivo inválido não derruba o certificado atual
+	s.Require().NoError(ioutil.WriteFile(files.Certificate, []byte("invalid"), 0600))
+	s.assert.Error(reloader.Reload())
+
+	cn, err = s.get(client)
+	s.Require().NoError(err)
+	s.assert.Equal("client-1", cn)
+
+	s.writeCertificate(files, "client-2")
+	s.Require().NoError(reloader.Reload())
+
+	cn, err = s.get(client)
+	s.Require().NoError(err)
+	s.assert.Equal("client-2", cn)
+}
+
+// TestReloadInterval ...
+func (s *CertificateTestSuite) TestReloadInterval() {
+	current := "client-1"
+	loader := func() (*celcoin.Certificate, error) {
+		certPEM, keyPEM := s.intermediate.issue(s.T(), current)
+		return &celcoin.Certificate{Certificate: certPEM, PrivateKey: keyPEM}, nil
+	}
+
+	reloader, err := celcoin.NewCertificateReloader(loader, 10*time.Millisecond)
+	s.Require().NoError(err)
+
+	cert, err := reloader.GetClientCertificate(nil)
+	s.Require().NoError(err)
+	s.assert.Equal("client-1", cert.Leaf.Subject.CommonName)
+
+	current = "client-2"
+	time.Sleep(20 * time.Millisecond)
+
+	cert, err = reloader.GetClientCertificate(nil)
+	s.Require().NoError(err)
+	s.assert.Equal("client-2", cert.Leaf.Subject.CommonName)
+}
+
+// TestNewClientWithCertificateLoader ...
+func (s *CertificateTestSuite) TestNewClientWithCertificateLoader() {
+	certPEM, keyPEM := s.intermediate.issue(s.T(), "client-1")
+
+	client, err := celcoin.NewClient(celcoin.Config{
+		ClientID:      celcoin.String("test-client-id"),
+		ClientSecret:  celcoin.String("test-client-secret"),
+		Mtls:          celcoin.Bool(true),
+		APIEndpoint:   celcoin.String(s.server.URL),
+		LoginEndpoint: celcoin.String(s.server.URL),
+		CertificateLoader: celcoin.StaticCertificateLoader(&celcoin.Certificate{
+			Certificate:      certPEM,
+			CertificateChain: s.intermediate.pem,
+			PrivateKey:       keyPEM,
+			RootCA:           s.serverCA,
+		}),
+	})
+	s.Require().NoError(err)
+	s.assert.NotNil(client.CertificateReloader)
+
+	cn, err := s.get(client.HTTPClient)
+	s.assert.NoError(err)
+	s.assert.Equal("client-1", cn)
+}
+
+// TestNewClientWithCredentialsProviderCertificate ...
+func (s *CertificateTestSuite) TestNewClientWithCredentialsProviderCertificate() {
+	secret := func(cn string) celcoin.Certificate {
+		certPEM, keyPEM := s.intermediate.issue(s.T(), cn)
+		return celcoin.Certificate{
+			ClientID:         "test-client-id",
+			Certificate:      certPEM,
+			CertificateChain: s.intermediate.pem,
+			PrivateKey:       keyPEM,
+			RootCA:           s.serverCA,
+		}
+	}
+	secrets := &fakeSecretsManager{}
+	secrets.set(secret("client-1"))
+
+	client, err := celcoin.NewClient(celcoin.Config{
+		Mtls:                       celcoin.Bool(true),
+		APIEndpoint:                celcoin.String(s.server.URL),
+		LoginEndpoint:              celcoin.String(s.server.URL),
+		Logger:                     celcoin.NewNopLogger(),
+		CredentialsProvider:        celcoin.NewSecretsManagerCredentialsProvider(secrets, "celcoin/credentials"),
+		CredentialsRefreshInterval: durationPtr(0),
+	})
+	s.Require().NoError(err)
+	s.Require().NotNil(client.CertificateReloader)
+	s.Require().NotNil(client.Credentials)
+
+	cn, err := s.get(client.HTTPClient)
+	s.Require().NoError(err)
+	s.assert.Equal("client-1", cn)
+
+	// A rotação do certificado no provedor é aplicada ao cliente sem recriá-lo
+	secrets.set(secret("client-2"))
+	changed, err := client.Credentials.Refresh(s.ctx)
+	s.Require().NoError(err)
+	s.assert.True(changed)
+
+	cn, err = s.get(client.HTTPClient)
+	s.Require().NoError(err)
+	s.assert.Equal("client-2", cn)
+}
+
+func (s *CertificateTestSuite) writeCertificate(files celcoin.CertificateFiles, cn string) {
+	certPEM, keyPEM := s.intermediate.issue(s.T(), cn)
+	s.Require().NoError(ioutil.WriteFile(files.Certificate, []byte(certPEM), 0600))
+	s.Require().NoError(ioutil.WriteFile(files.PrivateKey, []byte(keyPEM), 0600))
+}
+
+// testCA ... autoridade certificadora usada para emitir certificados de teste
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  string
+}
+
+// newTestCA ... cria uma CA raiz (parent nil) ou intermediária assinada por parent
+func newTestCA(t *testing.T, cn string, parent *testCA) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: cn},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	issuer, signer := template, key
+	if parent != nil {
+		issuer, signer = parent.cert, parent.key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, issuer, &key.PublicKey, signer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &testCA{
+		cert: cert,
+		key:  key,
+		pem:  string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+	}
+}
+
+// issue ... emite um certificado cliente em PEM assinado pela CA
+func (ca *testCA) issue(t *testing.T, cn string) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: cn},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	return string(certPEM), string(keyPEM)
+}
+
+func parseTestKey(t *testing.T, keyPEM string) *ecdsa.PrivateKey {
+	block, _ := pem.Decode([]byte(keyPEM))
+	if block == nil {
+		t.Fatal(fmt.Errorf("invalid key PEM"))
+	}
+	key, err := x509.ParseECPrivateKey(block.Bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
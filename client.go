@@ -0,0 +1,105 @@
+package celcoin
+
+import (
+	"net/http"
+)
+
+// Client ... agrupa todos os serviços da Celcoin sobre uma única sessão e um único transporte autenticado
+type Client struct {
+	Session    *Session
+	HTTPClient *http.Client
+	// CertificateReloader ... presente quando o mTLS usa um CertificateLoader; Reload força a rotação do certificado
+	CertificateReloader *CertificateReloader
+	// Credentials ... presente quando o Config usa um CredentialsProvider; Refresh força a releitura das credenciais
+	Credentials    *RefreshingCredentials
+	Authentication *Authentication
+	Pix            PixInterface
+	Transfers      TransfersInterface
+	Boletos        BoletosInterface
+	Payment        PaymentInterface
+	Dda            DdaInterface
+	Webhooks       Webhooks
+	Statement      StatementInterface
+	Balance        BalanceInterface
+	Customers      CustomersInterface
+	Business       BusinessInterface
+	IncomeReport   IncomeReportInterface
+}
+
+// NewClient ... cria a sessão, o cliente HTTP autenticado (OAuth2 ou mTLS) e todos os serviços a partir de um único Config.
+// Todos os serviços compartilham o mesmo pool de conexões e o mesmo token. Com Config.CredentialResolver,
+// os serviços são compartilhados entre os tenants e cada tenant (WithTenant) usa suas credenciais e seu token.
+// Os tokens em uso são renovados em background; chame Close ao descartar o Client.
+func NewClient(config Config) (*Client, error) {
+	session, err := NewSession(config)
+	if err != nil {
+		return nil, err
+	}
+
+	var httpClient *http.Client
+	var reloader *CertificateReloader
+	switch {
+	case session.CredentialResolver != nil:
+		httpClient, err = CreateMultiTenantHTTPClient(session)
+	case session.Mtls && session.certificateLoader != nil:
+		interval := DefaultCertificateReloadInterval
+		if config.CertificateReloadInterval != nil {
+			interval = *config.CertificateReloadInterval
+		}
+		if reloader, err = NewCertificateReloader(session.certificateLoader, interval); err != nil {
+			return nil, err
+		}
+		reloader.logger = session.logger("certificate")
+		if session.Credentials != nil {
+			// O certificado rotacionado é aplicado imediatamente, sem aguardar o intervalo de recarga
+			session.Credentials.OnRotate(func(_, _ Credentials) {
+				if err := reloader.Reload(); err != nil {
+					reloader.logger.WithError(err).Error("error reloading rotated mtls certificate")
+				}
+			})
+		}
+		httpClient, err = CreateReloadableMtlsHTTPClient(reloader, session)
+	case session.Mtls:
+		if config.Certificate == nil {
+			return nil, ErrMissingCertificate
+		}
+		httpClient, err = CreateMtlsHTTPClient(config.Certificate, session)
+	default:
+		httpClient, err = CreateOAuth2HTTPClient(session)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	client := NewClientWithHTTPClient(httpClient, *session)
+	client.CertificateReloader = reloader
+	client.Credentials = session.Credentials
+	return client, nil
+}
+
+// Close ... interrompe a renovação de tokens em background (inclusive a de cada tenant) e fecha as conexões ociosas
+func (c *Client) Close() {
+	if c.HTTPClient != nil {
+		c.HTTPClient.CloseIdleConnections()
+	}
+}
+
+// NewClientWithHTTPClient ... cria todos os serviços sobre um cliente HTTP já autenticado
+func NewClientWithHTTPClient(httpClient *http.Client, session Session) *Client {
+	return &Client{
+		Session:        &session,
+		HTTPClient:     httpClient,
+		Authentication: NewAuthentication(httpClient, session),
+		Pix:            NewPix(httpClient, session),
+		Transfers:      NewTransfers(httpClient, session),
+		Boletos:        NewBoletos(httpClient, session),
+		Payment:        NewPayment(httpClient, session),
+		Dda:            NewDda(httpClient, session),
+		Webhooks:       NewWebhooks(httpClient, session),
+		Statement:      NewStatement(httpClient, session),
+		Balance:        NewBalance(httpClient, session),
+		Customers:      NewCustomers(httpClient, session),
+		Business:       NewBusiness(httpClient, session),
+		IncomeReport:   NewIncomeReport(httpClient, session),
+	}
+}
@@ -0,0 +1,167 @@
+package celcoin_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/contbank/celcoin-sdk"
+	"github.com/contbank/celcoin-sdk/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+// Os mocks gerados (make mock) precisam acompanhar as interfaces dos serviços
+var (
+	_ celcoin.PixInterface          = (*mocks.PixInterface)(nil)
+	_ celcoin.TransfersInterface    = (*mocks.TransfersInterface)(nil)
+	_ celcoin.BoletosInterface      = (*mocks.BoletosInterface)(nil)
+	_ celcoin.PaymentInterface      = (*mocks.PaymentInterface)(nil)
+	_ celcoin.DdaInterface          = (*mocks.DdaInterface)(nil)
+	_ celcoin.Webhooks              = (*mocks.Webhooks)(nil)
+	_ celcoin.StatementInterface    = (*mocks.StatementInterface)(nil)
+	_ celcoin.BalanceInterface      = (*mocks.BalanceInterface)(nil)
+	_ celcoin.CustomersInterface    = (*mocks.CustomersInterface)(nil)
+	_ celcoin.BusinessInterface     = (*mocks.BusinessInterface)(nil)
+	_ celcoin.IncomeReportInterface = (*mocks.IncomeReportInterface)(nil)
+)
+
+// ClientTestSuite ...
+type ClientTestSuite struct {
+	suite.Suite
+	assert      *assert.Assertions
+	ctx         context.Context
+	server      *httptest.Server
+	tokenCalls  int32
+	client      *celcoin.Client
+	lastAuthHdr atomic.Value
+}
+
+// TestClientTestSuite ...
+func TestClientTestSuite(t *testing.T) {
+	suite.Run(t, new(ClientTestSuite))
+}
+
+// SetupTest ...
+func (s *ClientTestSuite) SetupTest() {
+	s.assert = assert.New(s.T())
+	s.ctx = context.Background()
+	s.tokenCalls = 0
+
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/"+celcoin.LoginPath):
+			atomic.AddInt32(&s.tokenCalls, 1)
+			json.NewEncoder(w).Encode(celcoin.AuthenticationResponse{
+				AccessToken: "shared-token",
+				ExpiresIn:   3600,
+				TokenType:   "bearer",
+			})
+		case r.URL.Path == celcoin.BalancePath:
+			s.lastAuthHdr.Store(r.Header.Get("Authorization"))
+			w.Write([]byte(`{"status":"SUCCESS","version":"1.0.0","body":{"amount":150.5}}`))
+		case r.URL.Path == celcoin.StatementPath:
+			s.lastAuthHdr.Store(r.Header.Get("Authorization"))
+			w.Write([]byte(`{"status":"SUCCESS","version":"1.0.0","totalItems":0,"body":{"movements":[]}}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+
+	client, err := celcoin.NewClient(celcoin.Config{
+		ClientID:      celcoin.String("test-client-id"),
+		ClientSecret:  celcoin.String("test-client-secret"),
+		APIEndpoint:   celcoin.String(s.server.URL),
+		LoginEndpoint: celcoin.String(s.server.URL),
+	})
+	s.assert.NoError(err)
+	s.client = client
+}
+
+// TearDownTest ...
+func (s *ClientTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+// TestNewClientWiresAllServices ...
+func (s *ClientTestSuite) TestNewClientWiresAllServices() {
+	s.assert.NotNil(s.client.Session)
+	s.assert.NotNil(s.client.HTTPClient)
+	s.assert.NotNil(s.client.Authentication)
+	s.assert.NotNil(s.client.Pix)
+	s.assert.NotNil(s.client.Transfers)
+	s.assert.NotNil(s.client.Boletos)
+	s.assert.NotNil(s.client.Payment)
+	s.assert.NotNil(s.client.Dda)
+	s.assert.NotNil(s.client.Webhooks)
+	s.assert.NotNil(s.client.Statement)
+	s.assert.NotNil(s.client.Balance)
+	s.assert.NotNil(s.client.Customers)
+	s.assert.NotNil(s.client.Business)
+	s.assert.NotNil(s.client.IncomeReport)
+}
+
+// TestServicesShareToken ...
+func (s *ClientTestSuite) TestServicesShareToken() {
+	balance, err := s.client.Balance.Balance(s.ctx, "123456")
+	s.assert.NoError(err)
+	s.assert.Equal(150.5, balance.Body.Amount)
+
+	_, err = s.client.Statement.GetStatements(s.ctx, &celcoin.StatementRequest{
+		Account: celcoin.String("123456"),
+	})
+	s.assert.NoError(err)
+
+	s.assert.Equal(int32(1), atomic.LoadInt32(&s.tokenCalls))
+	s.assert.Equal("Bearer shared-token", s.lastAuthHdr.Load())
+}
+
+// TestAuthenticationToken ...
+func (s *ClientTestSuite) TestAuthenticationToken() {
+	ctx, cancel := context.WithTimeout(s.ctx, 5*time.Second)
+	defer cancel()
+
+	token, err := s.client.Authentication.Token(ctx)
+	s.Require().NoError(err)
+	s.assert.Equal("Bearer shared-token", token)
+
+	// O token obtido pela Authentication é reutilizado pelos serviços
+	_, err = s.client.Balance.Balance(ctx, "123456")
+	s.assert.NoError(err)
+	s.assert.Equal(int32(1), atomic.LoadInt32(&s.tokenCalls))
+	s.assert.Equal("Bearer shared-token", s.lastAuthHdr.Load())
+}
+
+// TestNewClientMtlsWithoutCertificate ...
+func (s *ClientTestSuite) TestNewClientMtlsWithoutCertificate() {
+	client, err := celcoin.NewClient(celcoin.Config{
+		ClientID:      celcoin.String("test-client-id"),
+		ClientSecret:  celcoin.String("test-client-secret"),
+		APIEndpoint:   celcoin.String(s.server.URL),
+		LoginEndpoint: celcoin.String(s.server.URL),
+		Mtls:          celcoin.Bool(true),
+	})
+	s.assert.Nil(client)
+	s.assert.ErrorIs(err, celcoin.ErrMissingCertificate)
+}
+
+// TestClientWithMockedServices ...
+func (s *ClientTestSuite) TestClientWithMockedServices() {
+	expected := &celcoin.BalanceResponse{Status: "SUCCESS"}
+	expected.Body.Amount = 42
+
+	balance := mocks.NewBalanceInterface(s.T())
+	balance.EXPECT().Balance(mock.Anything, "123456").Return(expected, nil).Once()
+	client := &celcoin.Client{Balance: balance}
+
+	response, err := client.Balance.Balance(s.ctx, "123456")
+	s.assert.NoError(err)
+	s.assert.Equal(42.0, response.Body.Amount)
+	s.assert.Equal(int32(0), atomic.LoadInt32(&s.tokenCalls))
+}
@@ -0,0 +1,196 @@
+// Command celcoin-fake ... serve o MockServer do SDK como uma Celcoin local, para ambientes de frontend e QA.
+//
+// Uso:
+//
+//	celcoin-fake -addr 127.0.0.1:8080 -seed seed.yaml -state celcoin-fake.json -settlement-delay 5s
+//
+// A massa de dados (-seed) pode ser JSON ou YAML, com contas, chaves Pix e assinaturas de webhook:
+//
+//	accounts:
+//	  - account: "300000001"
+//	    documentNumber: "12345678909"
+//	    name: Maria Pagadora
+//	    balance: 1000
+//	pixKeys:
+//	  - key: maria@example.com
+//	    keyType: EMAIL
+//	    account: "300000001"
+//	webhooks:
+//	  - entity: pix-payment-in
+//	    webhookUrl: http://localhost:3000/webhooks/celcoin
+//
+// Quando o arquivo de estado (-state) existe, ele é carregado no lugar da massa de dados. O estado é gravado
+// periodicamente (-save-interval) e ao encerrar o processo. As credenciais aceitas são celcoin.MockClientID e
+// celcoin.MockClientSecret; as rotas administrativas ficam em celcoin.MockAdminPath e não exigem autenticação, por
+// isso o servidor escuta apenas em 127.0.0.1 por padrão. Use -addr :8080 para expor em todas as interfaces.
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/contbank/celcoin-sdk"
+)
+
+// options ... parâmetros da linha de comando
+type options struct {
+	addr            string
+	seed            string
+	state           string
+	saveInterval    time.Duration
+	settlementDelay time.Duration
+}
+
+func main() {
+	var opts options
+	flag.StringVar(&opts.addr, "addr", envOrDefault("CELCOIN_FAKE_ADDR", "127.0.0.1:8080"), "endereço (host:porta) do servidor")
+	flag.StringVar(&opts.seed, "seed", os.Getenv("CELCOIN_FAKE_SEED"), "massa de dados inicial em JSON ou YAML")
+	flag.StringVar(&opts.state, "state", os.Getenv("CELCOIN_FAKE_STATE"), "arquivo onde o estado é persistido entre execuções")
+	flag.DurationVar(&opts.saveInterval, "save-interval", 5*time.Second, "intervalo de gravação do estado; zero grava apenas ao encerrar")
+	flag.DurationVar(&opts.settlementDelay, "settlement-delay", 0, "tempo que pagamentos e transferências permanecem PROCESSING")
+	flag.Parse()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if err := run(ctx, opts); err != nil {
+		log.Fatalf("celcoin-fake: %v", err)
+	}
+}
+
+// run ... inicia o servidor e o mantém até o contexto ser cancelado
+func run(ctx context.Context, opts options) error {
+	listener, err := net.Listen("tcp", opts.addr)
+	if err != nil {
+		return err
+	}
+	server := celcoin.NewMockServerListener(listener)
+	defer server.Close()
+	server.SetSettlementDelay(opts.settlementDelay)
+
+	if err := prepare(server, opts); err != nil {
+		return err
+	}
+	log.Printf("celcoin-fake listening on %s (client_id=%s client_secret=%s)",
+		listener.Addr(), celcoin.MockClientID, celcoin.MockClientSecret)
+
+	var ticker <-chan time.Time
+	if len(opts.state) > 0 && opts.saveInterval > 0 {
+		t := time.NewTicker(opts.saveInterval)
+		defer t.Stop()
+		ticker = t.C
+	}
+	for {
+		select {
+		case <-ticker:
+			if err := saveState(server, opts.state); err != nil {
+				log.Printf("celcoin-fake: saving state: %v", err)
+			}
+		case <-ctx.Done():
+			log.Printf("celcoin-fake shutting down")
+			if len(opts.state) == 0 {
+				return nil
+			}
+			return saveState(server, opts.state)
+		}
+	}
+}
+
+// prepare ... carrega o estado persistido ou, na primeira execução, a massa de dados
+func prepare(server *celcoin.MockServer, opts options) error {
+	if len(opts.state) > 0 {
+		file, err := os.Open(opts.state)
+		switch {
+		case err == nil:
+			defer file.Close()
+			if err := server.LoadState(file); err != nil {
+				return fmt.Errorf("loading state %s: %w", opts.state, err)
+			}
+			log.Printf("celcoin-fake: state loaded from %s", opts.state)
+			return nil
+		case !errors.Is(err, os.ErrNotExist):
+			return err
+		}
+	}
+
+	if len(opts.seed) == 0 {
+		return nil
+	}
+	seed, err := loadSeed(opts.seed)
+	if err != nil {
+		return fmt.Errorf("loading seed %s: %w", opts.seed, err)
+	}
+	if err := server.Seed(seed); err != nil {
+		return err
+	}
+	log.Printf("celcoin-fake: seeded %d accounts, %d pix keys and %d webhooks from %s",
+		len(seed.Accounts), len(seed.PixKeys), len(seed.Webhooks), opts.seed)
+	return nil
+}
+
+// loadSeed ... arquivos .yaml e .yml são convertidos para JSON, usando as mesmas chaves dos tipos do SDK
+func loadSeed(path string) (celcoin.MockSeed, error) {
+	var seed celcoin.MockSeed
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return seed, err
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		var document interface{}
+		if err := yaml.Unmarshal(data, &document); err != nil {
+			return seed, err
+		}
+		if data, err = json.Marshal(document); err != nil {
+			return seed, err
+		}
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&seed); err != nil {
+		return seed, err
+	}
+	return seed, nil
+}
+
+// saveState ... grava em um arquivo temporário e o renomeia, para não corromper o estado ao encerrar no meio da escrita
+func saveState(server *celcoin.MockServer, path string) error {
+	temp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(temp.Name())
+
+	if err := server.SaveState(temp); err != nil {
+		temp.Close()
+		return err
+	}
+	if err := temp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(temp.Name(), path)
+}
+
+// envOrDefault ...
+func envOrDefault(key, fallback string) string {
+	if value := os.Getenv(key); len(value) > 0 {
+		return value
+	}
+	return fallback
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/contbank/celcoin-sdk"
+)
+
+type CelcoinFakeTestSuite struct {
+	suite.Suite
+	assert *assert.Assertions
+	dir    string
+}
+
+func TestCelcoinFakeTestSuite(t *testing.T) {
+	suite.Run(t, new(CelcoinFakeTestSuite))
+}
+
+func (s *CelcoinFakeTestSuite) SetupTest() {
+	s.assert = assert.New(s.T())
+	s.dir = s.T().TempDir()
+}
+
+// newServer ...
+func (s *CelcoinFakeTestSuite) newServer() *celcoin.MockServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	s.Require().NoError(err)
+	server := celcoin.NewMockServerListener(listener)
+	s.T().Cleanup(server.Close)
+	return server
+}
+
+func (s *CelcoinFakeTestSuite) TestLoadSeedYAMLAndJSON() {
+	fromYAML, err := loadSeed(filepath.Join("testdata", "seed.yaml"))
+	s.Require().NoError(err)
+	fromJSON, err := loadSeed(filepath.Join("testdata", "seed.json"))
+	s.Require().NoError(err)
+
+	s.assert.Equal(fromJSON, fromYAML)
+	s.assert.Len(fromYAML.Accounts, 2)
+	s.assert.Equal(1000.0, fromYAML.Accounts[0].Balance)
+	s.assert.Equal("joao@example.com", fromYAML.PixKeys[0].Key)
+}
+
+func (s *CelcoinFakeTestSuite) TestLoadSeedRejectsUnknownFields() {
+	path := filepath.Join(s.dir, "seed.yml")
+	s.Require().NoError(os.WriteFile(path, []byte("acounts: []\n"), 0o600))
+
+	_, err := loadSeed(path)
+	s.assert.Error(err)
+}
+
+func (s *CelcoinFakeTestSuite) TestStatePersistedBetweenRestarts() {
+	opts := options{seed: filepath.Join("testdata", "seed.yaml"), state: filepath.Join(s.dir, "state.json")}
+
+	first := s.newServer()
+	s.Require().NoError(prepare(first, opts))
+	s.Require().NoError(first.CashIn("300000002", 15, "Pix externo"))
+	s.Require().NoError(saveState(first, opts.state))
+
+	// Com o arquivo de estado presente, a massa de dados é ignorada
+	second := s.newServer()
+	s.Require().NoError(prepare(second, opts))
+	account, found := second.Account("300000002")
+	s.Require().True(found)
+	s.assert.Equal(15.0, account.Balance)
+	s.assert.Len(second.WebhookEvents(celcoin.MockEntityPixPaymentIn), 1)
+}
@@ -0,0 +1,156 @@
+package celcoin
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// ProductionApiEndpoint ...
+	ProductionApiEndpoint string = "https://api.openfinance.celcoin.com.br"
+	// ProductionLoginEndpoint ...
+	ProductionLoginEndpoint string = "https://api.openfinance.celcoin.com.br"
+)
+
+// EnvironmentEndpoints ... URLs base da API e do login de um ambiente da Celcoin
+type EnvironmentEndpoints struct {
+	APIEndpoint   string
+	LoginEndpoint string
+}
+
+// environments ... presets de URLs por ambiente
+var environments = map[string]EnvironmentEndpoints{
+	CelcoinEnvSandbox: {APIEndpoint: ApiEndpoint, LoginEndpoint: LoginEndpoint},
+	CelcoinEnvProd:    {APIEndpoint: ProductionApiEndpoint, LoginEndpoint: ProductionLoginEndpoint},
+}
+
+// EndpointsForEnvironment ... retorna as URLs base do ambiente (CelcoinEnvSandbox ou CelcoinEnvProd)
+func EndpointsForEnvironment(environment string) (EnvironmentEndpoints, error) {
+	endpoints, found := environments[normalizeEnvironment(environment)]
+	if !found {
+		return EnvironmentEndpoints{}, &ConfigError{
+			Field: "Environment",
+			Err:   ErrInvalidEnvironment,
+			Detail: fmt.Sprintf("%q is not one of %s, %s", environment,
+				CelcoinEnvSandbox, CelcoinEnvProd),
+		}
+	}
+	return endpoints, nil
+}
+
+func normalizeEnvironment(environment string) string {
+	return strings.ToUpper(strings.TrimSpace(environment))
+}
+
+// ConfigError ... erro de validação do Config, indicando o campo inválido
+type ConfigError struct {
+	Field  string
+	Detail string
+	Err    error
+}
+
+// Error ...
+func (e *ConfigError) Error() string {
+	if len(e.Detail) > 0 {
+		return fmt.Sprintf("celcoin config %s: %v: %s", e.Field, e.Err, e.Detail)
+	}
+	return fmt.Sprintf("celcoin config %s: %v", e.Field, e.Err)
+}
+
+// Unwrap ...
+func (e *ConfigError) Unwrap() error {
+	return e.Err
+}
+
+// validateEndpoint ... garante que a URL base é absoluta e usa http ou https
+func validateEndpoint(field, endpoint string) error {
+	parsed, err := url.Parse(endpoint)
+	if err != nil {
+		return &ConfigError{Field: field, Err: ErrInvalidEndpoint, Detail: err.Error()}
+	}
+	if (parsed.Scheme != "https" && parsed.Scheme != "http") || len(parsed.Host) == 0 {
+		return &ConfigError{Field: field, Err: ErrInvalidEndpoint,
+			Detail: fmt.Sprintf("%q must be an absolute http(s) url", endpoint)}
+	}
+	return nil
+}
+
+// LoadConfigFromEnv ... monta um Config a partir das variáveis de ambiente CELCOIN_*.
+// Variáveis ausentes ficam nil e recebem os padrões do NewSession.
+//
+//	CELCOIN_ENVIRONMENT, CELCOIN_API_ENDPOINT, CELCOIN_LOGIN_ENDPOINT, CELCOIN_CLIENT_ID,
+//	CELCOIN_CLIENT_SECRET, CELCOIN_API_VERSION, CELCOIN_SCOPES, CELCOIN_COMPANY_KEY, CELCOIN_MTLS,
+//	CELCOIN_CERTIFICATE, CELCOIN_CERTIFICATE_CHAIN, CELCOIN_PRIVATE_KEY, CELCOIN_PKCS12, CELCOIN_ROOT_CA,
+//	CELCOIN_CERTIFICATE_PASSPHRASE, CELCOIN_CERTIFICATE_FILE, CELCOIN_CERTIFICATE_CHAIN_FILE,
+//	CELCOIN_PRIVATE_KEY_FILE, CELCOIN_PKCS12_FILE, CELCOIN_ROOT_CA_FILE, CELCOIN_CERTIFICATE_RELOAD_INTERVAL
+//
+// Quando algum CELCOIN_*_FILE é informado, o certificado é lido do disco por um CertificateLoader e recarregado periodicamente.
+func LoadConfigFromEnv() (Config, error) {
+	var config Config
+
+	config.Environment = lookupEnv("CELCOIN_ENVIRONMENT")
+	config.APIEndpoint = lookupEnv("CELCOIN_API_ENDPOINT")
+	config.LoginEndpoint = lookupEnv("CELCOIN_LOGIN_ENDPOINT")
+	config.ClientID = lookupEnv("CELCOIN_CLIENT_ID")
+	config.ClientSecret = lookupEnv("CELCOIN_CLIENT_SECRET")
+	config.APIVersion = lookupEnv("CELCOIN_API_VERSION")
+	config.Scopes = lookupEnv("CELCOIN_SCOPES")
+	config.CompanyKey = lookupEnv("CELCOIN_COMPANY_KEY")
+
+	if value := lookupEnv("CELCOIN_MTLS"); value != nil {
+		mtls, err := strconv.ParseBool(*value)
+		if err != nil {
+			return Config{}, &ConfigError{Field: "CELCOIN_MTLS", Err: ErrInvalidConfigValue, Detail: err.Error()}
+		}
+		config.Mtls = Bool(mtls)
+	}
+
+	if value := lookupEnv("CELCOIN_CERTIFICATE_RELOAD_INTERVAL"); value != nil {
+		interval, err := time.ParseDuration(*value)
+		if err != nil {
+			return Config{}, &ConfigError{Field: "CELCOIN_CERTIFICATE_RELOAD_INTERVAL", Err: ErrInvalidConfigValue, Detail: err.Error()}
+		}
+		config.CertificateReloadInterval = &interval
+	}
+
+	passphrase := os.Getenv("CELCOIN_CERTIFICATE_PASSPHRASE")
+
+	files := CertificateFiles{
+		Certificate:      os.Getenv("CELCOIN_CERTIFICATE_FILE"),
+		CertificateChain: os.Getenv("CELCOIN_CERTIFICATE_CHAIN_FILE"),
+		PrivateKey:       os.Getenv("CELCOIN_PRIVATE_KEY_FILE"),
+		PKCS12:           os.Getenv("CELCOIN_PKCS12_FILE"),
+		RootCA:           os.Getenv("CELCOIN_ROOT_CA_FILE"),
+		Passphrase:       passphrase,
+	}
+	if files != (CertificateFiles{Passphrase: passphrase}) {
+		config.CertificateLoader = NewFileCertificateLoader(files)
+	}
+
+	certificate := Certificate{
+		Certificate:      os.Getenv("CELCOIN_CERTIFICATE"),
+		CertificateChain: os.Getenv("CELCOIN_CERTIFICATE_CHAIN"),
+		PrivateKey:       os.Getenv("CELCOIN_PRIVATE_KEY"),
+		PKCS12:           os.Getenv("CELCOIN_PKCS12"),
+		RootCA:           os.Getenv("CELCOIN_ROOT_CA"),
+		Passphrase:       passphrase,
+	}
+	if certificate != (Certificate{Passphrase: passphrase}) {
+		config.Certificate = &certificate
+	}
+
+	return config, nil
+}
+
+// lookupEnv ... retorna nil para variáveis ausentes ou vazias
+func lookupEnv(key string) *string {
+	value, found := os.LookupEnv(key)
+	if !found || len(strings.TrimSpace(value)) == 0 {
+		return nil
+	}
+	return String(strings.TrimSpace(value))
+}
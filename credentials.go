@@ -0,0 +1,252 @@
+package celcoin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
+)
+
+// DefaultCredentialsRefreshInterval ... intervalo padrão de releitura do CredentialsProvider
+const DefaultCredentialsRefreshInterval = 15 * time.Minute
+
+// Credentials ... credenciais Celcoin obtidas por um CredentialsProvider
+type Credentials struct {
+	ClientID     string
+	ClientSecret string
+	// Certificate ... certificado mTLS; nil quando a fonte não possui certificado
+	Certificate *Certificate
+}
+
+// equal ...
+func (c *Credentials) equal(other *Credentials) bool {
+	if c == nil || other == nil {
+		return c == other
+	}
+	if c.ClientID != other.ClientID || c.ClientSecret != other.ClientSecret {
+		return false
+	}
+	if c.Certificate == nil || other.Certificate == nil {
+		return c.Certificate == other.Certificate
+	}
+	return *c.Certificate == *other.Certificate
+}
+
+// CredentialsProvider ... fonte das credenciais Celcoin (variáveis de ambiente, arquivo, AWS Secrets Manager, ...)
+type CredentialsProvider interface {
+	Retrieve(ctx context.Context) (*Credentials, error)
+}
+
+// credentialsFromSecret ... lê um segredo no formato do Certificate (uuid, client_id, client_secret, certificate, privateKey, ...)
+func credentialsFromSecret(data []byte) (*Credentials, error) {
+	var secret Certificate
+	if err := json.Unmarshal(data, &secret); err != nil {
+		return nil, fmt.Errorf("invalid credentials secret: %w", err)
+	}
+
+	credentials := &Credentials{ClientID: secret.ClientID, ClientSecret: secret.ClientSecret}
+	if len(secret.Certificate) > 0 || len(secret.PKCS12) > 0 {
+		credentials.Certificate = &secret
+	}
+	return credentials, nil
+}
+
+// EnvCredentialsProvider ... credenciais das variáveis CELCOIN_* lidas por LoadConfigFromEnv
+type EnvCredentialsProvider struct{}
+
+// NewEnvCredentialsProvider ...
+func NewEnvCredentialsProvider() *EnvCredentialsProvider {
+	return &EnvCredentialsProvider{}
+}
+
+// Retrieve ...
+func (p *EnvCredentialsProvider) Retrieve(context.Context) (*Credentials, error) {
+	config, err := LoadConfigFromEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	credentials := &Credentials{Certificate: config.Certificate}
+	if config.ClientID != nil {
+		credentials.ClientID = *config.ClientID
+	}
+	if config.ClientSecret != nil {
+		credentials.ClientSecret = *config.ClientSecret
+	}
+	if config.CertificateLoader != nil {
+		if credentials.Certificate, err = config.CertificateLoader(); err != nil {
+			return nil, err
+		}
+	}
+	return credentials, nil
+}
+
+// FileCredentialsProvider ... credenciais de um arquivo JSON no formato do Certificate, relido a cada Retrieve
+type FileCredentialsProvider struct {
+	path string
+}
+
+// NewFileCredentialsProvider ...
+func NewFileCredentialsProvider(path string) *FileCredentialsProvider {
+	return &FileCredentialsProvider{path: path}
+}
+
+// Retrieve ...
+func (p *FileCredentialsProvider) Retrieve(context.Context) (*Credentials, error) {
+	data, err := ioutil.ReadFile(p.path)
+	if err != nil {
+		return nil, err
+	}
+	return credentialsFromSecret(data)
+}
+
+// SecretsManagerCredentialsProvider ... credenciais de um segredo do AWS Secrets Manager no formato do Certificate
+type SecretsManagerCredentialsProvider struct {
+	client   secretsmanageriface.SecretsManagerAPI
+	secretID string
+	// VersionStage ... estágio lido (padrão AWSCURRENT)
+	VersionStage string
+}
+
+// NewSecretsManagerCredentialsProvider ... client pode ser o secretsmanager.New(session) ou um fake nos testes
+func NewSecretsManagerCredentialsProvider(client secretsmanageriface.SecretsManagerAPI, secretID string) *SecretsManagerCredentialsProvider {
+	return &SecretsManagerCredentialsProvider{client: client, secretID: secretID}
+}
+
+// Retrieve ...
+func (p *SecretsManagerCredentialsProvider) Retrieve(ctx context.Context) (*Credentials, error) {
+	input := &secretsmanager.GetSecretValueInput{SecretId: aws.String(p.secretID)}
+	if len(p.VersionStage) > 0 {
+		input.VersionStage = aws.String(p.VersionStage)
+	}
+
+	output, err := p.client.GetSecretValueWithContext(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("error getting secret %s: %w", p.secretID, err)
+	}
+
+	switch {
+	case output.SecretString != nil:
+		return credentialsFromSecret([]byte(*output.SecretString))
+	case len(output.SecretBinary) > 0:
+		return credentialsFromSecret(output.SecretBinary)
+	}
+	return nil, fmt.Errorf("secret %s is empty", p.secretID)
+}
+
+// CredentialsRotationHook ... chamado quando as credenciais mudam, com as credenciais anteriores e as atuais
+type CredentialsRotationHook func(previous, current Credentials)
+
+// RefreshingCredentials ... mantém as credenciais do CredentialsProvider e as relê periodicamente.
+// Se uma releitura falhar, as credenciais anteriores continuam em uso.
+type RefreshingCredentials struct {
+	provider CredentialsProvider
+	mutex    sync.RWMutex
+	current  *Credentials
+	onRotate []CredentialsRotationHook
+	stop     chan struct{}
+	stopOnce sync.Once
+	logger   Logger
+}
+
+// NewRefreshingCredentials ... lê as credenciais imediatamente e, com interval > 0, as relê a cada interval até Stop.
+// Com interval zero a releitura acontece apenas via Refresh.
+func NewRefreshingCredentials(ctx context.Context, provider CredentialsProvider, interval time.Duration) (*RefreshingCredentials, error) {
+	if provider == nil {
+		return nil, errors.New("credentials provider is required")
+	}
+
+	credentials, err := provider.Retrieve(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &RefreshingCredentials{
+		provider: provider,
+		current:  credentials,
+		stop:     make(chan struct{}),
+		logger:   NewLogrusLogger(nil),
+	}
+	if interval > 0 {
+		go r.run(interval)
+	}
+	return r, nil
+}
+
+// run ...
+func (r *RefreshingCredentials) run(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.stop:
+			return
+		case <-ticker.C:
+			if _, err := r.Refresh(context.Background()); err != nil {
+				r.logger.WithError(err).Error("error refreshing celcoin credentials, keeping current credentials")
+			}
+		}
+	}
+}
+
+// Refresh ... relê as credenciais e, quando mudaram, chama os CredentialsRotationHook. Retorna se houve rotação.
+func (r *RefreshingCredentials) Refresh(ctx context.Context) (bool, error) {
+	credentials, err := r.provider.Retrieve(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	r.mutex.Lock()
+	previous := r.current
+	if previous.equal(credentials) {
+		r.mutex.Unlock()
+		return false, nil
+	}
+	r.current = credentials
+	hooks := r.onRotate
+	r.mutex.Unlock()
+
+	r.logger.WithField("client_id", credentials.ClientID).Info("celcoin credentials rotated")
+	for _, hook := range hooks {
+		hook(*previous, *credentials)
+	}
+	return true, nil
+}
+
+// Credentials ... credenciais atuais
+func (r *RefreshingCredentials) Credentials() Credentials {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	return *r.current
+}
+
+// OnRotate ... registra uma função chamada sempre que as credenciais mudarem
+func (r *RefreshingCredentials) OnRotate(hook CredentialsRotationHook) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.onRotate = append(r.onRotate, hook)
+}
+
+// CertificateLoader ... CertificateLoader com o certificado das credenciais atuais, para o CertificateReloader do mTLS
+func (r *RefreshingCredentials) CertificateLoader() CertificateLoader {
+	return func() (*Certificate, error) {
+		credentials := r.Credentials()
+		if credentials.Certificate == nil {
+			return nil, newTransportError(TransportStepLoadCertificate, errors.New("credentials do not contain a certificate"))
+		}
+		return credentials.Certificate, nil
+	}
+}
+
+// Stop ... interrompe a releitura periódica
+func (r *RefreshingCredentials) Stop() {
+	r.stopOnce.Do(func() { close(r.stop) })
+}
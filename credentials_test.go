@@ -0,0 +1,206 @@
+package celcoin_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
+	"github.com/contbank/celcoin-sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+// fakeSecretsManager ... implementa apenas GetSecretValueWithContext da API do Secrets Manager
+type fakeSecretsManager struct {
+	secretsmanageriface.SecretsManagerAPI
+	mutex  sync.Mutex
+	secret string
+	err    error
+	input  *secretsmanager.GetSecretValueInput
+}
+
+// GetSecretValueWithContext ...
+func (f *fakeSecretsManager) GetSecretValueWithContext(_ aws.Context, input *secretsmanager.GetSecretValueInput,
+	_ ...request.Option) (*secretsmanager.GetSecretValueOutput, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.input = input
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &secretsmanager.GetSecretValueOutput{SecretString: aws.String(f.secret)}, nil
+}
+
+// set ...
+func (f *fakeSecretsManager) set(secret celcoin.Certificate) {
+	data, _ := json.Marshal(secret)
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.secret = string(data)
+	f.err = nil
+}
+
+// CredentialsTestSuite ...
+type CredentialsTestSuite struct {
+	suite.Suite
+	assert  *assert.Assertions
+	ctx     context.Context
+	secrets *fakeSecretsManager
+	server  *httptest.Server
+	mutex   sync.Mutex
+	tokens  []string
+	auth    string
+}
+
+// TestCredentialsTestSuite ...
+func TestCredentialsTestSuite(t *testing.T) {
+	suite.Run(t, new(CredentialsTestSuite))
+}
+
+// SetupTest ...
+func (s *CredentialsTestSuite) SetupTest() {
+	s.assert = assert.New(s.T())
+	s.ctx = context.Background()
+	s.tokens = nil
+	s.secrets = &fakeSecretsManager{}
+	s.secrets.set(celcoin.Certificate{UUID: "uuid-1", ClientID: "client-id", ClientSecret: "secret-1"})
+
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/"+celcoin.LoginPath):
+			r.ParseForm()
+			token := "token-" + r.PostForm.Get("client_secret")
+			s.tokens = append(s.tokens, token)
+			json.NewEncoder(w).Encode(celcoin.AuthenticationResponse{AccessToken: token, ExpiresIn: 3600})
+		case r.URL.Path == celcoin.BalancePath:
+			s.auth = r.Header.Get("Authorization")
+			w.Write([]byte(`{"status":"SUCCESS","version":"1.0.0","body":{"amount":10}}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+}
+
+// TearDownTest ...
+func (s *CredentialsTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+// TestSecretsManagerProvider ...
+func (s *CredentialsTestSuite) TestSecretsManagerProvider() {
+	ca := newTestCA(s.T(), "Celcoin Test CA", nil)
+	certPEM, keyPEM := ca.issue(s.T(), "client")
+	s.secrets.set(celcoin.Certificate{ClientID: "client-id", ClientSecret: "secret-1", Certificate: certPEM, PrivateKey: keyPEM})
+
+	provider := celcoin.NewSecretsManagerCredentialsProvider(s.secrets, "celcoin/credentials")
+	provider.VersionStage = "AWSPENDING"
+
+	credentials, err := provider.Retrieve(s.ctx)
+	s.Require().NoError(err)
+	s.assert.Equal("client-id", credentials.ClientID)
+	s.assert.Equal("secret-1", credentials.ClientSecret)
+	s.Require().NotNil(credentials.Certificate)
+	s.assert.Equal(certPEM, credentials.Certificate.Certificate)
+	s.assert.Equal("celcoin/credentials", *s.secrets.input.SecretId)
+	s.assert.Equal("AWSPENDING", *s.secrets.input.VersionStage)
+
+	s.secrets.err = errors.New("access denied")
+	_, err = provider.Retrieve(s.ctx)
+	s.assert.ErrorContains(err, "access denied")
+}
+
+// TestFileAndEnvProviders ...
+func (s *CredentialsTestSuite) TestFileAndEnvProviders() {
+	path := filepath.Join(s.T().TempDir(), "credentials.json")
+	s.Require().NoError(ioutil.WriteFile(path, []byte(`{"uuid":"1","client_id":"file-id","client_secret":"file-secret"}`), 0600))
+
+	credentials, err := celcoin.NewFileCredentialsProvider(path).Retrieve(s.ctx)
+	s.Require().NoError(err)
+	s.assert.Equal(celcoin.Credentials{ClientID: "file-id", ClientSecret: "file-secret"}, *credentials)
+
+	s.T().Setenv("CELCOIN_CLIENT_ID", "env-id")
+	s.T().Setenv("CELCOIN_CLIENT_SECRET", "env-secret")
+	credentials, err = celcoin.NewEnvCredentialsProvider().Retrieve(s.ctx)
+	s.Require().NoError(err)
+	s.assert.Equal(celcoin.Credentials{ClientID: "env-id", ClientSecret: "env-secret"}, *credentials)
+}
+
+// TestRotationRenewsToken ...
+func (s *CredentialsTestSuite) TestRotationRenewsToken() {
+	client, err := celcoin.NewClient(celcoin.Config{
+		APIEndpoint:                celcoin.String(s.server.URL),
+		LoginEndpoint:              celcoin.String(s.server.URL),
+		Logger:                     celcoin.NewNopLogger(),
+		CredentialsProvider:        celcoin.NewSecretsManagerCredentialsProvider(s.secrets, "celcoin/credentials"),
+		CredentialsRefreshInterval: durationPtr(0),
+	})
+	s.Require().NoError(err)
+	s.Require().NotNil(client.Credentials)
+	s.assert.Equal("client-id", client.Session.ClientID)
+
+	var rotated []string
+	client.Credentials.OnRotate(func(previous, current celcoin.Credentials) {
+		rotated = append(rotated, previous.ClientSecret+"->"+current.ClientSecret)
+	})
+
+	_, err = client.Balance.Balance(s.ctx, "123456")
+	s.Require().NoError(err)
+	s.assert.Equal("Bearer token-secret-1", s.auth)
+
+	changed, err := client.Credentials.Refresh(s.ctx)
+	s.Require().NoError(err)
+	s.assert.False(changed)
+
+	s.secrets.set(celcoin.Certificate{UUID: "uuid-1", ClientID: "client-id", ClientSecret: "secret-2"})
+	changed, err = client.Credentials.Refresh(s.ctx)
+	s.Require().NoError(err)
+	s.assert.True(changed)
+	s.assert.Equal([]string{"secret-1->secret-2"}, rotated)
+
+	_, err = client.Balance.Balance(s.ctx, "123456")
+	s.Require().NoError(err)
+	s.assert.Equal("Bearer token-secret-2", s.auth)
+	s.assert.Equal([]string{"token-secret-1", "token-secret-2"}, s.tokens)
+}
+
+// TestPeriodicRefreshKeepsCredentialsOnError ...
+func (s *CredentialsTestSuite) TestPeriodicRefreshKeepsCredentialsOnError() {
+	credentials, err := celcoin.NewRefreshingCredentials(s.ctx,
+		celcoin.NewSecretsManagerCredentialsProvider(s.secrets, "celcoin/credentials"), 5*time.Millisecond)
+	s.Require().NoError(err)
+	defer credentials.Stop()
+
+	s.secrets.mutex.Lock()
+	s.secrets.err = errors.New("throttled")
+	s.secrets.mutex.Unlock()
+	time.Sleep(20 * time.Millisecond)
+	s.assert.Equal("secret-1", credentials.Credentials().ClientSecret)
+
+	s.secrets.set(celcoin.Certificate{ClientID: "client-id", ClientSecret: "secret-2"})
+	s.assert.Eventually(func() bool {
+		return credentials.Credentials().ClientSecret == "secret-2"
+	}, time.Second, 5*time.Millisecond)
+
+	_, err = credentials.CertificateLoader()()
+	s.assert.Error(err)
+}
+
+// durationPtr ...
+func durationPtr(d time.Duration) *time.Duration {
+	return &d
+}
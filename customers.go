@@ -2,16 +2,37 @@ package celcoin
 
 import (
 	"context"
-	"encoding/json"
-	"io/ioutil"
 	"net/http"
-	"net/url"
-	"path"
-	"strings"
+)
 
-	"github.com/sirupsen/logrus"
+var (
+	// customersErrors ... erros da API de contas, retornados na lista "errors"
+	customersErrors = errorListErrors{fallback: ErrDefaultCustomersAccounts}
+	// onboardingErrors ... erros das APIs de onboarding
+	onboardingErrors = envelopeErrors{find: ignoreMessage(FindOnboardingError),
+		fallback: ErrDefaultCustomersAccounts, rawDecodeErrors: true}
+	// cancelAccountErrors ... erros da API de encerramento de conta
+	cancelAccountErrors = envelopeErrors{find: ignoreMessage(FindCancelAccountError),
+		fallback: ErrDefaultBusinessAccounts, rawDecodeErrors: true}
+	// updateAccountStatusErrors ... erros da API de alteração de status da conta
+	updateAccountStatusErrors = envelopeErrors{find: ignoreMessage(FindUpdateAccountStatusAccountErrors),
+		fallback: ErrDefaultBusinessAccounts, rawDecodeErrors: true}
 )
 
+// customersSuccessStatus ...
+var customersSuccessStatus = []int{http.StatusOK}
+
+// CustomersInterface define a interface para as contas de pessoa física.
+type CustomersInterface interface {
+	FindAccounts(ctx context.Context, documentNumber *string, accountNumber *string) (*CustomerResponse, error)
+	CreateAccount(ctx context.Context, customerData *Customer) (*CustomerOnboardingResponse, error)
+	CreateAccountMigration(ctx context.Context, customerData *CustomerMigration) (*CustomerOnboardingResponse, error)
+	GetOnboardingProposal(ctx context.Context, proposalId string) (*OnboardingProposalResponse, error)
+	GetOnboardingProposalFiles(ctx context.Context, proposalId string) (*OnboardingProposalFilesResponse, error)
+	UpdateAccountStatus(ctx context.Context, accountNumber *string, documentNumber *string, reason *string, status *string) (*UpdateAccountStatusResponse, error)
+	CancelAccount(ctx context.Context, accountNumber *string, documentNumber *string, reason *string) (*CancelAccountResponse, error)
+}
+
 // Customers ...
 type Customers struct {
 	session        Session
@@ -19,57 +40,42 @@ type Customers struct {
 	authentication *Authentication
 }
 
+var _ CustomersInterface = (*Customers)(nil)
+
 // NewCustomers ...
-func NewCustomers(httpClient *http.Client, session Session) *Customers {
+func NewCustomers(httpClient *http.Client, session Session) CustomersInterface {
 	return &Customers{
 		session:        session,
-		httpClient:     NewLoggingHTTPClient(httpClient),
+		httpClient:     newSessionLoggingHTTPClient(httpClient, session),
 		authentication: NewAuthentication(httpClient, session),
 	}
 }
 
 // UpdateAccountStatus ... faz atualização do status da conta
 func (c *Customers) UpdateAccountStatus(ctx context.Context,
-	accountNumber *string, documentNumber *string, reason *string, status *string) (*UpdateAccountStatusResponse, error) {
+	accountNumber *string, documentNumber *string, reason *string, status *string) (_ *UpdateAccountStatusResponse, err error) {
+	ctx, op := c.session.startOperation(ctx, "customers", "UpdateAccountStatus", UpdateAccountStatusPath)
+	defer op.end(&err)
+	logger := op.logger
 
-	requestID, _ := ctx.Value("Request-Id").(string)
-	fields := logrus.Fields{
+	requestID := RequestIDFrom(ctx)
+	fields := Fields{
 		"request_id": requestID,
-		"interface":  "CancelAccount",
-		"service":    "business",
+		"interface":  "UpdateAccountStatus",
 	}
 
+	queryParams := map[string]string{}
 	if accountNumber != nil {
 		fields["account"] = accountNumber
+		queryParams["account"] = *accountNumber
 	}
 
 	if documentNumber != nil {
 		fields["document_number"] = documentNumber
+		queryParams["documentNumber"] = *documentNumber
 	}
 
-	endpoint := c.session.APIEndpoint
-	u, err := url.Parse(endpoint)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).
-			Error("error parsing endpoint")
-		return nil, err
-	}
-
-	u.Path = path.Join(u.Path, UpdateAccountStatusPath)
-
-	q := u.Query()
-	if accountNumber != nil {
-		q.Set("account", *accountNumber)
-	}
-
-	if documentNumber != nil {
-		q.Set("documentNumber", *documentNumber)
-	}
-
-	u.RawQuery = q.Encode()
-
-	logrus.WithFields(fields).WithField("celcoin_endpoint", u.String()).
-		Info("celcoin update account status request")
+	logger.WithFields(fields).Info("celcoin update account status request")
 
 	bodyPayload := struct {
 		Status string `json:"status"`
@@ -79,628 +85,239 @@ func (c *Customers) UpdateAccountStatus(ctx context.Context,
 		Reason: *reason,
 	}
 
-	reqBody, err := json.Marshal(bodyPayload)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).
-			Error("error marshalling request body")
-		return nil, err
-	}
-
-	req, err := http.NewRequestWithContext(ctx, "PUT", u.String(), strings.NewReader(string(reqBody)))
+	response, err := execute[UpdateAccountStatusResponse](ctx, c.httpClient, c.session, apiRequest{
+		method:  http.MethodPut,
+		path:    UpdateAccountStatusPath,
+		query:   queryParams,
+		body:    bodyPayload,
+		header:  jsonHeader,
+		success: customersSuccessStatus,
+		errors:  updateAccountStatusErrors,
+		fields:  fields,
+	})
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).
-			Error("error creating request")
 		return nil, err
 	}
 
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).
-			Error("error executing request")
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).
-			Error("error reading response body")
-		return nil, err
-	}
-
-	if resp.StatusCode == http.StatusOK {
-		var response UpdateAccountStatusResponse
-		if err := json.Unmarshal(respBody, &response); err != nil {
-			logrus.WithFields(fields).WithError(err).
-				Error("error unmarshalling response")
-			return nil, err
-		}
-
-		logrus.WithFields(fields).WithField("celcoin_response", response).
-			Info("response with success")
-		return &response, nil
-	}
-
-	var errResponse *ErrorDefaultResponse
-	if err := json.Unmarshal(respBody, &errResponse); err != nil {
-		logrus.WithFields(fields).WithError(err).Error("error unmarshalling error response")
-		return nil, err
-	}
-
-	if errResponse != nil && errResponse.Error != nil && len(*errResponse.Error.ErrorCode) > 0 {
-		err := FindUpdateAccountStatusAccountErrors(*errResponse.Error.ErrorCode, &resp.StatusCode)
-		logrus.WithFields(fields).WithError(err).
-			Error("error updating account status")
-		return nil, err
-	}
-
-	logrus.WithFields(fields).
-		Error("error updating account status")
-	return nil, ErrDefaultBusinessAccounts
+	logger.WithFields(fields).WithField("celcoin_response", response).
+		Info("response with success")
+	return response, nil
 }
 
 // FindAccounts ...
 func (c *Customers) FindAccounts(ctx context.Context,
-	documentNumber *string, accountNumber *string) (*CustomerResponse, error) {
+	documentNumber *string, accountNumber *string) (_ *CustomerResponse, err error) {
+	ctx, op := c.session.startOperation(ctx, "customers", "FindAccounts", CustomersPath)
+	defer op.end(&err)
+	logger := op.logger
 
-	requestID, _ := ctx.Value("Request-Id").(string)
-	fields := logrus.Fields{
+	requestID := RequestIDFrom(ctx)
+	fields := Fields{
 		"request_id": requestID,
 		"interface":  "FindAccounts",
-		"service":    "customers",
 	}
 
+	queryParams := map[string]string{}
 	if documentNumber != nil {
 		fields["document_number"] = documentNumber
+		queryParams["documentNumber"] = *documentNumber
 	}
 
 	if accountNumber != nil {
 		fields["account_number"] = accountNumber
+		queryParams["account"] = *accountNumber
 	}
 
-	endpoint, err := c.getCustomerAPIEndpoint(requestID, documentNumber, accountNumber)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).
-			Error("error getting customer api endpoint")
-		return nil, err
-	}
-
-	logrus.WithFields(fields).WithField("celcoin_endpoint", endpoint).
-		Info("natural person find account request")
+	logger.WithFields(fields).Info("natural person find account request")
 
-	req, err := http.NewRequestWithContext(ctx, "GET", *endpoint, nil)
+	response, err := execute[CustomerResponse](ctx, c.httpClient, c.session, apiRequest{
+		method:   http.MethodGet,
+		path:     CustomersPath,
+		query:    queryParams,
+		success:  customersSuccessStatus,
+		notFound: ErrEntryNotFound,
+		errors:   customersErrors,
+		fields:   fields,
+	})
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).
-			Error("error new request")
-		return nil, err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).
-			Error("error http client")
-		return nil, err
-	}
-
-	defer resp.Body.Close()
-
-	respBody, _ := ioutil.ReadAll(resp.Body)
-
-	if resp.StatusCode == http.StatusOK {
-		var response CustomerResponse
-
-		if err := json.Unmarshal(respBody, &response); err != nil {
-			logrus.WithFields(fields).WithError(err).
-				Error("error unmarshal")
-			return nil, err
-		}
-
-		logrus.WithFields(fields).WithField("celcoin_response", response).
-			Info("response with success")
-
-		return &response, nil
-	}
-
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, ErrEntryNotFound
-	}
-
-	var bodyErr *ErrorResponse
-
-	if err := json.Unmarshal(respBody, &bodyErr); err != nil {
-		logrus.WithFields(fields).WithError(err).
-			Error("error unmarshal")
 		return nil, err
 	}
 
-	if len(bodyErr.Errors) > 0 {
-		errModel := bodyErr.Errors[0]
-		err := FindError(errModel.Code, errModel.Messages...)
-		logrus.WithFields(fields).WithError(err).
-			Error("error finding customers account")
-		return nil, err
-	}
-
-	logrus.WithFields(fields).
-		Error("error finding customers account")
-
-	return nil, ErrDefaultCustomersAccounts
+	logger.WithFields(fields).WithField("celcoin_response", response).
+		Info("response with success")
+	return response, nil
 }
 
 // CreateAccount ... cria uma nova conta de cliente
-func (c *Customers) CreateAccount(ctx context.Context, customerData *Customer) (*CustomerOnboardingResponse, error) {
-	requestID, _ := ctx.Value("Request-Id").(string)
-	fields := logrus.Fields{
+func (c *Customers) CreateAccount(ctx context.Context, customerData *Customer) (_ *CustomerOnboardingResponse, err error) {
+	ctx, op := c.session.startOperation(ctx, "customers", "CreateAccount", NaturalPersonOnboardingPath)
+	defer op.end(&err)
+	logger := op.logger
+	requestID := RequestIDFrom(ctx)
+	fields := Fields{
 		"request_id": requestID,
 		"interface":  "CreateAccount",
-		"service":    "customers",
-	}
-
-	endpoint := c.session.APIEndpoint
-	reqBody, err := json.Marshal(customerData)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).
-			Error("error marshalling request body")
-		return nil, err
-	}
-
-	logrus.WithFields(fields).
-		Info("request body marshalled successfully")
-
-	url, err := url.Parse(endpoint)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).
-			Error("error parsing endpoint")
-		return nil, err
-	}
-
-	url.Path = path.Join(url.Path, NaturalPersonOnboardingPath)
-
-	logrus.WithFields(fields).WithField("celcoin_endpoint", url.String()).
-		Info("natural person proposal request")
-
-	req, err := http.NewRequestWithContext(ctx, "POST", url.String(), strings.NewReader(string(reqBody)))
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).
-			Error("error creating request")
-		return nil, err
 	}
 
-	req.Header.Set("Content-Type", "application/json")
-
-	logrus.WithFields(fields).
-		Info("request created successfully")
+	logger.WithFields(fields).Info("natural person proposal request")
 
-	resp, err := c.httpClient.Do(req)
+	response, err := execute[CustomerOnboardingResponse](ctx, c.httpClient, c.session, apiRequest{
+		method:  http.MethodPost,
+		path:    NaturalPersonOnboardingPath,
+		body:    customerData,
+		header:  jsonHeader,
+		success: customersSuccessStatus,
+		errors:  onboardingErrors,
+		fields:  fields,
+	})
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("error executing request")
 		return nil, err
 	}
-	defer resp.Body.Close()
-
-	logrus.WithFields(fields).
-		Info("request executed successfully")
 
-	respBody, _ := ioutil.ReadAll(resp.Body)
-
-	logrus.WithFields(fields).
-		Infof("response received with status code %d", resp.StatusCode)
-
-	if resp.StatusCode == http.StatusOK {
-		var response CustomerOnboardingResponse
-		if err := json.Unmarshal(respBody, &response); err != nil {
-			logrus.WithFields(fields).WithError(err).Error("error unmarshalling response")
-			return nil, err
-		}
-
-		logrus.WithFields(fields).WithField("celcoin_response", response).
-			Info("response with success")
-		return &response, nil
-	}
-
-	var errResponse *ErrorDefaultResponse
-	if err := json.Unmarshal(respBody, &errResponse); err != nil {
-		logrus.WithFields(fields).WithError(err).
-			Error("error unmarshalling error response")
-		return nil, err
-	}
-
-	if errResponse != nil && errResponse.Error != nil && len(*errResponse.Error.ErrorCode) > 0 {
-		err := FindOnboardingError(*errResponse.Error.ErrorCode, &resp.StatusCode)
-		logrus.WithFields(fields).WithError(err).
-			Error("error creating natural person onboarding proposal")
-		return nil, err
-	}
-
-	logrus.WithFields(fields).
-		Error("error default create account")
-	return nil, ErrDefaultCustomersAccounts
+	logger.WithFields(fields).WithField("celcoin_response", response).
+		Info("response with success")
+	return response, nil
 }
 
 // GetOnboardingProposal ... consulta o proposalId
-func (c *Customers) GetOnboardingProposal(ctx context.Context, proposalId string) (*OnboardingProposalResponse, error) {
-	requestID, _ := ctx.Value("Request-Id").(string)
-	fields := logrus.Fields{
+func (c *Customers) GetOnboardingProposal(ctx context.Context, proposalId string) (_ *OnboardingProposalResponse, err error) {
+	ctx, op := c.session.startOperation(ctx, "customers", "GetOnboardingProposal", ProposalsPath)
+	defer op.end(&err)
+	logger := op.logger
+	requestID := RequestIDFrom(ctx)
+	fields := Fields{
 		"request_id":  requestID,
 		"interface":   "GetOnboardingProposal",
-		"service":     "customers",
 		"proposal_id": proposalId,
 	}
 
-	endpoint := c.session.APIEndpoint
-	u, err := url.Parse(endpoint)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).
-			Error("error parsing endpoint")
-		return nil, err
-	}
-
-	u.Path = path.Join(u.Path, ProposalsPath)
-
-	q := u.Query()
-	q.Set("proposalId", proposalId)
-	u.RawQuery = q.Encode()
-
-	logrus.WithFields(fields).WithField("celcoin_endpoint", u.String()).
-		Info("natural person get onboarding proposal")
+	logger.WithFields(fields).Info("natural person get onboarding proposal")
 
-	req, err := http.NewRequestWithContext(ctx, "GET", u.String(), nil)
+	response, err := execute[OnboardingProposalResponse](ctx, c.httpClient, c.session, apiRequest{
+		method:  http.MethodGet,
+		path:    ProposalsPath,
+		query:   map[string]string{"proposalId": proposalId},
+		success: customersSuccessStatus,
+		errors:  onboardingErrors,
+		fields:  fields,
+	})
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("error creating request")
-		return nil, err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("error executing request")
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	respBody, _ := ioutil.ReadAll(resp.Body)
-
-	if resp.StatusCode == http.StatusOK {
-		var response OnboardingProposalResponse
-		if err := json.Unmarshal(respBody, &response); err != nil {
-			logrus.WithFields(fields).WithError(err).Error("error unmarshalling response")
-			return nil, err
-		}
-
-		logrus.WithFields(fields).WithField("celcoin_response", response).
-			Info("response with success")
-		return &response, nil
-	}
-
-	var errResponse *ErrorDefaultResponse
-	if err := json.Unmarshal(respBody, &errResponse); err != nil {
-		logrus.WithFields(fields).WithError(err).
-			Error("error unmarshalling error response")
 		return nil, err
 	}
 
-	if errResponse != nil && errResponse.Error != nil && len(*errResponse.Error.ErrorCode) > 0 {
-		err := FindOnboardingError(*errResponse.Error.ErrorCode, &resp.StatusCode)
-		logrus.WithFields(fields).WithError(err).
-			Error("error getting natural person onboarding proposal")
-		return nil, err
-	}
-
-	logrus.WithFields(fields).
-		Error("error default onboarding proposal")
-
-	return nil, ErrDefaultCustomersAccounts
+	logger.WithFields(fields).WithField("celcoin_response", response).
+		Info("response with success")
+	return response, nil
 }
 
 // GetOnboardingProposalFiles ... consulta os arquivos do proposalId
-func (c *Customers) GetOnboardingProposalFiles(ctx context.Context, proposalId string) (*OnboardingProposalFilesResponse, error) {
-	requestID, _ := ctx.Value("Request-Id").(string)
-	fields := logrus.Fields{
+func (c *Customers) GetOnboardingProposalFiles(ctx context.Context, proposalId string) (_ *OnboardingProposalFilesResponse, err error) {
+	ctx, op := c.session.startOperation(ctx, "customers", "GetOnboardingProposalFiles", ProposalFilesPath)
+	defer op.end(&err)
+	logger := op.logger
+	requestID := RequestIDFrom(ctx)
+	fields := Fields{
 		"request_id":  requestID,
 		"interface":   "GetOnboardingProposalFiles",
-		"service":     "customers",
 		"proposal_id": proposalId,
 	}
 
-	endpoint := c.session.APIEndpoint
-	u, err := url.Parse(endpoint)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).
-			Error("error parsing endpoint")
-		return nil, err
-	}
-
-	u.Path = path.Join(u.Path, ProposalFilesPath)
-
-	q := u.Query()
-	q.Set("proposalId", proposalId)
-	u.RawQuery = q.Encode()
-
-	logrus.WithFields(fields).WithField("celcoin_endpoint", u.String()).
-		Info("natural person get onboarding proposal files")
-
-	req, err := http.NewRequestWithContext(ctx, "GET", u.String(), nil)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).
-			Error("error creating request")
-		return nil, err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).
-			Error("error executing request")
-		return nil, err
-	}
-	defer resp.Body.Close()
+	logger.WithFields(fields).Info("natural person get onboarding proposal files")
 
-	respBody, err := ioutil.ReadAll(resp.Body)
+	response, err := execute[OnboardingProposalFilesResponse](ctx, c.httpClient, c.session, apiRequest{
+		method:  http.MethodGet,
+		path:    ProposalFilesPath,
+		query:   map[string]string{"proposalId": proposalId},
+		success: customersSuccessStatus,
+		errors:  onboardingErrors,
+		fields:  fields,
+	})
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).
-			Error("error reading response body")
-		return nil, err
-	}
-
-	if resp.StatusCode == http.StatusOK {
-		var response OnboardingProposalFilesResponse
-		if err := json.Unmarshal(respBody, &response); err != nil {
-			logrus.WithFields(fields).WithError(err).
-				Error("error unmarshalling response")
-			return nil, err
-		}
-
-		logrus.WithFields(fields).WithField("celcoin_response", response).
-			Info("response with success")
-		return &response, nil
-	}
-
-	var errResponse *ErrorDefaultResponse
-	if err := json.Unmarshal(respBody, &errResponse); err != nil {
-		logrus.WithFields(fields).WithError(err).
-			Error("error unmarshalling error response")
 		return nil, err
 	}
 
-	if errResponse != nil && errResponse.Error != nil && len(*errResponse.Error.ErrorCode) > 0 {
-		err := FindOnboardingError(*errResponse.Error.ErrorCode, &resp.StatusCode)
-		logrus.WithFields(fields).WithError(err).
-			Error("error getting natural person onboarding proposal files")
-		return nil, err
-	}
-
-	logrus.WithFields(fields).
-		Error("error getting natural person onboarding proposal files")
-	return nil, ErrDefaultCustomersAccounts
+	logger.WithFields(fields).WithField("celcoin_response", response).
+		Info("response with success")
+	return response, nil
 }
 
-// getCustomerAPIEndpoint
-func (c *Customers) getCustomerAPIEndpoint(requestID string, documentNumber *string,
-	accountNumber *string) (*string, error) {
-
-	fields := logrus.Fields{
-		"request_id":      requestID,
-		"document_number": documentNumber,
-		"account_number":  accountNumber,
-	}
-
-	u, err := url.Parse(c.session.APIEndpoint)
-	if err != nil {
-		logrus.WithFields(fields).
-			WithError(err).Error("error api endpoint")
-		return nil, err
-	}
-
-	u.Path = path.Join(u.Path, CustomersPath)
-	q := u.Query()
-
-	if documentNumber != nil && len(*documentNumber) > 0 {
-		q.Set("documentNumber", *documentNumber)
-	}
-
-	if accountNumber != nil && len(*accountNumber) > 0 {
-		q.Set("account", *accountNumber)
-	}
-
-	u.RawQuery = q.Encode()
-	endpoint := u.String()
-
-	fields["endpoint"] = endpoint
-	logrus.WithFields(fields).Info("get endpoint success")
-
-	return &endpoint, nil
-}
-
-// CancelAccount ... consulta os arquivos do proposalId
+// CancelAccount ... encerra a conta
 func (c *Customers) CancelAccount(ctx context.Context,
-	accountNumber *string, documentNumber *string, reason *string) (*CancelAccountResponse, error) {
+	accountNumber *string, documentNumber *string, reason *string) (_ *CancelAccountResponse, err error) {
+	ctx, op := c.session.startOperation(ctx, "customers", "CancelAccount", CancelAccountPath)
+	defer op.end(&err)
+	logger := op.logger
 
-	requestID, _ := ctx.Value("Request-Id").(string)
-	fields := logrus.Fields{
+	requestID := RequestIDFrom(ctx)
+	fields := Fields{
 		"request_id": requestID,
 		"interface":  "CancelAccount",
-		"service":    "business",
 	}
 
+	queryParams := map[string]string{}
 	if accountNumber != nil {
 		fields["account_number"] = accountNumber
+		queryParams["account"] = *accountNumber
 	}
 
 	if reason != nil {
 		fields["reason"] = reason
+		queryParams["reason"] = *reason
 	}
 
 	if documentNumber != nil {
 		fields["document_number"] = documentNumber
-	}
-
-	endpoint := c.session.APIEndpoint
-	u, err := url.Parse(endpoint)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).
-			Error("error parsing endpoint")
-		return nil, err
-	}
-
-	u.Path = path.Join(u.Path, CancelAccountPath)
-
-	q := u.Query()
-	if accountNumber != nil {
-		q.Set("account", *accountNumber)
-	}
-
-	if reason != nil {
-		q.Set("reason", *reason)
-	}
-
-	if documentNumber != nil && accountNumber == nil {
-		q.Set("documentNumber", *documentNumber)
-	}
-
-	u.RawQuery = q.Encode()
-
-	logrus.WithFields(fields).WithField("celcoin_endpoint", u.String()).
-		Info("celcoin cancel account request")
-
-	req, err := http.NewRequestWithContext(ctx, "DELETE", u.String(), nil)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).
-			Error("error creating request")
-		return nil, err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).
-			Error("error executing request")
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).
-			Error("error reading response body")
-		return nil, err
-	}
-
-	if resp.StatusCode == http.StatusOK {
-		var response CancelAccountResponse
-		if err := json.Unmarshal(respBody, &response); err != nil {
-			logrus.WithFields(fields).WithError(err).
-				Error("error unmarshalling response")
-			return nil, err
+		if accountNumber == nil {
+			queryParams["documentNumber"] = *documentNumber
 		}
-
-		logrus.WithFields(fields).WithField("celcoin_response", response).
-			Info("response with success")
-		return &response, nil
 	}
 
-	var errResponse *ErrorDefaultResponse
-	if err := json.Unmarshal(respBody, &errResponse); err != nil {
-		logrus.WithFields(fields).WithError(err).Error("error unmarshalling error response")
-		return nil, err
-	}
+	logger.WithFields(fields).Info("celcoin cancel account request")
 
-	if errResponse != nil && errResponse.Error != nil && len(*errResponse.Error.ErrorCode) > 0 {
-		err := FindCancelAccountError(*errResponse.Error.ErrorCode, &resp.StatusCode)
-		logrus.WithFields(fields).WithError(err).
-			Error("error cancel account")
+	response, err := execute[CancelAccountResponse](ctx, c.httpClient, c.session, apiRequest{
+		method:  http.MethodDelete,
+		path:    CancelAccountPath,
+		query:   queryParams,
+		success: customersSuccessStatus,
+		errors:  cancelAccountErrors,
+		fields:  fields,
+	})
+	if err != nil {
 		return nil, err
 	}
 
-	logrus.WithFields(fields).
-		Error("error cancel account")
-	return nil, ErrDefaultBusinessAccounts
+	logger.WithFields(fields).WithField("celcoin_response", response).
+		Info("response with success")
+	return response, nil
 }
 
 // CreateAccountMigration ... cria uma nova conta de cliente
-func (c *Customers) CreateAccountMigration(ctx context.Context, customerData *CustomerMigration) (*CustomerOnboardingResponse, error) {
-	requestID, _ := ctx.Value("Request-Id").(string)
-	fields := logrus.Fields{
+func (c *Customers) CreateAccountMigration(ctx context.Context, customerData *CustomerMigration) (_ *CustomerOnboardingResponse, err error) {
+	ctx, op := c.session.startOperation(ctx, "customers", "CreateAccountMigration", NaturalPersonOnboardingPath)
+	defer op.end(&err)
+	logger := op.logger
+	requestID := RequestIDFrom(ctx)
+	fields := Fields{
 		"request_id": requestID,
-		"interface":  "CreateAccount",
-		"service":    "customers",
+		"interface":  "CreateAccountMigration",
 	}
 
-	endpoint := c.session.APIEndpoint
-	reqBody, err := json.Marshal(customerData)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).
-			Error("error marshalling request body")
-		return nil, err
-	}
-
-	logrus.WithFields(fields).
-		Info("request body marshalled successfully")
-
-	url, err := url.Parse(endpoint)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).
-			Error("error parsing endpoint")
-		return nil, err
-	}
-
-	url.Path = path.Join(url.Path, NaturalPersonOnboardingPath)
-
-	logrus.WithFields(fields).WithField("celcoin_endpoint", url.String()).
-		Info("natural person proposal request")
-
-	req, err := http.NewRequestWithContext(ctx, "POST", url.String(), strings.NewReader(string(reqBody)))
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).
-			Error("error creating request")
-		return nil, err
-	}
-
-	req.Header.Set("Content-Type", "application/json")
-
-	logrus.WithFields(fields).
-		Info("request created successfully")
+	logger.WithFields(fields).Info("natural person proposal request")
 
-	resp, err := c.httpClient.Do(req)
+	response, err := execute[CustomerOnboardingResponse](ctx, c.httpClient, c.session, apiRequest{
+		method:  http.MethodPost,
+		path:    NaturalPersonOnboardingPath,
+		body:    customerData,
+		header:  jsonHeader,
+		success: customersSuccessStatus,
+		errors:  onboardingErrors,
+		fields:  fields,
+	})
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("error executing request")
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	logrus.WithFields(fields).
-		Info("request executed successfully")
-
-	respBody, _ := ioutil.ReadAll(resp.Body)
-
-	logrus.WithFields(fields).
-		Infof("response received with status code %d", resp.StatusCode)
-
-	if resp.StatusCode == http.StatusOK {
-		var response CustomerOnboardingResponse
-		if err := json.Unmarshal(respBody, &response); err != nil {
-			logrus.WithFields(fields).WithError(err).Error("error unmarshalling response")
-			return nil, err
-		}
-
-		logrus.WithFields(fields).WithField("celcoin_response", response).
-			Info("response with success")
-		return &response, nil
-	}
-
-	var errResponse *ErrorDefaultResponse
-	if err := json.Unmarshal(respBody, &errResponse); err != nil {
-		logrus.WithFields(fields).WithError(err).
-			Error("error unmarshalling error response")
-		return nil, err
-	}
-
-	if errResponse != nil && errResponse.Error != nil && len(*errResponse.Error.ErrorCode) > 0 {
-		err := FindOnboardingError(*errResponse.Error.ErrorCode, &resp.StatusCode)
-		logrus.WithFields(fields).WithError(err).
-			Error("error creating natural person onboarding proposal")
 		return nil, err
 	}
 
-	logrus.WithFields(fields).
-		Error("error default create account")
-	return nil, ErrDefaultCustomersAccounts
+	logger.WithFields(fields).WithField("celcoin_response", response).
+		Info("response with success")
+	return response, nil
 }
@@ -20,7 +20,7 @@ type CustomersTestSuite struct {
 	assert     *assert.Assertions
 	ctx        context.Context
 	session    *Session
-	customers  *Customers
+	customers  CustomersInterface
 	mockClient *MockHTTPClient
 }
 
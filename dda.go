@@ -1,19 +1,22 @@
 package celcoin
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
-	"fmt"
-	"io/ioutil"
 	"net/http"
-	"net/url"
-	"path"
 
 	"github.com/contbank/grok"
-	"github.com/sirupsen/logrus"
 )
 
+// ddaErrors ... erros da API de DDA, retornados na chave "erro"
+var ddaErrors = envelopeErrors{erro: true, find: ignoreMessage(FindDdaError), fallback: ErrDefaultDda}
+
+// DdaInterface define a interface para o cadastro de usuários no DDA.
+type DdaInterface interface {
+	BuildEndpoint(basePath string, queryParams map[string]string, pathParams ...string) (*string, error)
+	CreateRegisterUser(ctx context.Context, correlationID string, model DdaRegisterUserRequest) (*DdaRegisterUserResponse, error)
+	DeleteRegisterUser(ctx context.Context, correlationID string, model DdaDeleteUserRequest) (*DdaRegisterUserResponse, error)
+}
+
 // Dda ...
 type Dda struct {
 	session        Session
@@ -21,20 +24,28 @@ type Dda struct {
 	httpClient     *LoggingHTTPClient
 }
 
+var _ DdaInterface = (*Dda)(nil)
+
 // NewDda ...
-func NewDda(httpClient *http.Client, session Session) *Dda {
+func NewDda(httpClient *http.Client, session Session) DdaInterface {
 	return &Dda{
 		session:        session,
-		httpClient:     NewLoggingHTTPClient(httpClient),
+		httpClient:     newSessionLoggingHTTPClient(httpClient, session),
 		authentication: NewAuthentication(httpClient, session),
 	}
 }
 
 // CreateRegisterUser ...
 func (s *Dda) CreateRegisterUser(ctx context.Context, correlationID string,
-	model DdaRegisterUserRequest) (*DdaRegisterUserResponse, error) {
-	logrus.
-		WithFields(logrus.Fields{
+	model DdaRegisterUserRequest) (_ *DdaRegisterUserResponse, err error) {
+	if len(correlationID) > 0 {
+		ctx = WithRequestID(ctx, correlationID)
+	}
+	ctx, op := s.session.startOperation(ctx, "dda", "CreateRegisterUser", DdaSubscriptionPath)
+	defer op.end(&err)
+	logger := op.logger
+	logger.
+		WithFields(Fields{
 			"correlation_id": correlationID,
 		}).
 		Info("create register user")
@@ -44,89 +55,43 @@ func (s *Dda) CreateRegisterUser(ctx context.Context, correlationID string,
 // createRegisterUser ...
 func (s *Dda) createRegisterUser(ctx context.Context, requestID string,
 	model DdaRegisterUserRequest) (*DdaRegisterUserResponse, error) {
+	logger := loggerFrom(ctx, s.session.logger("dda"))
 
-	fields := logrus.Fields{
+	fields := Fields{
 		"request_id": requestID,
 		"model":      model,
 	}
-	logrus.WithFields(fields).Info("Create Register User")
+	logger.WithFields(fields).Info("Create Register User")
 	req := &model
 
 	err := grok.Validator.Struct(req)
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error validating model")
+		logger.WithFields(fields).WithError(err).Error("Error validating model")
 		return nil, grok.FromValidationErros(err)
 	}
 
-	endpoint, err := s.BuildEndpoint(DdaSubscriptionPath, nil)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error building endpoint for CreateDdaSubscription")
-		return nil, err
-	}
-
-	logrus.WithField("endpoint", *endpoint).Info("Calling CreateDdaSubscription")
-
-	payload, err := json.Marshal(req)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error serializing request")
-		return nil, fmt.Errorf("error serializing request: %v", err)
-	}
-
-	httpReq, err := http.NewRequestWithContext(ctx, "POST", *endpoint, bytes.NewReader(payload))
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error creating HTTP request")
-		return nil, fmt.Errorf("error creating HTTP request: %v", err)
-	}
-
-	httpReq.Header.Set("Content-Type", "application/json")
-	resp, err := s.httpClient.Do(httpReq)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error in HTTP client")
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	respBody, _ := ioutil.ReadAll(resp.Body)
-
-	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusAccepted || resp.StatusCode == http.StatusCreated {
-		var response *DdaRegisterUserResponse
-
-		if err := json.Unmarshal(respBody, &response); err != nil {
-			logrus.WithFields(fields).WithError(err).
-				Error("error decoding json response")
-			return nil, ErrDefaultDda
-		}
-		return response, nil
-	}
-
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, ErrEntryNotFound
-	}
-
-	var errResponse *ErroDefaultResponse
-	if err := json.Unmarshal(respBody, &errResponse); err != nil {
-		logrus.WithFields(fields).WithError(err).
-			Error("error decoding json response")
-		return nil, ErrDefaultDda
-	}
-
-	if errResponse.Error != nil {
-		err := FindDdaError(*errResponse.Error.ErrorCode, &resp.StatusCode)
-		logrus.WithField("celcoin_error", errResponse.Error).
-			WithFields(fields).WithError(err).
-			Error("celcoin get pix error")
-		return nil, err
-	}
-
-	return nil, ErrDefaultDda
-
+	return execute[DdaRegisterUserResponse](ctx, s.httpClient, s.session, apiRequest{
+		method:   http.MethodPost,
+		path:     DdaSubscriptionPath,
+		body:     req,
+		header:   jsonHeader,
+		notFound: ErrEntryNotFound,
+		errors:   ddaErrors,
+		fields:   fields,
+	})
 }
 
 // DeleteRegisterUser ...
 func (s *Dda) DeleteRegisterUser(ctx context.Context, correlationID string,
-	model DdaDeleteUserRequest) (*DdaRegisterUserResponse, error) {
-	logrus.
-		WithFields(logrus.Fields{
+	model DdaDeleteUserRequest) (_ *DdaRegisterUserResponse, err error) {
+	if len(correlationID) > 0 {
+		ctx = WithRequestID(ctx, correlationID)
+	}
+	ctx, op := s.session.startOperation(ctx, "dda", "DeleteRegisterUser", DdaSubscriptionPath)
+	defer op.end(&err)
+	logger := op.logger
+	logger.
+		WithFields(Fields{
 			"correlation_id": correlationID,
 		}).Info("delete register user")
 	return s.deleteRegisterUser(ctx, correlationID, model)
@@ -135,106 +100,41 @@ func (s *Dda) DeleteRegisterUser(ctx context.Context, correlationID string,
 // deleteRegisterUser ...
 func (s *Dda) deleteRegisterUser(ctx context.Context, requestID string,
 	model DdaDeleteUserRequest) (*DdaRegisterUserResponse, error) {
+	logger := loggerFrom(ctx, s.session.logger("dda"))
 
-	fields := logrus.Fields{
+	fields := Fields{
 		"request_id": requestID,
 		"model":      model,
 	}
-	logrus.WithFields(fields).Info("Create Register User")
+	logger.WithFields(fields).Info("Create Register User")
 	req := &model
 
 	err := grok.Validator.Struct(req)
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error validating model")
+		logger.WithFields(fields).WithError(err).Error("Error validating model")
 		return nil, grok.FromValidationErros(err)
 	}
 
-	endpoint, err := s.BuildEndpoint(DdaSubscriptionPath, nil)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error building endpoint for CreateDdaSubscription")
-		return nil, err
-	}
-
-	logrus.WithField("endpoint", *endpoint).Info("Calling CreateDdaSubscription")
-
-	payload, err := json.Marshal(req)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error serializing request")
-		return nil, fmt.Errorf("error serializing request: %v", err)
-	}
-
-	httpReq, err := http.NewRequestWithContext(ctx, "DELETE", *endpoint, bytes.NewReader(payload))
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error creating HTTP request")
-		return nil, fmt.Errorf("error creating HTTP request: %v", err)
-	}
-
-	httpReq.Header.Set("Content-Type", "application/json")
-	resp, err := s.httpClient.Do(httpReq)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error in HTTP client")
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	respBody, _ := ioutil.ReadAll(resp.Body)
-
-	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusAccepted || resp.StatusCode == http.StatusCreated {
-		var response *DdaRegisterUserResponse
-
-		if err := json.Unmarshal(respBody, &response); err != nil {
-			logrus.WithFields(fields).WithError(err).
-				Error("error decoding json response")
-			return nil, ErrDefaultDda
-		}
-		return response, nil
-	}
-
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, ErrEntryNotFound
-	}
-
-	var errResponse *ErroDefaultResponse
-	if err := json.Unmarshal(respBody, &errResponse); err != nil {
-		logrus.WithFields(fields).WithError(err).
-			Error("error decoding json response")
-		return nil, ErrDefaultDda
-	}
-
-	if errResponse.Error != nil {
-		err := FindDdaError(*errResponse.Error.ErrorCode, &resp.StatusCode)
-		logrus.WithField("celcoin_error", errResponse.Error).
-			WithFields(fields).WithError(err).
-			Error("celcoin get pix error")
-		return nil, err
-	}
-
-	return nil, ErrDefaultDda
+	return execute[DdaRegisterUserResponse](ctx, s.httpClient, s.session, apiRequest{
+		method:   http.MethodDelete,
+		path:     DdaSubscriptionPath,
+		body:     req,
+		header:   jsonHeader,
+		notFound: ErrEntryNotFound,
+		errors:   ddaErrors,
+		fields:   fields,
+	})
 }
 
+// BuildEndpoint ...
 func (s *Dda) BuildEndpoint(basePath string, queryParams map[string]string, pathParams ...string) (*string, error) {
-	u, err := url.Parse(s.session.APIEndpoint)
+	logger := s.session.logger("dda")
+	endpoint, err := buildEndpoint(s.session.APIEndpoint, basePath, queryParams, pathParams...)
 	if err != nil {
-		logrus.WithError(err).Error("Error parsing API endpoint")
+		logger.WithError(err).Error("Error parsing API endpoint")
 		return nil, err
 	}
 
-	// Construção do caminho completo
-	fullPath := path.Join(basePath, path.Join(pathParams...))
-	u.Path = path.Join(u.Path, fullPath)
-
-	// Adição de query parameters
-	if queryParams != nil {
-		q := u.Query()
-		for key, value := range queryParams {
-			if value != "" {
-				q.Set(key, value)
-			}
-		}
-		u.RawQuery = q.Encode()
-	}
-
-	endpoint := u.String()
-	logrus.WithField("endpoint", endpoint).Info("Endpoint built successfully")
+	logger.WithField("endpoint", endpoint).Debug("Endpoint built successfully")
 	return &endpoint, nil
 }
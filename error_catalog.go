@@ -0,0 +1,271 @@
+package celcoin
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"sync"
+
+	"github.com/contbank/grok"
+)
+
+// errorCatalogData ... catálogo padrão de erros da Celcoin, exportado como dado para o frontend
+//
+//go:embed error_catalog.json
+var errorCatalogData []byte
+
+// ErrorDomain ... domínio (API) em que o código de erro da Celcoin foi retornado
+type ErrorDomain string
+
+const (
+	// ErrorDomainOnboarding ...
+	ErrorDomainOnboarding ErrorDomain = "onboarding"
+	// ErrorDomainPix ...
+	ErrorDomainPix ErrorDomain = "pix"
+	// ErrorDomainWebhook ...
+	ErrorDomainWebhook ErrorDomain = "webhook"
+	// ErrorDomainStatement ...
+	ErrorDomainStatement ErrorDomain = "statement"
+	// ErrorDomainBalance ...
+	ErrorDomainBalance ErrorDomain = "balance"
+	// ErrorDomainIncomeReport ...
+	ErrorDomainIncomeReport ErrorDomain = "income_report"
+	// ErrorDomainCharge ...
+	ErrorDomainCharge ErrorDomain = "charge"
+	// ErrorDomainPayment ...
+	ErrorDomainPayment ErrorDomain = "payment"
+	// ErrorDomainCancelAccount ...
+	ErrorDomainCancelAccount ErrorDomain = "cancel_account"
+	// ErrorDomainUpdateAccountStatus ...
+	ErrorDomainUpdateAccountStatus ErrorDomain = "update_account_status"
+	// ErrorDomainDda ...
+	ErrorDomainDda ErrorDomain = "dda"
+)
+
+// Language ... idioma das mensagens do catálogo
+type Language string
+
+const (
+	// LanguagePT ... português, idioma padrão
+	LanguagePT Language = "pt"
+	// LanguageEN ...
+	LanguageEN Language = "en"
+)
+
+// ErrorCatalogEntry ... mapeamento de um código de erro da Celcoin, em um domínio, para o erro Contbank
+type ErrorCatalogEntry struct {
+	Domain       ErrorDomain `json:"domain"`
+	CelcoinCode  string      `json:"celcoinCode"`
+	ContbankCode string      `json:"contbankCode"`
+	// Status ... status HTTP do erro; zero usa o status da resposta da Celcoin
+	Status int `json:"status,omitempty"`
+	// Divergence ... motivo para o código da Celcoin ser mapeado para outro erro Contbank em outro domínio.
+	// Obrigatório nesses casos: as chaves são expostas pela API e não podem ser unificadas sem quebrar os clientes.
+	Divergence string              `json:"divergence,omitempty"`
+	Messages   map[Language]string `json:"messages"`
+}
+
+// Message ... mensagem no idioma informado, ou em português quando não houver tradução
+func (e ErrorCatalogEntry) Message(language Language) string {
+	if message, ok := e.Messages[language]; ok && len(message) > 0 {
+		return message
+	}
+	return e.Messages[LanguagePT]
+}
+
+// validate ...
+func (e ErrorCatalogEntry) validate() error {
+	switch {
+	case len(e.Domain) == 0:
+		return fmt.Errorf("error catalog: domain is required for %q", e.CelcoinCode)
+	case len(e.CelcoinCode) == 0:
+		return fmt.Errorf("error catalog: celcoinCode is required in domain %q", e.Domain)
+	case len(e.ContbankCode) == 0:
+		return fmt.Errorf("error catalog: contbankCode is required for %s/%s", e.Domain, e.CelcoinCode)
+	case len(e.Messages[LanguagePT]) == 0:
+		return fmt.Errorf("error catalog: pt message is required for %s/%s", e.Domain, e.CelcoinCode)
+	case e.Status != 0 && (e.Status < http.StatusBadRequest || e.Status > 599):
+		return fmt.Errorf("error catalog: invalid status %d for %s/%s", e.Status, e.Domain, e.CelcoinCode)
+	}
+	return nil
+}
+
+type errorCatalogKey struct {
+	domain ErrorDomain
+	code   string
+}
+
+// ErrorCatalog ... catálogo de erros indexado por domínio e código da Celcoin. Pode ser alterado em tempo de execução.
+type ErrorCatalog struct {
+	mutex    sync.RWMutex
+	language Language
+	entries  map[errorCatalogKey]ErrorCatalogEntry
+}
+
+// defaultErrorCatalog ... usado pelas funções Find*Error
+var defaultErrorCatalog = mustLoadErrorCatalog(errorCatalogData)
+
+// DefaultErrorCatalog ... catálogo usado pelo SDK; alterações feitas nele valem para todos os serviços
+func DefaultErrorCatalog() *ErrorCatalog {
+	return defaultErrorCatalog
+}
+
+// ErrorCatalogJSON ... conteúdo do catálogo padrão embutido no SDK
+func ErrorCatalogJSON() []byte {
+	return append([]byte{}, errorCatalogData...)
+}
+
+// NewErrorCatalog ... cria um catálogo; códigos repetidos no mesmo domínio são rejeitados
+func NewErrorCatalog(entries []ErrorCatalogEntry) (*ErrorCatalog, error) {
+	catalog := &ErrorCatalog{
+		language: LanguagePT,
+		entries:  make(map[errorCatalogKey]ErrorCatalogEntry, len(entries)),
+	}
+	for _, entry := range entries {
+		if err := entry.validate(); err != nil {
+			return nil, err
+		}
+		key := errorCatalogKey{domain: entry.Domain, code: entry.CelcoinCode}
+		if _, exists := catalog.entries[key]; exists {
+			return nil, fmt.Errorf("error catalog: duplicate mapping for %s/%s", entry.Domain, entry.CelcoinCode)
+		}
+		catalog.entries[key] = entry
+	}
+	return catalog, nil
+}
+
+// LoadErrorCatalog ... lê um catálogo no formato de error_catalog.json
+func LoadErrorCatalog(r io.Reader) (*ErrorCatalog, error) {
+	entries, err := decodeErrorCatalog(r)
+	if err != nil {
+		return nil, err
+	}
+	return NewErrorCatalog(entries)
+}
+
+// mustLoadErrorCatalog ...
+func mustLoadErrorCatalog(data []byte) *ErrorCatalog {
+	catalog, err := LoadErrorCatalog(bytes.NewReader(data))
+	if err != nil {
+		panic(err)
+	}
+	return catalog
+}
+
+// decodeErrorCatalog ...
+func decodeErrorCatalog(r io.Reader) ([]ErrorCatalogEntry, error) {
+	var entries []ErrorCatalogEntry
+	if err := json.NewDecoder(r).Decode(&entries); err != nil {
+		return nil, fmt.Errorf("error catalog: %w", err)
+	}
+	return entries, nil
+}
+
+// SetLanguage ... idioma das mensagens dos erros gerados pelo catálogo
+func (c *ErrorCatalog) SetLanguage(language Language) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.language = language
+}
+
+// Lookup ...
+func (c *ErrorCatalog) Lookup(domain ErrorDomain, celcoinCode string) (ErrorCatalogEntry, bool) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	entry, ok := c.entries[errorCatalogKey{domain: domain, code: celcoinCode}]
+	return entry, ok
+}
+
+// Override ... inclui ou substitui mapeamentos
+func (c *ErrorCatalog) Override(entries ...ErrorCatalogEntry) error {
+	for _, entry := range entries {
+		if err := entry.validate(); err != nil {
+			return err
+		}
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	for _, entry := range entries {
+		c.entries[errorCatalogKey{domain: entry.Domain, code: entry.CelcoinCode}] = entry
+	}
+	return nil
+}
+
+// Load ... inclui ou substitui mapeamentos a partir de um JSON no formato de error_catalog.json
+func (c *ErrorCatalog) Load(r io.Reader) error {
+	entries, err := decodeErrorCatalog(r)
+	if err != nil {
+		return err
+	}
+	overrides, err := NewErrorCatalog(entries)
+	if err != nil {
+		return err
+	}
+	return c.Override(overrides.Entries()...)
+}
+
+// Entries ... mapeamentos ordenados por domínio e código
+func (c *ErrorCatalog) Entries() []ErrorCatalogEntry {
+	c.mutex.RLock()
+	entries := make([]ErrorCatalogEntry, 0, len(c.entries))
+	for _, entry := range c.entries {
+		entries = append(entries, entry)
+	}
+	c.mutex.RUnlock()
+
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Domain != entries[j].Domain {
+			return entries[i].Domain < entries[j].Domain
+		}
+		return entries[i].CelcoinCode < entries[j].CelcoinCode
+	})
+	return entries
+}
+
+// MarshalJSON ...
+func (c *ErrorCatalog) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.Entries())
+}
+
+// Error ... erro Contbank para o código da Celcoin. Códigos desconhecidos retornam UNKNOWN_ERROR com status 500.
+func (c *ErrorCatalog) Error(domain ErrorDomain, celcoinCode string, responseStatus *int) *grok.Error {
+	entry, ok := c.Lookup(domain, celcoinCode)
+	if !ok {
+		return grok.NewError(http.StatusInternalServerError, "UNKNOWN_ERROR", "unknown error")
+	}
+
+	status := entry.Status
+	if status == 0 && responseStatus != nil {
+		status = *responseStatus
+	}
+	if status == 0 {
+		status = http.StatusInternalServerError
+	}
+
+	c.mutex.RLock()
+	language := c.language
+	c.mutex.RUnlock()
+	return grok.NewError(status, entry.ContbankCode, entry.Message(language))
+}
+
+// errorMapping ... formato dos mapas *ErrorMappings
+type errorMapping = struct {
+	ContbankCode string
+	Description  string
+}
+
+// errorMappings ... visão de um domínio no formato dos mapas *ErrorMappings
+func (c *ErrorCatalog) errorMappings(domain ErrorDomain) map[string]errorMapping {
+	mappings := make(map[string]errorMapping)
+	for _, entry := range c.Entries() {
+		if entry.Domain == domain {
+			mappings[entry.CelcoinCode] = errorMapping{entry.ContbankCode, entry.Messages[LanguagePT]}
+		}
+	}
+	return mappings
+}
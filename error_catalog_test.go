@@ -0,0 +1,184 @@
+package celcoin_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/contbank/celcoin-sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+// ErrorCatalogTestSuite ...
+type ErrorCatalogTestSuite struct {
+	suite.Suite
+	assert  *assert.Assertions
+	entries []celcoin.ErrorCatalogEntry
+}
+
+// TestErrorCatalogTestSuite ...
+func TestErrorCatalogTestSuite(t *testing.T) {
+	suite.Run(t, new(ErrorCatalogTestSuite))
+}
+
+// SetupTest ...
+func (s *ErrorCatalogTestSuite) SetupTest() {
+	s.assert = assert.New(s.T())
+	s.entries = nil
+	s.Require().NoError(json.Unmarshal(celcoin.ErrorCatalogJSON(), &s.entries))
+	s.Require().NotEmpty(s.entries)
+}
+
+// TestNoDuplicateMappings ...
+func (s *ErrorCatalogTestSuite) TestNoDuplicateMappings() {
+	seen := make(map[string]bool)
+	for _, entry := range s.entries {
+		key := string(entry.Domain) + "/" + entry.CelcoinCode
+		s.assert.False(seen[key], "duplicate mapping %s", key)
+		seen[key] = true
+	}
+	s.assert.Len(celcoin.DefaultErrorCatalog().Entries(), len(s.entries))
+}
+
+// TestNoConflictingMappings ...
+func (s *ErrorCatalogTestSuite) TestNoConflictingMappings() {
+	contbankCodes := make(map[string]map[string]bool)
+	statuses := make(map[string]int)
+	for _, entry := range s.entries {
+		if contbankCodes[entry.CelcoinCode] == nil {
+			contbankCodes[entry.CelcoinCode] = make(map[string]bool)
+		}
+		contbankCodes[entry.CelcoinCode][entry.ContbankCode] = true
+
+		key := string(entry.Domain) + "/" + entry.ContbankCode
+		if status, found := statuses[key]; found && entry.Status != 0 {
+			s.assert.Equal(status, entry.Status, "conflicting status for %s", key)
+		} else if entry.Status != 0 {
+			statuses[key] = entry.Status
+		}
+	}
+
+	// Um código mapeado para chaves diferentes conforme o domínio precisa registrar o motivo no catálogo
+	for _, entry := range s.entries {
+		if mapped := contbankCodes[entry.CelcoinCode]; len(mapped) > 1 {
+			s.assert.NotEmpty(entry.Divergence, "celcoin code %s maps to %v in different domains", entry.CelcoinCode, mapped)
+		}
+	}
+}
+
+// TestEntriesAreComplete ...
+func (s *ErrorCatalogTestSuite) TestEntriesAreComplete() {
+	domains := map[celcoin.ErrorDomain]bool{
+		celcoin.ErrorDomainOnboarding:          true,
+		celcoin.ErrorDomainPix:                 true,
+		celcoin.ErrorDomainWebhook:             true,
+		celcoin.ErrorDomainStatement:           true,
+		celcoin.ErrorDomainBalance:             true,
+		celcoin.ErrorDomainIncomeReport:        true,
+		celcoin.ErrorDomainCharge:              true,
+		celcoin.ErrorDomainPayment:             true,
+		celcoin.ErrorDomainCancelAccount:       true,
+		celcoin.ErrorDomainUpdateAccountStatus: true,
+		celcoin.ErrorDomainDda:                 true,
+	}
+	for _, entry := range s.entries {
+		name := string(entry.Domain) + "/" + entry.CelcoinCode
+		s.assert.True(domains[entry.Domain], "unknown domain in %s", name)
+		s.assert.NotEmpty(entry.ContbankCode, name)
+		s.assert.Equal(strings.ToUpper(entry.ContbankCode), entry.ContbankCode, name)
+		s.assert.NotEmpty(entry.Messages[celcoin.LanguagePT], name)
+		s.assert.NotEmpty(entry.Messages[celcoin.LanguageEN], name)
+	}
+}
+
+// TestFindErrorUsesCatalog ...
+func (s *ErrorCatalogTestSuite) TestFindErrorUsesCatalog() {
+	status := http.StatusBadRequest
+
+	err := celcoin.FindStatementError("CBE041", &status)
+	s.assert.Equal("ACCOUNT_TOO_LONG", err.Key)
+	s.assert.Equal(http.StatusBadRequest, err.Code)
+
+	err = celcoin.FindPixError("CBE041", &status)
+	s.assert.Equal("ACCOUNT_MAX_LENGTH_EXCEEDED", err.Key)
+
+	err = celcoin.FindDdaError("CDDA301", &status)
+	s.assert.Equal("UNAUTHORIZED_REQUEST", err.Key)
+	s.assert.Equal(http.StatusUnauthorized, err.Code)
+
+	balanceErr := celcoin.FindBalanceError("CBE041", "Account possui tamanho maximo de 20 caracteres.")
+	s.assert.Equal("INVALID_ACCOUNT_LENGTH", balanceErr.ErrorKey)
+	s.assert.Equal(http.StatusConflict, balanceErr.GrokError.Code)
+
+	balanceErr = celcoin.FindBalanceError("CBE999", "Conta inválida")
+	s.assert.Equal("CBE999", balanceErr.ErrorKey)
+	s.assert.Equal([]string{"Conta inválida"}, balanceErr.GrokError.Messages)
+
+	err = celcoin.FindPaymentError("UNKNOWN", &status)
+	s.assert.Equal("UNKNOWN_ERROR", err.Key)
+	s.assert.Equal(http.StatusInternalServerError, err.Code)
+
+	message := "mensagem da Celcoin"
+	err = celcoin.FindPixErrorWithMessage("UNKNOWN", &status, &message)
+	s.assert.Equal([]string{message}, err.Messages)
+}
+
+// TestLanguageAndOverride ...
+func (s *ErrorCatalogTestSuite) TestLanguageAndOverride() {
+	catalog, err := celcoin.LoadErrorCatalog(bytes.NewReader(celcoin.ErrorCatalogJSON()))
+	s.Require().NoError(err)
+	status := http.StatusBadRequest
+
+	catalog.SetLanguage(celcoin.LanguageEN)
+	s.assert.Equal([]string{"Invalid account."}, catalog.Error(celcoin.ErrorDomainStatement, "CBE039", &status).Messages)
+
+	s.Require().NoError(catalog.Override(celcoin.ErrorCatalogEntry{
+		Domain:       celcoin.ErrorDomainStatement,
+		CelcoinCode:  "CBE039",
+		ContbankCode: "INVALID_ACCOUNT_NUMBER",
+		Status:       http.StatusUnprocessableEntity,
+		Messages:     map[celcoin.Language]string{celcoin.LanguagePT: "Conta inválida."},
+	}))
+	overridden := catalog.Error(celcoin.ErrorDomainStatement, "CBE039", &status)
+	s.assert.Equal("INVALID_ACCOUNT_NUMBER", overridden.Key)
+	s.assert.Equal(http.StatusUnprocessableEntity, overridden.Code)
+	s.assert.Equal([]string{"Conta inválida."}, overridden.Messages, "falls back to pt")
+
+	s.Require().NoError(catalog.Load(strings.NewReader(
+		`[{"domain":"pix","celcoinCode":"CBE999","contbankCode":"NEW_ERROR","messages":{"pt":"novo","en":"new"}}]`)))
+	entry, found := catalog.Lookup(celcoin.ErrorDomainPix, "CBE999")
+	s.assert.True(found)
+	s.assert.Equal("NEW_ERROR", entry.ContbankCode)
+
+	// O catálogo padrão não é afetado
+	_, found = celcoin.DefaultErrorCatalog().Lookup(celcoin.ErrorDomainPix, "CBE999")
+	s.assert.False(found)
+}
+
+// TestRejectsInvalidCatalog ...
+func (s *ErrorCatalogTestSuite) TestRejectsInvalidCatalog() {
+	_, err := celcoin.LoadErrorCatalog(strings.NewReader(`[
+		{"domain":"pix","celcoinCode":"CBE001","contbankCode":"A","messages":{"pt":"a"}},
+		{"domain":"pix","celcoinCode":"CBE001","contbankCode":"B","messages":{"pt":"b"}}
+	]`))
+	s.assert.ErrorContains(err, "duplicate mapping for pix/CBE001")
+
+	_, err = celcoin.LoadErrorCatalog(strings.NewReader(`[{"domain":"pix","celcoinCode":"CBE001","contbankCode":"A","messages":{"en":"a"}}]`))
+	s.assert.ErrorContains(err, "pt message is required")
+
+	_, err = celcoin.LoadErrorCatalog(strings.NewReader(`[{"domain":"pix","celcoinCode":"CBE001","contbankCode":"A","status":200,"messages":{"pt":"a"}}]`))
+	s.assert.ErrorContains(err, "invalid status")
+}
+
+// TestMarshalJSON ...
+func (s *ErrorCatalogTestSuite) TestMarshalJSON() {
+	data, err := json.Marshal(celcoin.DefaultErrorCatalog())
+	s.Require().NoError(err)
+
+	var entries []celcoin.ErrorCatalogEntry
+	s.Require().NoError(json.Unmarshal(data, &entries))
+	s.assert.ElementsMatch(s.entries, entries)
+}
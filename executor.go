@@ -0,0 +1,241 @@
+package celcoin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path"
+
+	"github.com/contbank/grok"
+)
+
+// defaultSuccessStatus ... status tratados como sucesso quando a chamada não informa outros
+var defaultSuccessStatus = []int{http.StatusOK, http.StatusCreated, http.StatusAccepted}
+
+// jsonHeader ... cabeçalho das chamadas que enviam JSON
+var jsonHeader = map[string]string{"Content-Type": "application/json"}
+
+// apiRequest ... chamada HTTP à Celcoin executada por execute
+type apiRequest struct {
+	method string
+	// path e pathParams ... caminho relativo ao APIEndpoint da sessão, unidos com path.Join
+	path       string
+	pathParams []string
+	// query ... parâmetros com valor vazio não são enviados
+	query map[string]string
+	// body ... serializado como JSON quando diferente de nil
+	body   interface{}
+	header map[string]string
+	// success ... status tratados como sucesso; padrão 200, 201 e 202
+	success []int
+	// notFound ... erro retornado em respostas 404; quando nil, o 404 é tratado pela estratégia de erros
+	notFound error
+	// discardResponse ... o corpo das respostas de sucesso não é decodificado
+	discardResponse bool
+	errors          errorStrategy
+	fields          Fields
+}
+
+// succeeded ...
+func (r apiRequest) succeeded(statusCode int) bool {
+	success := r.success
+	if len(success) == 0 {
+		success = defaultSuccessStatus
+	}
+	for _, status := range success {
+		if status == statusCode {
+			return true
+		}
+	}
+	return false
+}
+
+// errorStrategy ... converte as respostas de erro da Celcoin no erro retornado pela operação
+type errorStrategy interface {
+	// mapError ... erro para uma resposta com status fora da lista de sucesso
+	mapError(logger Logger, statusCode int, body []byte) error
+	// decodeError ... erro quando o corpo de uma resposta não pode ser decodificado
+	decodeError(err error) error
+}
+
+// envelopeErrors ... respostas no formato {"error": {"errorCode", "message"}}, convertidas por find
+type envelopeErrors struct {
+	// erro ... o envelope usa a chave "erro" (DDA)
+	erro bool
+	find func(code string, responseStatus *int, message *string) *grok.Error
+	// fallback ... erro de respostas sem errorCode ou que não puderam ser decodificadas
+	fallback error
+	// rawDecodeErrors ... falhas de decodificação retornam o erro do encoding/json em vez de fallback
+	rawDecodeErrors bool
+}
+
+// decodeError ...
+func (e envelopeErrors) decodeError(err error) error {
+	if e.rawDecodeErrors {
+		return err
+	}
+	return e.fallback
+}
+
+// mapError ...
+func (e envelopeErrors) mapError(logger Logger, statusCode int, body []byte) error {
+	celcoinErr, err := e.decode(body)
+	if err != nil {
+		logger.WithError(err).Error("error decoding json error response")
+		return e.decodeError(err)
+	}
+
+	if celcoinErr == nil || celcoinErr.ErrorCode == nil || len(*celcoinErr.ErrorCode) == 0 {
+		logger.WithField("celcoin_status", statusCode).Error("celcoin error response without error code")
+		return e.fallback
+	}
+
+	apiErr := e.find(*celcoinErr.ErrorCode, &statusCode, celcoinErr.Message)
+	logger.WithField("celcoin_error", celcoinErr).WithField("celcoin_status", statusCode).
+		WithError(apiErr).Error("celcoin error response")
+	return apiErr
+}
+
+// decode ...
+func (e envelopeErrors) decode(body []byte) (*ErrorDefault, error) {
+	if e.erro {
+		var response *ErroDefaultResponse
+		if err := json.Unmarshal(body, &response); err != nil || response == nil {
+			return nil, err
+		}
+		return response.Error, nil
+	}
+
+	var response *ErrorDefaultResponse
+	if err := json.Unmarshal(body, &response); err != nil || response == nil {
+		return nil, err
+	}
+	return response.Error, nil
+}
+
+// errorListErrors ... respostas no formato {"errors": [{"code", "messages"}]}, convertidas por FindError
+type errorListErrors struct {
+	fallback error
+}
+
+// decodeError ...
+func (e errorListErrors) decodeError(err error) error {
+	return err
+}
+
+// mapError ...
+func (e errorListErrors) mapError(logger Logger, statusCode int, body []byte) error {
+	var response *ErrorResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		logger.WithError(err).Error("error decoding json error response")
+		return err
+	}
+
+	if response == nil || len(response.Errors) == 0 {
+		logger.WithField("celcoin_status", statusCode).Error("celcoin error response without errors")
+		return e.fallback
+	}
+
+	errModel := response.Errors[0]
+	apiErr := FindError(errModel.Code, errModel.Messages...)
+	logger.WithField("celcoin_error", errModel).WithField("celcoin_status", statusCode).
+		WithError(apiErr).Error("celcoin error response")
+	return apiErr
+}
+
+// ignoreMessage ... adapta as funções Find*Error que não usam a mensagem da Celcoin
+func ignoreMessage(find func(code string, responseStatus *int) *grok.Error) func(string, *int, *string) *grok.Error {
+	return func(code string, responseStatus *int, _ *string) *grok.Error {
+		return find(code, responseStatus)
+	}
+}
+
+// buildEndpoint ... URL de basePath e pathParams sob o apiEndpoint; parâmetros de query vazios são ignorados
+func buildEndpoint(apiEndpoint, basePath string, queryParams map[string]string, pathParams ...string) (string, error) {
+	u, err := url.Parse(apiEndpoint)
+	if err != nil {
+		return "", err
+	}
+
+	u.Path = path.Join(u.Path, path.Join(basePath, path.Join(pathParams...)))
+
+	if queryParams != nil {
+		q := u.Query()
+		for key, value := range queryParams {
+			if value != "" {
+				q.Set(key, value)
+			}
+		}
+		u.RawQuery = q.Encode()
+	}
+
+	return u.String(), nil
+}
+
+// execute ... envia a chamada pelo LoggingHTTPClient e decodifica a resposta de sucesso em T.
+// Respostas de erro são convertidas pela errorStrategy da chamada.
+func execute[T any](ctx context.Context, client *LoggingHTTPClient, session Session, req apiRequest) (*T, error) {
+	logger := loggerFrom(ctx, session.baseLogger()).WithFields(req.fields)
+
+	endpoint, err := buildEndpoint(session.APIEndpoint, req.path, req.query, req.pathParams...)
+	if err != nil {
+		logger.WithError(err).Error("error building endpoint")
+		return nil, err
+	}
+	logger = logger.WithField("endpoint", endpoint)
+
+	var body io.Reader
+	if req.body != nil {
+		payload, err := json.Marshal(req.body)
+		if err != nil {
+			logger.WithError(err).Error("error serializing request")
+			return nil, fmt.Errorf("error serializing request: %w", err)
+		}
+		body = bytes.NewReader(payload)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, req.method, endpoint, body)
+	if err != nil {
+		logger.WithError(err).Error("error creating http request")
+		return nil, fmt.Errorf("error creating http request: %w", err)
+	}
+	for key, value := range req.header {
+		httpReq.Header.Set(key, value)
+	}
+
+	resp, err := client.Do(httpReq)
+	if err != nil {
+		logger.WithError(err).Error("error http client")
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		logger.WithError(err).Error("error reading response body")
+		return nil, err
+	}
+
+	if req.succeeded(resp.StatusCode) {
+		response := new(T)
+		if req.discardResponse {
+			return response, nil
+		}
+		if err := json.Unmarshal(respBody, response); err != nil {
+			logger.WithError(err).Error("error decoding json response")
+			return nil, req.errors.decodeError(err)
+		}
+		return response, nil
+	}
+
+	if resp.StatusCode == http.StatusNotFound && req.notFound != nil {
+		return nil, req.notFound
+	}
+
+	return nil, req.errors.mapError(logger, resp.StatusCode, respBody)
+}
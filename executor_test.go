@@ -0,0 +1,525 @@
+package celcoin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/contbank/grok"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+// ExecutorTestSuite ...
+type ExecutorTestSuite struct {
+	suite.Suite
+	assert      *assert.Assertions
+	status      int
+	body        string
+	request     *http.Request
+	requestBody string
+	server      *httptest.Server
+	session     Session
+}
+
+// TestExecutorTestSuite ...
+func TestExecutorTestSuite(t *testing.T) {
+	suite.Run(t, new(ExecutorTestSuite))
+}
+
+// SetupTest ...
+func (s *ExecutorTestSuite) SetupTest() {
+	s.assert = assert.New(s.T())
+	s.status = http.StatusOK
+	s.body = `{}`
+	s.request = nil
+	s.requestBody = ""
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		s.request = r
+		s.requestBody = string(body)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(s.status)
+		w.Write([]byte(s.body))
+	}))
+	s.session = Session{APIEndpoint: s.server.URL, Logger: NewNopLogger()}
+}
+
+// TearDownTest ...
+func (s *ExecutorTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+// respond ...
+func (s *ExecutorTestSuite) respond(status int, body string) {
+	s.status = status
+	s.body = body
+	s.request = nil
+}
+
+// TestExecute ...
+func (s *ExecutorTestSuite) TestExecute() {
+	type response struct {
+		ID string `json:"id"`
+	}
+	client := newSessionLoggingHTTPClient(http.DefaultClient, s.session)
+
+	tests := []struct {
+		name     string
+		status   int
+		body     string
+		request  apiRequest
+		expected *response
+		check    func(err error)
+	}{
+		{
+			name:     "decodes the response",
+			status:   http.StatusCreated,
+			body:     `{"id":"123"}`,
+			request:  apiRequest{method: http.MethodPost, path: "/v1/items", errors: pixErrors},
+			expected: &response{ID: "123"},
+		},
+		{
+			name:    "status outside the success list",
+			status:  http.StatusCreated,
+			body:    `{"id":"123"}`,
+			request: apiRequest{method: http.MethodGet, path: "/v1/items", success: []int{http.StatusOK}, errors: pixErrors},
+			check:   func(err error) { s.assert.ErrorIs(err, ErrDefaultPix) },
+		},
+		{
+			name:    "not found",
+			status:  http.StatusNotFound,
+			request: apiRequest{method: http.MethodGet, path: "/v1/items", notFound: ErrEntryNotFound, errors: pixErrors},
+			check:   func(err error) { s.assert.ErrorIs(err, ErrEntryNotFound) },
+		},
+		{
+			name:    "not found handled by the error strategy",
+			status:  http.StatusNotFound,
+			body:    `{"error":{"errorCode":"CBE001"}}`,
+			request: apiRequest{method: http.MethodGet, path: "/v1/items", errors: pixErrors},
+			check:   func(err error) { s.assertKey(err, "MISSING_CLIENT_CODE") },
+		},
+		{
+			name:    "celcoin message for unknown codes",
+			status:  http.StatusBadRequest,
+			body:    `{"error":{"errorCode":"XYZ999","message":" saldo insuficiente "}}`,
+			request: apiRequest{method: http.MethodPost, path: "/v1/items", errors: pixPaymentErrors},
+			check: func(err error) {
+				var grokErr *grok.Error
+				s.Require().True(errors.As(err, &grokErr))
+				s.assert.Equal([]string{"saldo insuficiente"}, grokErr.Messages)
+			},
+		},
+		{
+			name:    "dda envelope",
+			status:  http.StatusBadRequest,
+			body:    `{"status":400,"erro":{"errorCode":"CDDA001","message":"campos obrigatórios"}}`,
+			request: apiRequest{method: http.MethodPost, path: "/v1/items", errors: ddaErrors},
+			check:   func(err error) { s.assertKey(err, "MISSING_REQUIRED_FIELDS") },
+		},
+		{
+			name:    "empty error code",
+			status:  http.StatusBadRequest,
+			body:    `{"error":{"errorCode":""}}`,
+			request: apiRequest{method: http.MethodPost, path: "/v1/items", errors: onboardingErrors},
+			check:   func(err error) { s.assert.ErrorIs(err, ErrDefaultCustomersAccounts) },
+		},
+		{
+			name:    "undecodable success",
+			status:  http.StatusOK,
+			body:    `{"id":`,
+			request: apiRequest{method: http.MethodGet, path: "/v1/items", errors: webhookErrors},
+			check:   func(err error) { s.assert.ErrorIs(err, ErrDefaultWebhook) },
+		},
+		{
+			name:    "undecodable success returning the decoding error",
+			status:  http.StatusOK,
+			body:    `{"id":`,
+			request: apiRequest{method: http.MethodGet, path: "/v1/items", errors: onboardingErrors},
+			check: func(err error) {
+				var syntaxErr *json.SyntaxError
+				s.assert.True(errors.As(err, &syntaxErr))
+			},
+		},
+		{
+			name:    "error list",
+			status:  http.StatusBadRequest,
+			body:    `{"errors":[{"code":"EMAIL_ALREADY_IN_USE","messages":["email em uso"]}]}`,
+			request: apiRequest{method: http.MethodGet, path: "/v1/items", errors: customersErrors},
+			check:   func(err error) { s.assert.ErrorIs(err, ErrEmailAlreadyInUse) },
+		},
+		{
+			name:    "discarded response",
+			status:  http.StatusOK,
+			body:    `not json`,
+			request: apiRequest{method: http.MethodDelete, path: "/v1/items", discardResponse: true, errors: pixErrors},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			s.respond(tt.status, tt.body)
+			result, err := execute[response](context.Background(), client, s.session, tt.request)
+			if tt.check != nil {
+				s.Require().Error(err)
+				s.assert.Nil(result)
+				tt.check(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(result)
+			if tt.expected != nil {
+				s.assert.Equal(tt.expected, result)
+			}
+		})
+	}
+}
+
+// TestExecuteRequest ...
+func (s *ExecutorTestSuite) TestExecuteRequest() {
+	client := newSessionLoggingHTTPClient(http.DefaultClient, s.session)
+
+	_, err := execute[struct{}](WithRequestID(context.Background(), "request-123"), client, s.session, apiRequest{
+		method:     http.MethodPut,
+		path:       "/v1/items",
+		pathParams: []string{"123", "status"},
+		query:      map[string]string{"account": "456", "empty": ""},
+		body:       map[string]string{"status": "BLOCKED"},
+		header:     map[string]string{"Content-Type": "application/json", "api-version": "v1"},
+		errors:     pixErrors,
+	})
+	s.Require().NoError(err)
+
+	s.assert.Equal(http.MethodPut, s.request.Method)
+	s.assert.Equal("/v1/items/123/status", s.request.URL.Path)
+	s.assert.Equal(url.Values{"account": {"456"}}, s.request.URL.Query())
+	s.assert.Equal("application/json", s.request.Header.Get("Content-Type"))
+	s.assert.Equal("v1", s.request.Header.Get("api-version"))
+	s.assert.JSONEq(`{"status":"BLOCKED"}`, s.requestBody)
+	s.assert.Equal("request-123", s.request.Header.Get(CorrelationIDHeader))
+
+	_, err = execute[struct{}](context.Background(), client, s.session, apiRequest{method: http.MethodGet, path: "/v1/items", errors: pixErrors})
+	s.Require().NoError(err)
+	s.assert.Empty(s.requestBody)
+	s.assert.Empty(s.request.Header.Get("Content-Type"))
+}
+
+// serviceCall ... chamada de um método público, com o comportamento esperado em cada tipo de resposta
+type serviceCall struct {
+	name   string
+	method string
+	path   string
+	// notFound ... respostas 404 retornam ErrEntryNotFound
+	notFound bool
+	// errorBody e errorKey ... resposta de erro da Celcoin e chave do erro Contbank esperado
+	errorBody string
+	errorKey  string
+	// fallback ... erro de respostas de erro sem código da Celcoin
+	fallback error
+	// rawDecodeErrors ... respostas inválidas retornam o erro do encoding/json em vez de fallback
+	rawDecodeErrors bool
+	call            func(ctx context.Context) error
+}
+
+// TestServiceMethods ... fixa o comportamento HTTP dos métodos públicos de Pix, Webhooks, DDA e Customers
+func (s *ExecutorTestSuite) TestServiceMethods() {
+	for _, tt := range s.serviceCalls() {
+		s.Run(tt.name, func() {
+			ctx := context.Background()
+
+			s.respond(http.StatusOK, `{}`)
+			s.Require().NoError(tt.call(ctx))
+			s.Require().NotNil(s.request)
+			s.assert.Equal(tt.method, s.request.Method)
+			s.assert.Equal(tt.path, s.request.URL.Path)
+
+			s.respond(http.StatusBadRequest, tt.errorBody)
+			s.assertKey(tt.call(ctx), tt.errorKey)
+
+			s.respond(http.StatusNotFound, `{}`)
+			if tt.notFound {
+				s.assert.ErrorIs(tt.call(ctx), ErrEntryNotFound)
+			} else {
+				s.assert.ErrorIs(tt.call(ctx), tt.fallback)
+			}
+
+			s.respond(http.StatusInternalServerError, `{}`)
+			s.assert.ErrorIs(tt.call(ctx), tt.fallback)
+
+			s.respond(http.StatusBadGateway, `<html>bad gateway</html>`)
+			err := tt.call(ctx)
+			if tt.rawDecodeErrors {
+				var syntaxErr *json.SyntaxError
+				s.assert.True(errors.As(err, &syntaxErr), "%v", err)
+			} else {
+				s.assert.ErrorIs(err, tt.fallback)
+			}
+		})
+	}
+}
+
+// serviceCalls ...
+func (s *ExecutorTestSuite) serviceCalls() []serviceCall {
+	pix := NewPix(http.DefaultClient, s.session)
+	webhooks := NewWebhooks(http.DefaultClient, s.session)
+	dda := NewDda(http.DefaultClient, s.session)
+	customers := NewCustomers(http.DefaultClient, s.session)
+
+	account, document, key, id := "123456", "11122233344", "chave@contbank.com", "987"
+	qrCodeURL := "https://qr.celcoin.com.br/pix/v2/cob/abc"
+	emvPath := url.PathEscape("qr.celcoin.com.br/pix/v2/cob/abc")
+	pixErrorBody := `{"status":"ERROR","error":{"errorCode":"CBE001","message":"clientCode obrigatório"}}`
+	webhookErrorBody := `{"status":"ERROR","error":{"errorCode":"CBE205","message":"webhook já cadastrado"}}`
+	ddaErrorBody := `{"status":400,"erro":{"errorCode":"CDDA001","message":"campos obrigatórios"}}`
+	onboardingErrorBody := `{"status":"ERROR","error":{"errorCode":"CBE023","message":"email já cadastrado"}}`
+	accountErrorBody := `{"status":"ERROR","error":{"errorCode":"CBE039","message":"conta inválida"}}`
+
+	cashOut := PixCashOutRequest{
+		Amount:          25.55,
+		ClientCode:      "1458854",
+		EndToEndId:      "E3030629420200808185300887639654",
+		InitiationType:  "DICT",
+		PaymentType:     "IMMEDIATE",
+		Urgency:         "HIGH",
+		TransactionType: "TRANSFER",
+		DebitParty:      DebitParty{Account: account},
+		CreditParty:     CreditParty{Bank: "30306294", Key: key, TaxId: document, Name: "Celcoin"},
+	}
+	merchant := PixMerchant{PostalCode: "01201005", City: "Barueri", Name: "Celcoin"}
+	qrCodeMerchant := PixQrCodeMerchant{MerchantCategoryCode: "0000", PostalCode: "01201005", City: "Barueri", Name: "Celcoin"}
+	claimAction := PixClaimActionRequest{ID: id, Reason: "USER_REQUESTED"}
+
+	pixCall := func(name, method, path string, call func(ctx context.Context) error) serviceCall {
+		return serviceCall{name: "pix/" + name, method: method, path: path, notFound: true,
+			errorBody: pixErrorBody, errorKey: "MISSING_CLIENT_CODE", fallback: ErrDefaultPix, call: call}
+	}
+	webhookCall := func(name, method, path string, call func(ctx context.Context) error) serviceCall {
+		return serviceCall{name: "webhooks/" + name, method: method, path: path, notFound: true,
+			errorBody: webhookErrorBody, errorKey: "WEBHOOK_ALREADY_REGISTERED", fallback: ErrDefaultWebhook, call: call}
+	}
+	ddaCall := func(name, method string, call func(ctx context.Context) error) serviceCall {
+		return serviceCall{name: "dda/" + name, method: method, path: DdaSubscriptionPath, notFound: true,
+			errorBody: ddaErrorBody, errorKey: "MISSING_REQUIRED_FIELDS", fallback: ErrDefaultDda, call: call}
+	}
+	customersCall := func(name, method, path string, call func(ctx context.Context) error) serviceCall {
+		return serviceCall{name: "customers/" + name, method: method, path: path, errorBody: onboardingErrorBody,
+			errorKey: "INVALID_ACCOUNTS_EMAIL", fallback: ErrDefaultCustomersAccounts, rawDecodeErrors: true, call: call}
+	}
+
+	deletePixKey := pixCall("DeletePixKey", http.MethodDelete, PixDictPath+"/"+key, func(ctx context.Context) error {
+		return pix.DeletePixKey(ctx, account, key)
+	})
+	deletePixKey.notFound = false
+
+	updateAccountStatus := customersCall("UpdateAccountStatus", http.MethodPut, UpdateAccountStatusPath, func(ctx context.Context) error {
+		status, reason := "BLOQUEADO", "bloqueio judicial"
+		_, err := customers.UpdateAccountStatus(ctx, &account, &document, &reason, &status)
+		return err
+	})
+	updateAccountStatus.errorBody, updateAccountStatus.errorKey = accountErrorBody, "ACCOUNT_INVALID"
+	updateAccountStatus.fallback = ErrDefaultBusinessAccounts
+
+	cancelAccount := customersCall("CancelAccount", http.MethodDelete, CancelAccountPath, func(ctx context.Context) error {
+		reason := "encerramento"
+		_, err := customers.CancelAccount(ctx, &account, &document, &reason)
+		return err
+	})
+	cancelAccount.errorBody, cancelAccount.errorKey = accountErrorBody, "ACCOUNT_INVALID"
+	cancelAccount.fallback = ErrDefaultBusinessAccounts
+
+	findAccounts := customersCall("FindAccounts", http.MethodGet, CustomersPath, func(ctx context.Context) error {
+		_, err := customers.FindAccounts(ctx, &document, &account)
+		return err
+	})
+	findAccounts.notFound = true
+	findAccounts.errorBody = `{"errors":[{"code":"EMAIL_ALREADY_IN_USE","messages":["email em uso"]}]}`
+	findAccounts.errorKey = ErrEmailAlreadyInUse.Key
+
+	return []serviceCall{
+		pixCall("CreatePixKey", http.MethodPost, PixDictPath, func(ctx context.Context) error {
+			_, err := pix.CreatePixKey(ctx, PixKeyRequest{Account: account, KeyType: "EVP"})
+			return err
+		}),
+		pixCall("GetPixKeys", http.MethodGet, PixDictPath+"/"+account, func(ctx context.Context) error {
+			_, err := pix.GetPixKeys(ctx, account)
+			return err
+		}),
+		deletePixKey,
+		pixCall("GetExternalPixKey", http.MethodGet, PixDictExternalEntryV2Path+"/"+account, func(ctx context.Context) error {
+			_, err := pix.GetExternalPixKey(ctx, account, key, document)
+			return err
+		}),
+		pixCall("GetExternalPixKeyDueDate", http.MethodGet, PixDictExternalEntryV2Path+"/"+account, func(ctx context.Context) error {
+			_, err := pix.GetExternalPixKeyDueDate(ctx, &account, &document, &key)
+			return err
+		}),
+		pixCall("GetExternalPixKeyDueDateDeprecated", http.MethodPost, PixDictDueDatePath, func(ctx context.Context) error {
+			_, err := pix.GetExternalPixKeyDueDateDeprecated(ctx, document, key)
+			return err
+		}),
+		pixCall("PaymentPixCashOut", http.MethodPost, PixPaymentV2Path, func(ctx context.Context) error {
+			_, err := pix.PaymentPixCashOut(ctx, cashOut)
+			return err
+		}),
+		pixCall("DecodeEmvQRCode", http.MethodPost, PixEmvPath, func(ctx context.Context) error {
+			_, err := pix.DecodeEmvQRCode(ctx, "00020101021226")
+			return err
+		}),
+		pixCall("GetPixCashoutStatus", http.MethodGet, PixPaymentV2Path+"/status", func(ctx context.Context) error {
+			_, err := pix.GetPixCashoutStatus(ctx, id, "", "")
+			return err
+		}),
+		pixCall("GetPixCashinStatus", http.MethodGet, PixCashInStatusPath, func(ctx context.Context) error {
+			_, err := pix.GetPixCashinStatus(ctx, id, id, id)
+			return err
+		}),
+		pixCall("PixCashInStatic", http.MethodPost, PixStaticPath, func(ctx context.Context) error {
+			_, err := pix.PixCashInStatic(ctx, PixCashInStaticRequest{Key: key, Amount: 10, TransactionIdentification: id, Merchant: merchant})
+			return err
+		}),
+		pixCall("CreatePixCashInDueDate", http.MethodPost, PixCashInDynamicPath+"/duedate", func(ctx context.Context) error {
+			_, err := pix.CreatePixCashInDueDate(ctx, PixCashInDueDateRequest{Key: key})
+			return err
+		}),
+		pixCall("GetPixCashInDueDate", http.MethodGet, PixCashInDynamicPath+"/duedate/"+id, func(ctx context.Context) error {
+			_, err := pix.GetPixCashInDueDate(ctx, &id)
+			return err
+		}),
+		pixCall("PutPixCashInDueDate", http.MethodPut, PixCashInDynamicPath+"/duedate/"+id, func(ctx context.Context) error {
+			_, err := pix.PutPixCashInDueDate(ctx, id, PixCashInDueDateRequest{Key: key})
+			return err
+		}),
+		pixCall("DeletePixCashInDueDate", http.MethodDelete, PixCashInDynamicPath+"/duedate/"+id, func(ctx context.Context) error {
+			_, err := pix.DeletePixCashInDueDate(ctx, &id)
+			return err
+		}),
+		pixCall("CreatePixCashInImmediate", http.MethodPost, PixCashInDynamicPath+"/immediate", func(ctx context.Context) error {
+			_, err := pix.CreatePixCashInImmediate(ctx, PixCashInImmediateRequest{Key: key})
+			return err
+		}),
+		pixCall("GetPixCashInImmediate", http.MethodGet, PixCashInDynamicPath+"/immediate/"+id, func(ctx context.Context) error {
+			_, err := pix.GetPixCashInImmediate(ctx, &id)
+			return err
+		}),
+		pixCall("PutPixCashInImmediate", http.MethodPut, PixCashInDynamicPath+"/immediate/"+id, func(ctx context.Context) error {
+			_, err := pix.PutPixCashInImmediate(ctx, id, PixCashInImmediateRequest{Key: key})
+			return err
+		}),
+		pixCall("DeletePixCashInImmediate", http.MethodDelete, PixCashInDynamicPath+"/immediate/"+id, func(ctx context.Context) error {
+			_, err := pix.DeletePixCashInImmediate(ctx, &id)
+			return err
+		}),
+		pixCall("GetEmvQRCodeImmediate", http.MethodGet, PixEmvUrl+"/immediate/payload/"+emvPath, func(ctx context.Context) error {
+			_, err := pix.GetEmvQRCodeImmediate(ctx, &qrCodeURL)
+			return err
+		}),
+		pixCall("GetEmvQRCodeDueDate", http.MethodGet, PixEmvUrl+"/duedate/payload/"+emvPath, func(ctx context.Context) error {
+			_, err := pix.GetEmvQRCodeDueDate(ctx, &qrCodeURL)
+			return err
+		}),
+		pixCall("CreateQrCodeLocation", http.MethodPost, PixQrCodeLocationPath, func(ctx context.Context) error {
+			_, err := pix.CreateQrCodeLocation(ctx, PixQrCodeLocationRequest{
+				ClientRequestID: "9b26edb7-1a1b-4b8d-a2d8-3c5f4d2c1e0f", Type: "COB", Merchant: qrCodeMerchant})
+			return err
+		}),
+		pixCall("CreatePixClaim", http.MethodPost, PixClaimPath, func(ctx context.Context) error {
+			_, err := pix.CreatePixClaim(ctx, PixClaimRequest{Key: key, KeyType: "EMAIL", Account: account, ClaimType: "OWNERSHIP"})
+			return err
+		}),
+		pixCall("ConfirmPixClaim", http.MethodPost, PixClaimPath+"/confirm", func(ctx context.Context) error {
+			_, err := pix.ConfirmPixClaim(ctx, claimAction)
+			return err
+		}),
+		pixCall("CancelPixClaim", http.MethodPost, PixClaimPath+"/cancel", func(ctx context.Context) error {
+			_, err := pix.CancelPixClaim(ctx, claimAction)
+			return err
+		}),
+		pixCall("GetPixClaim", http.MethodGet, PixClaimPath+"/"+id, func(ctx context.Context) error {
+			_, err := pix.GetPixClaim(ctx, id)
+			return err
+		}),
+		pixCall("GetPixClaimList", http.MethodGet, PixClaimPath, func(ctx context.Context) error {
+			_, err := pix.GetPixClaimList(ctx, "2025-01-01", "2025-01-31", 10, 1, "OPEN", "OWNERSHIP")
+			return err
+		}),
+
+		webhookCall("CreateSubscription", http.MethodPost, WebhookPath+"/subscription", func(ctx context.Context) error {
+			_, err := webhooks.CreateSubscription(ctx, WebhookSubscriptionRequest{Entity: "pix-payment-out", WebhookURL: "https://contbank.com/webhook"})
+			return err
+		}),
+		webhookCall("CreateSubscriptionDda", http.MethodPost, WebhookDdaPath+"/register", func(ctx context.Context) error {
+			_, err := webhooks.CreateSubscriptionDda(ctx, WebhookSubscriptionDdaRequest{TypeEventWebhook: "Invoice", URL: "https://contbank.com/webhook"})
+			return err
+		}),
+		webhookCall("GetSubscriptions", http.MethodGet, WebhookPath+"/subscription", func(ctx context.Context) error {
+			_, err := webhooks.GetSubscriptions(ctx, "pix-payment-out", Bool(true))
+			return err
+		}),
+		webhookCall("UpdateSubscription", http.MethodPut, WebhookPath+"/subscription/pix-payment-out", func(ctx context.Context) error {
+			_, err := webhooks.UpdateSubscription(ctx, "pix-payment-out", WebhookUpdateRequest{WebhookURL: "https://contbank.com/webhook"})
+			return err
+		}),
+		webhookCall("DeleteSubscription", http.MethodDelete, WebhookPath+"/subscription/pix-payment-out", func(ctx context.Context) error {
+			_, err := webhooks.DeleteSubscription(ctx, "pix-payment-out", id)
+			return err
+		}),
+		webhookCall("GetWebhookReplayCount", http.MethodGet, WebhookPath+"/replay/pix-payment-out", func(ctx context.Context) error {
+			_, err := webhooks.GetWebhookReplayCount(ctx, "pix-payment-out", "2025-01-01", "2025-01-31", map[string]string{"OnlyPending": "true"})
+			return err
+		}),
+		webhookCall("GetWebhookReplay", http.MethodGet, WebhookPath+"/replay/pix-payment-out", func(ctx context.Context) error {
+			_, err := webhooks.GetWebhookReplay(ctx, "pix-payment-out", "2025-01-01", "2025-01-31", true)
+			return err
+		}),
+		webhookCall("GetWebhookReplaySendCount", http.MethodGet, WebhookPath+"/replay/pix-payment-out/count", func(ctx context.Context) error {
+			_, err := webhooks.GetWebhookReplaySendCount(ctx, "pix-payment-out", "2025-01-01", "2025-01-31")
+			return err
+		}),
+		webhookCall("ReplayMessageFromWebhook", http.MethodPut, WebhookPath+"/replay/pix-payment-out", func(ctx context.Context) error {
+			_, err := webhooks.ReplayMessageFromWebhook(ctx, "pix-payment-out", id, "2025-01-01", "2025-01-31", true, WebhookReplayRequest{})
+			return err
+		}),
+
+		ddaCall("CreateRegisterUser", http.MethodPost, func(ctx context.Context) error {
+			_, err := dda.CreateRegisterUser(ctx, "correlation-123", DdaRegisterUserRequest{Document: document, ClientName: "Contbank"})
+			return err
+		}),
+		ddaCall("DeleteRegisterUser", http.MethodDelete, func(ctx context.Context) error {
+			_, err := dda.DeleteRegisterUser(ctx, "correlation-123", DdaDeleteUserRequest{Document: document})
+			return err
+		}),
+
+		updateAccountStatus,
+		findAccounts,
+		customersCall("CreateAccount", http.MethodPost, NaturalPersonOnboardingPath, func(ctx context.Context) error {
+			_, err := customers.CreateAccount(ctx, &Customer{})
+			return err
+		}),
+		customersCall("GetOnboardingProposal", http.MethodGet, ProposalsPath, func(ctx context.Context) error {
+			_, err := customers.GetOnboardingProposal(ctx, id)
+			return err
+		}),
+		customersCall("GetOnboardingProposalFiles", http.MethodGet, ProposalFilesPath, func(ctx context.Context) error {
+			_, err := customers.GetOnboardingProposalFiles(ctx, id)
+			return err
+		}),
+		cancelAccount,
+		customersCall("CreateAccountMigration", http.MethodPost, NaturalPersonOnboardingPath, func(ctx context.Context) error {
+			_, err := customers.CreateAccountMigration(ctx, &CustomerMigration{})
+			return err
+		}),
+	}
+}
+
+// assertKey ...
+func (s *ExecutorTestSuite) assertKey(err error, key string) {
+	var grokErr *grok.Error
+	if s.assert.True(errors.As(err, &grokErr), "%v", err) {
+		s.assert.Equal(key, grokErr.Key)
+	}
+}
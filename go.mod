@@ -13,6 +13,9 @@ require (
 	github.com/stretchr/testify v1.10.0
 	github.com/tidwall/gjson v1.12.1
 	github.com/tidwall/sjson v1.1.6
+	github.com/youmark/pkcs8 v0.0.0-20181117223130-1be2e3e5546d
+	golang.org/x/crypto v0.11.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
@@ -64,9 +67,7 @@ require (
 	github.com/xdg-go/pbkdf2 v1.0.0 // indirect
 	github.com/xdg-go/scram v1.0.2 // indirect
 	github.com/xdg-go/stringprep v1.0.2 // indirect
-	github.com/youmark/pkcs8 v0.0.0-20181117223130-1be2e3e5546d // indirect
 	go.mongodb.org/mongo-driver v1.8.1 // indirect
-	golang.org/x/crypto v0.11.0 // indirect
 	golang.org/x/net v0.10.0 // indirect
 	golang.org/x/oauth2 v0.0.0-20200902213428-5d25da1a8d43 // indirect
 	golang.org/x/sync v0.1.0 // indirect
@@ -81,5 +82,4 @@ require (
 	gopkg.in/mgo.v2 v2.0.0-20190816093944-a6b53ec6cb22 // indirect
 	gopkg.in/square/go-jose.v2 v2.5.1 // indirect
 	gopkg.in/yaml.v2 v2.4.0 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
@@ -2,19 +2,35 @@ package celcoin
 
 import (
 	"bytes"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"time"
-
-	"github.com/sirupsen/logrus"
 )
 
 type LoggingHTTPClient struct {
 	client *http.Client
+	policy *LogPolicy
+	logger Logger
 }
 
 func NewLoggingHTTPClient(client *http.Client) *LoggingHTTPClient {
-	return &LoggingHTTPClient{client: client}
+	return NewLoggingHTTPClientWithPolicy(client, nil)
+}
+
+// NewLoggingHTTPClientWithPolicy ... LoggingHTTPClient com regras de mascaramento, nível, tamanho e amostragem dos logs
+func NewLoggingHTTPClientWithPolicy(client *http.Client, policy *LogPolicy) *LoggingHTTPClient {
+	if policy == nil {
+		policy = DefaultLogPolicy()
+	}
+	return &LoggingHTTPClient{client: client, policy: policy, logger: NewLogrusLogger(nil)}
+}
+
+// newSessionLoggingHTTPClient ... LoggingHTTPClient dos serviços, com a LogPolicy e o Logger da sessão
+func newSessionLoggingHTTPClient(client *http.Client, session Session) *LoggingHTTPClient {
+	httpClient := NewLoggingHTTPClientWithPolicy(client, session.LogPolicy)
+	httpClient.logger = session.baseLogger()
+	return httpClient
 }
 
 func (c *LoggingHTTPClient) Do(req *http.Request) (*http.Response, error) {
@@ -25,21 +41,36 @@ func (c *LoggingHTTPClient) Do(req *http.Request) (*http.Response, error) {
 	if req != nil && req.Body != nil {
 		reqBody, _ = ioutil.ReadAll(req.Body)
 		req.Body = ioutil.NopCloser(bytes.NewBuffer(reqBody)) // Restore the body for further use
+		req.GetBody = func() (io.ReadCloser, error) {         // Keep the body rewindable for retries
+			return ioutil.NopCloser(bytes.NewReader(reqBody)), nil
+		}
+	}
+	if req != nil {
+		setCorrelationHeader(req)
 	}
 
-	logrus.WithFields(logrus.Fields{
-		"celcoin_request": logrus.Fields{
-			"method":     req.Method,
-			"url":        req.URL.String(),
-			"header":     req.Header,
-			"body":       string(reqBody),
-			"user-agent": req.UserAgent(),
-		},
-	}).Info("HTTP Request Celcoin")
+	// O logger da operação, quando presente no contexto, já traz service, operation e request_id
+	logger := loggerFrom(req.Context(), c.logger)
+	level := c.policy.Level
+	sampled := c.policy.sampled()
+	requestFields := Fields{
+		"method":     req.Method,
+		"url":        c.policy.redactURL(req.URL),
+		"header":     c.policy.redactHeaders(req.Header),
+		"body":       c.policy.redactBody(req.Header.Get("Content-Type"), reqBody),
+		"user-agent": req.UserAgent(),
+	}
+	if sampled {
+		logAt(logger.WithField("celcoin_request", requestFields), level, "HTTP Request Celcoin")
+	}
 
+	op := operationFrom(req.Context())
 	resp, err := c.client.Do(req)
 	if err != nil {
-		logrus.WithError(err).Error("HTTP request failed")
+		if op != nil {
+			op.record(req.Method, 0, nil)
+		}
+		logger.WithField("celcoin_request", requestFields).WithError(err).Error("HTTP request failed")
 		return nil, err
 	}
 
@@ -51,15 +82,28 @@ func (c *LoggingHTTPClient) Do(req *http.Request) (*http.Response, error) {
 		respBody, _ = ioutil.ReadAll(resp.Body)
 		resp.Body = ioutil.NopCloser(bytes.NewBuffer(respBody)) // Restore the body for further use
 	}
+	captureResponseMeta(req, resp, respBody, duration)
+	if op != nil {
+		op.record(req.Method, resp.StatusCode, respBody)
+	}
 
-	logrus.WithFields(logrus.Fields{
-		"celcoin_response": logrus.Fields{
-			"header":   resp.Header,
+	// Respostas com erro são sempre registradas, junto com a requisição omitida pela amostragem
+	fields := Fields{
+		"celcoin_status": resp.StatusCode,
+		"celcoin_response": Fields{
+			"header":   c.policy.redactHeaders(resp.Header),
 			"status":   resp.StatusCode,
 			"duration": duration,
-			"body":     string(respBody),
+			"body":     c.policy.redactBody(resp.Header.Get("Content-Type"), respBody),
 		},
-	}).Info("HTTP Response Celcoin")
+	}
+	if !sampled {
+		if resp.StatusCode < http.StatusBadRequest {
+			return resp, nil
+		}
+		fields["celcoin_request"] = requestFields
+	}
+	logAt(logger.WithFields(fields), level, "HTTP Response Celcoin")
 
 	return resp, nil
 }
@@ -0,0 +1,223 @@
+package celcoin_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/contbank/celcoin-sdk"
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+// LoggingHTTPClientTestSuite ...
+type LoggingHTTPClientTestSuite struct {
+	suite.Suite
+	assert *assert.Assertions
+	hook   *test.Hook
+	level  logrus.Level
+	status int
+	body   string
+	server *httptest.Server
+}
+
+// TestLoggingHTTPClientTestSuite ...
+func TestLoggingHTTPClientTestSuite(t *testing.T) {
+	suite.Run(t, new(LoggingHTTPClientTestSuite))
+}
+
+// SetupTest ...
+func (s *LoggingHTTPClientTestSuite) SetupTest() {
+	s.assert = assert.New(s.T())
+	s.hook = test.NewGlobal()
+	s.level = logrus.GetLevel()
+	s.status = http.StatusOK
+	s.body = `{"status":"SUCCESS","body":{"documentNumber":"12345678909","name":"Fulano de Tal","amount":10}}`
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Set-Cookie", "session=secret")
+		w.WriteHeader(s.status)
+		w.Write([]byte(s.body))
+	}))
+}
+
+// TearDownTest ...
+func (s *LoggingHTTPClientTestSuite) TearDownTest() {
+	s.server.Close()
+	logrus.StandardLogger().ReplaceHooks(make(logrus.LevelHooks))
+	logrus.SetLevel(s.level)
+}
+
+func (s *LoggingHTTPClientTestSuite) do(policy *celcoin.LogPolicy, req *http.Request) {
+	client := celcoin.NewLoggingHTTPClientWithPolicy(http.DefaultClient, policy)
+
+	resp, err := client.Do(req)
+	s.Require().NoError(err)
+	defer resp.Body.Close()
+}
+
+func (s *LoggingHTTPClientTestSuite) logged() string {
+	var b strings.Builder
+	for _, entry := range s.hook.AllEntries() {
+		fmt.Fprintf(&b, "%s %v\n", entry.Message, entry.Data)
+	}
+	return b.String()
+}
+
+// TestRedactsHeadersAndJSON ...
+func (s *LoggingHTTPClientTestSuite) TestRedactsHeadersAndJSON() {
+	req, err := http.NewRequest(http.MethodPost, s.server.URL+"/pix?access_token=query-secret-token&page=1",
+		strings.NewReader(`{"debitParty":{"taxId":"12345678909","account":"300541976902"},"amount":10}`))
+	s.Require().NoError(err)
+	req.Header.Set("Authorization", "Bearer super-secret-token")
+	req.Header.Set("Content-Type", "application/json")
+
+	s.do(nil, req)
+
+	logged := s.logged()
+	s.assert.Len(s.hook.AllEntries(), 2)
+	s.assert.NotContains(logged, "super-secret-token")
+	s.assert.NotContains(logged, "12345678909")
+	s.assert.NotContains(logged, "Fulano de Tal")
+	s.assert.NotContains(logged, "session=secret")
+	s.assert.NotContains(logged, "query-secret-token")
+	s.assert.NotContains(logged, "300541976902")
+	s.assert.Contains(logged, "page=1")
+	s.assert.Contains(logged, celcoin.RedactedValue)
+}
+
+// TestRedactsFormFields ...
+func (s *LoggingHTTPClientTestSuite) TestRedactsFormFields() {
+	form := url.Values{
+		"client_id":     {"celcoin-client"},
+		"client_secret": {"celcoin-secret"},
+		"grant_type":    {"client_credentials"},
+	}
+	req, err := http.NewRequest(http.MethodPost, s.server.URL+"/"+celcoin.LoginPath, strings.NewReader(form.Encode()))
+	s.Require().NoError(err)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	s.do(nil, req)
+
+	logged := s.logged()
+	s.assert.NotContains(logged, "celcoin-secret")
+	s.assert.Contains(logged, "celcoin-client")
+	s.assert.Contains(logged, "client_credentials")
+}
+
+// TestCustomRules ...
+func (s *LoggingHTTPClientTestSuite) TestCustomRules() {
+	req, err := http.NewRequest(http.MethodGet, s.server.URL+"/balance", nil)
+	s.Require().NoError(err)
+	req.Header.Set("X-Tenant", "tenant-secret")
+
+	s.do(&celcoin.LogPolicy{
+		RedactHeaders:   []string{"x-tenant"},
+		RedactJSONPaths: []string{"status"},
+	}, req)
+
+	logged := s.logged()
+	s.assert.NotContains(logged, "tenant-secret")
+	s.assert.NotContains(logged, "SUCCESS")
+	s.assert.NotContains(logged, "12345678909")
+}
+
+// TestDisableDefaultRedaction ...
+func (s *LoggingHTTPClientTestSuite) TestDisableDefaultRedaction() {
+	req, err := http.NewRequest(http.MethodGet, s.server.URL+"/balance", nil)
+	s.Require().NoError(err)
+
+	s.do(&celcoin.LogPolicy{DisableDefaultRedaction: true, RedactJSONKeys: []string{"name"}}, req)
+
+	logged := s.logged()
+	s.assert.Contains(logged, "12345678909")
+	s.assert.NotContains(logged, "Fulano de Tal")
+}
+
+// TestMaxBodySize ...
+func (s *LoggingHTTPClientTestSuite) TestMaxBodySize() {
+	s.body = `{"description":"` + strings.Repeat("x", 100) + `"}`
+	req, err := http.NewRequest(http.MethodGet, s.server.URL+"/balance", nil)
+	s.Require().NoError(err)
+
+	s.do(&celcoin.LogPolicy{MaxBodySize: 20}, req)
+
+	response := s.hook.LastEntry().Data["celcoin_response"].(celcoin.Fields)
+	s.assert.True(strings.HasPrefix(response["body"].(string), `{"description":"xxx`))
+	s.assert.Contains(response["body"], "(truncated 98 bytes)")
+
+	s.hook.Reset()
+	s.do(&celcoin.LogPolicy{MaxBodySize: -1}, req)
+	response = s.hook.LastEntry().Data["celcoin_response"].(celcoin.Fields)
+	s.assert.Empty(response["body"])
+}
+
+// TestMaxBodySizeKeepsUTF8 ...
+func (s *LoggingHTTPClientTestSuite) TestMaxBodySizeKeepsUTF8() {
+	s.body = `{"description":"` + strings.Repeat("ã", 50) + `"}`
+	req, err := http.NewRequest(http.MethodGet, s.server.URL+"/balance", nil)
+	s.Require().NoError(err)
+
+	// O limite cai no meio do segundo byte de um "ã"
+	s.do(&celcoin.LogPolicy{MaxBodySize: 19}, req)
+
+	response := s.hook.LastEntry().Data["celcoin_response"].(celcoin.Fields)
+	body := response["body"].(string)
+	s.assert.True(utf8.ValidString(body), body)
+	s.assert.True(strings.HasPrefix(body, `{"description":"ã...`))
+	s.assert.Contains(body, "(truncated 100 bytes)")
+}
+
+// TestLevel ...
+func (s *LoggingHTTPClientTestSuite) TestLevel() {
+	logrus.SetLevel(logrus.DebugLevel)
+	req, err := http.NewRequest(http.MethodGet, s.server.URL+"/balance", nil)
+	s.Require().NoError(err)
+
+	s.do(&celcoin.LogPolicy{Level: celcoin.LogLevelDebug}, req)
+
+	s.Require().Len(s.hook.AllEntries(), 2)
+	for _, entry := range s.hook.AllEntries() {
+		s.assert.Equal(logrus.DebugLevel, entry.Level)
+	}
+}
+
+// TestSampling ...
+func (s *LoggingHTTPClientTestSuite) TestSampling() {
+	policy := &celcoin.LogPolicy{SampleRate: 0.000001}
+	req, err := http.NewRequest(http.MethodGet, s.server.URL+"/balance", nil)
+	s.Require().NoError(err)
+
+	s.do(policy, req)
+	s.assert.Empty(s.hook.AllEntries())
+
+	// Falhas são sempre registradas, com a requisição
+	s.status = http.StatusInternalServerError
+	s.do(policy, req)
+	s.Require().Len(s.hook.AllEntries(), 1)
+	s.assert.Contains(s.hook.LastEntry().Data, "celcoin_request")
+	s.assert.Contains(s.hook.LastEntry().Data, "celcoin_response")
+}
+
+// TestLoggingRoundTripperRestrictsTopLevelFields ...
+func (s *LoggingHTTPClientTestSuite) TestLoggingRoundTripperRestrictsTopLevelFields() {
+	s.body = `{"status":"SUCCESS","version":"1.0.0"}`
+	client := &http.Client{Transport: celcoin.LoggingRoundTripper{
+		Proxied:     http.DefaultTransport,
+		Restricteds: []string{"status"},
+	}}
+
+	resp, err := client.Get(s.server.URL + "/balance")
+	s.Require().NoError(err)
+	resp.Body.Close()
+
+	logged := s.logged()
+	s.assert.NotContains(logged, "SUCCESS")
+	s.assert.Contains(logged, "1.0.0")
+}
@@ -7,33 +7,40 @@ import (
 	"net/http"
 	"net/url"
 	"path"
-
-	"github.com/sirupsen/logrus"
 )
 
+// IncomeReportInterface define a interface para o informe de rendimentos.
+type IncomeReportInterface interface {
+	GetIncomeReport(ctx context.Context, calendarYear *string, accountNumber *string) (*IncomeReportResponse, error)
+}
+
 // IncomeReport ...
 type IncomeReport struct {
 	session    Session
 	httpClient *LoggingHTTPClient
 }
 
+var _ IncomeReportInterface = (*IncomeReport)(nil)
+
 // NewIncomeReport ...
-func NewIncomeReport(httpClient *http.Client, session Session) *IncomeReport {
+func NewIncomeReport(httpClient *http.Client, session Session) IncomeReportInterface {
 	return &IncomeReport{
 		session:    session,
-		httpClient: NewLoggingHTTPClient(httpClient),
+		httpClient: newSessionLoggingHTTPClient(httpClient, session),
 	}
 }
 
 // GetIncomeReport ...
 func (r *IncomeReport) GetIncomeReport(ctx context.Context,
-	calendarYear *string, accountNumber *string) (*IncomeReportResponse, error) {
+	calendarYear *string, accountNumber *string) (_ *IncomeReportResponse, err error) {
+	ctx, op := r.session.startOperation(ctx, "income_report", "GetIncomeReport", IncomeReportPath)
+	defer op.end(&err)
+	logger := op.logger
 
-	requestID, _ := ctx.Value("Request-Id").(string)
-	fields := logrus.Fields{
+	requestID := RequestIDFrom(ctx)
+	fields := Fields{
 		"request_id":     requestID,
 		"interface":      "GetIncomeReport",
-		"service":        "income_report",
 		"calendar_year":  calendarYear,
 		"account_number": accountNumber,
 	}
@@ -48,7 +55,7 @@ func (r *IncomeReport) GetIncomeReport(ctx context.Context,
 
 	u, err := url.Parse(r.session.APIEndpoint)
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error parsing api endpoint")
 		return nil, err
 	}
@@ -68,12 +75,12 @@ func (r *IncomeReport) GetIncomeReport(ctx context.Context,
 	u.RawQuery = q.Encode()
 	endpoint := u.String()
 
-	logrus.WithFields(fields).WithField("celcoin_endpoint", endpoint).
+	logger.WithFields(fields).WithField("celcoin_endpoint", endpoint).
 		Info("income report request")
 
-	req, err := http.NewRequest("GET", endpoint, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error creating request")
 		return nil, err
 	}
@@ -82,7 +89,7 @@ func (r *IncomeReport) GetIncomeReport(ctx context.Context,
 
 	resp, err := r.httpClient.Do(req)
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error making request")
 		return nil, err
 	}
@@ -90,7 +97,7 @@ func (r *IncomeReport) GetIncomeReport(ctx context.Context,
 
 	bodyBytes, err := io.ReadAll(resp.Body)
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error reading response body")
 		return nil, err
 	}
@@ -98,14 +105,14 @@ func (r *IncomeReport) GetIncomeReport(ctx context.Context,
 	if resp.StatusCode != http.StatusOK {
 		var errResponse *ErrorDefaultResponse
 		if err := json.Unmarshal(bodyBytes, &errResponse); err != nil {
-			logrus.WithFields(fields).WithError(err).
+			logger.WithFields(fields).WithError(err).
 				Error("error unmarshal")
 			return nil, err
 		}
 
 		if errResponse != nil && errResponse.Error != nil && len(*errResponse.Error.ErrorCode) > 0 {
 			err := FindIncomeReportError(*errResponse.Error.ErrorCode, &resp.StatusCode)
-			logrus.WithFields(fields).WithError(err).
+			logger.WithFields(fields).WithError(err).
 				Error("error getting income response")
 			return nil, err
 		}
@@ -113,12 +120,12 @@ func (r *IncomeReport) GetIncomeReport(ctx context.Context,
 
 	var incomeReportResponse *IncomeReportResponse
 	if err := json.Unmarshal(bodyBytes, &incomeReportResponse); err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error unmarshal")
 		return nil, err
 	}
 
-	logrus.WithFields(fields).WithField("celcoin_response", incomeReportResponse).
+	logger.WithFields(fields).WithField("celcoin_response", incomeReportResponse).
 		Info("income report response")
 
 	return incomeReportResponse, nil
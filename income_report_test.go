@@ -21,7 +21,7 @@ type IncomeReportTestSuite struct {
 	assert       *assert.Assertions
 	ctx          context.Context
 	session      *Session
-	incomeReport *IncomeReport
+	incomeReport IncomeReportInterface
 	mockClient   *MockHTTPClient
 }
 
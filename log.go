@@ -3,6 +3,7 @@ package celcoin
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
@@ -10,48 +11,67 @@ import (
 
 	"github.com/tidwall/gjson"
 	"github.com/tidwall/sjson"
-
-	"github.com/sirupsen/logrus"
 )
 
 // LoggingRoundTripper ...
 type LoggingRoundTripper struct {
 	Proxied     http.RoundTripper
 	Restricteds []string
+	// Policy ... regras de mascaramento, nível e amostragem (padrão DefaultLogPolicy)
+	Policy *LogPolicy
+	// Logger ... destino dos logs (padrão NewLogrusLogger(nil))
+	Logger Logger
 }
 
 // RoundTrip ...
 func (lrt LoggingRoundTripper) RoundTrip(req *http.Request) (res *http.Response, err error) {
 
-	fields := logrus.Fields{
-		"request_id":        req.Context().Value("Request-Id"),
+	fields := Fields{
+		"request_id":        RequestIDFrom(req.Context()),
 		"worker_request_id": req.Context().Value("Worker-Request-Id"),
 	}
 
+	policy := lrt.Policy
+	if policy == nil {
+		policy = DefaultLogPolicy()
+	}
+	logger := lrt.Logger
+	if logger == nil {
+		logger = NewLogrusLogger(nil)
+	}
+	logger = loggerFrom(req.Context(), logger)
+	level := policy.Level
+	sampled := policy.sampled()
+
 	now := time.Now()
 
-	fields["request"] = request(req, lrt.Restricteds)
+	fields["request"] = request(req, lrt.Restricteds, policy)
 
-	logrus.WithFields(fields).Infof("sending request to %v", req.URL)
+	if sampled {
+		logAt(logger.WithFields(fields), level, fmt.Sprintf("sending request to %v", policy.redactURL(req.URL)))
+	}
 
 	res, err = lrt.Proxied.RoundTrip(req)
 
 	elapsed := time.Since(now)
 
 	if err != nil {
-		logrus.
+		logger.
 			WithError(err).
 			WithFields(fields).
 			Error("error while receiving response")
 		return
 	}
 
-	fields["response"] = response(res, lrt.Restricteds)
+	if !sampled && res.StatusCode < http.StatusBadRequest {
+		return
+	}
+
+	fields["response"] = response(res, lrt.Restricteds, policy)
 	fields["latency"] = elapsed.Seconds()
+	fields["celcoin_status"] = res.StatusCode
 
-	logrus.
-		WithFields(fields).
-		Print("request completed successfully")
+	logAt(logger.WithFields(fields), level, "request completed successfully")
 
 	return
 }
@@ -63,11 +83,11 @@ func restricted(v interface{}, restricteds []string) interface{} {
 		for _, restricted := range restricteds {
 			result := gjson.Get(str, restricted)
 
-			if result.Index <= 0 {
+			if !result.Exists() {
 				continue
 			}
 
-			str, _ = sjson.Set(str, restricted, "RESTRICTED")
+			str, _ = sjson.Set(str, restricted, RedactedValue)
 		}
 		return unmarshal(str)
 	}
@@ -82,7 +102,7 @@ func marshal(v interface{}) string {
 
 // unmarshal ...
 func unmarshal(str string) interface{} {
-	v := make(map[string]interface{})
+	var v interface{}
 
 	json.Unmarshal([]byte(str), &v)
 
@@ -90,7 +110,7 @@ func unmarshal(str string) interface{} {
 }
 
 // request ...
-func request(request *http.Request, restricteds []string) interface{} {
+func request(request *http.Request, restricteds []string, policy *LogPolicy) interface{} {
 	r := make(map[string]interface{})
 
 	if request.Body != nil {
@@ -98,40 +118,46 @@ func request(request *http.Request, restricteds []string) interface{} {
 		io.Copy(bodyCopy, request.Body)
 		bodyData := bodyCopy.Bytes()
 
-		var body map[string]interface{}
-		json.Unmarshal(bodyData, &body)
-
-		r["body"] = restricted(body, restricteds)
+		r["body"] = body(request.Header.Get("Content-Type"), bodyData, restricteds, policy)
 		request.Body = ioutil.NopCloser(bytes.NewReader(bodyData))
 	}
 
 	r["host"] = request.Host
-	r["form"] = request.Form
+	r["form"] = policy.redactValues(request.Form)
 	r["path"] = request.URL.Path
 	r["method"] = request.Method
-	r["url"] = request.URL.String()
-	r["post_form"] = request.PostForm
+	r["url"] = policy.redactURL(request.URL)
+	r["header"] = policy.redactHeaders(request.Header)
+	r["post_form"] = policy.redactValues(request.PostForm)
 	r["remote_addr"] = request.RemoteAddr
-	r["query_string"] = request.URL.Query()
+	r["query_string"] = policy.redactValues(request.URL.Query())
 
 	return r
 }
 
 // response ...
-func response(response *http.Response, restricteds []string) interface{} {
+func response(response *http.Response, restricteds []string, policy *LogPolicy) interface{} {
 	r := make(map[string]interface{})
 
 	bodyCopy := new(bytes.Buffer)
 	io.Copy(bodyCopy, response.Body)
 	bodyData := bodyCopy.Bytes()
 
-	var body map[string]interface{}
-	json.Unmarshal(bodyData, &body)
-
-	r["body"] = restricted(body, restricteds)
+	r["body"] = body(response.Header.Get("Content-Type"), bodyData, restricteds, policy)
+	r["header"] = policy.redactHeaders(response.Header)
 	r["status"] = response.StatusCode
 
 	response.Body = ioutil.NopCloser(bytes.NewReader(bodyData))
 
 	return r
 }
+
+// body ... corpo mascarado pelos Restricteds e pela LogPolicy
+func body(contentType string, data []byte, restricteds []string, policy *LogPolicy) string {
+	if len(restricteds) > 0 && json.Valid(data) {
+		var v interface{}
+		json.Unmarshal(data, &v)
+		data = []byte(marshal(restricted(v, restricteds)))
+	}
+	return policy.redactBody(contentType, data)
+}
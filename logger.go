@@ -0,0 +1,202 @@
+package celcoin
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Fields ... campos estruturados dos logs
+type Fields map[string]interface{}
+
+// Logger ... logger usado pelo SDK. Os adaptadores NewLogrusLogger (padrão), NewSlogLogger e NewNopLogger
+// cobrem os casos comuns; outras bibliotecas (ex.: zap) podem ser ligadas implementando esta interface.
+type Logger interface {
+	WithField(key string, value interface{}) Logger
+	WithFields(fields Fields) Logger
+	WithError(err error) Logger
+	Debug(msg string)
+	Info(msg string)
+	Warn(msg string)
+	Error(msg string)
+}
+
+// logrusLogger ...
+type logrusLogger struct {
+	entry *logrus.Entry
+}
+
+// NewLogrusLogger ... adaptador para o logrus; nil usa o logger global, como nas versões anteriores do SDK
+func NewLogrusLogger(logger *logrus.Logger) Logger {
+	if logger == nil {
+		logger = logrus.StandardLogger()
+	}
+	return &logrusLogger{entry: logrus.NewEntry(logger)}
+}
+
+// WithField ...
+func (l *logrusLogger) WithField(key string, value interface{}) Logger {
+	return &logrusLogger{entry: l.entry.WithField(key, value)}
+}
+
+// WithFields ...
+func (l *logrusLogger) WithFields(fields Fields) Logger {
+	return &logrusLogger{entry: l.entry.WithFields(logrus.Fields(fields))}
+}
+
+// WithError ...
+func (l *logrusLogger) WithError(err error) Logger {
+	return &logrusLogger{entry: l.entry.WithError(err)}
+}
+
+// Debug ...
+func (l *logrusLogger) Debug(msg string) {
+	l.entry.Debug(msg)
+}
+
+// Info ...
+func (l *logrusLogger) Info(msg string) {
+	l.entry.Info(msg)
+}
+
+// Warn ...
+func (l *logrusLogger) Warn(msg string) {
+	l.entry.Warn(msg)
+}
+
+// Error ...
+func (l *logrusLogger) Error(msg string) {
+	l.entry.Error(msg)
+}
+
+// nopLogger ...
+type nopLogger struct{}
+
+// NewNopLogger ... descarta todos os logs do SDK
+func NewNopLogger() Logger {
+	return nopLogger{}
+}
+
+// WithField ...
+func (l nopLogger) WithField(string, interface{}) Logger { return l }
+
+// WithFields ...
+func (l nopLogger) WithFields(Fields) Logger { return l }
+
+// WithError ...
+func (l nopLogger) WithError(error) Logger { return l }
+
+// Debug ...
+func (nopLogger) Debug(string) {}
+
+// Info ...
+func (nopLogger) Info(string) {}
+
+// Warn ...
+func (nopLogger) Warn(string) {}
+
+// Error ...
+func (nopLogger) Error(string) {}
+
+// logAt ... registra a mensagem no nível configurado na LogPolicy
+func logAt(logger Logger, level LogLevel, msg string) {
+	switch level {
+	case LogLevelDebug:
+		logger.Debug(msg)
+	case LogLevelWarn:
+		logger.Warn(msg)
+	case LogLevelError:
+		logger.Error(msg)
+	default:
+		logger.Info(msg)
+	}
+}
+
+type loggerKey struct{}
+
+// withLogger ... guarda no contexto o logger da operação, usado pelo LoggingHTTPClient
+func withLogger(ctx context.Context, logger Logger) context.Context {
+	return context.WithValue(ctx, loggerKey{}, logger)
+}
+
+// loggerFrom ... logger da operação guardado no contexto ou o fallback informado
+func loggerFrom(ctx context.Context, fallback Logger) Logger {
+	if logger, ok := ctx.Value(loggerKey{}).(Logger); ok && logger != nil {
+		return logger
+	}
+	return fallback
+}
+
+// redactingLogger ... aplica a LogPolicy aos campos antes de repassá-los ao Logger, para que os payloads
+// registrados pelos serviços (requisições, respostas, contas e chaves Pix) não exponham dados pessoais
+type redactingLogger struct {
+	logger Logger
+	policy *LogPolicy
+}
+
+// WithField ...
+func (l *redactingLogger) WithField(key string, value interface{}) Logger {
+	return &redactingLogger{logger: l.logger.WithField(key, l.policy.redactField(l.policy.jsonKeys(), key, value)), policy: l.policy}
+}
+
+// WithFields ...
+func (l *redactingLogger) WithFields(fields Fields) Logger {
+	return &redactingLogger{logger: l.logger.WithFields(l.policy.redactFields(fields)), policy: l.policy}
+}
+
+// WithError ...
+func (l *redactingLogger) WithError(err error) Logger {
+	return &redactingLogger{logger: l.logger.WithError(err), policy: l.policy}
+}
+
+// Debug ...
+func (l *redactingLogger) Debug(msg string) {
+	l.logger.Debug(msg)
+}
+
+// Info ...
+func (l *redactingLogger) Info(msg string) {
+	l.logger.Info(msg)
+}
+
+// Warn ...
+func (l *redactingLogger) Warn(msg string) {
+	l.logger.Warn(msg)
+}
+
+// Error ...
+func (l *redactingLogger) Error(msg string) {
+	l.logger.Error(msg)
+}
+
+// baseLogger ... Logger da sessão com as regras de mascaramento da LogPolicy; sessões sem Logger usam o logrus global
+func (s Session) baseLogger() Logger {
+	logger := s.Logger
+	if logger == nil {
+		logger = NewLogrusLogger(nil)
+	}
+	policy := s.LogPolicy
+	if policy == nil {
+		policy = DefaultLogPolicy()
+	}
+	return &redactingLogger{logger: logger, policy: policy}
+}
+
+// logger ... Logger da sessão com o campo service
+func (s Session) logger(service string) Logger {
+	return s.baseLogger().WithField("service", service)
+}
+
+// operationLogger ... Logger da operação com os campos service, operation, request_id e tenant,
+// junto com o contexto que o repassa ao LoggingHTTPClient
+func (s Session) operationLogger(ctx context.Context, service, operation string) (context.Context, Logger) {
+	fields := Fields{"operation": operation}
+	if requestID := RequestIDFrom(ctx); len(requestID) > 0 {
+		fields["request_id"] = requestID
+	}
+	if tenant := TenantFrom(ctx); len(tenant) > 0 {
+		fields["tenant"] = tenant
+	}
+	logger := s.logger(service).WithFields(fields)
+	return withLogger(ctx, logger), logger
+}
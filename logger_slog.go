@@ -0,0 +1,59 @@
+//go:build go1.21
+
+package celcoin
+
+import (
+	"log/slog"
+)
+
+// slogLogger ...
+type slogLogger struct {
+	logger *slog.Logger
+}
+
+// NewSlogLogger ... adaptador para o log/slog; nil usa slog.Default()
+func NewSlogLogger(logger *slog.Logger) Logger {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &slogLogger{logger: logger}
+}
+
+// WithField ...
+func (l *slogLogger) WithField(key string, value interface{}) Logger {
+	return &slogLogger{logger: l.logger.With(key, value)}
+}
+
+// WithFields ...
+func (l *slogLogger) WithFields(fields Fields) Logger {
+	args := make([]interface{}, 0, len(fields)*2)
+	for key, value := range fields {
+		args = append(args, key, value)
+	}
+	return &slogLogger{logger: l.logger.With(args...)}
+}
+
+// WithError ...
+func (l *slogLogger) WithError(err error) Logger {
+	return &slogLogger{logger: l.logger.With("error", err)}
+}
+
+// Debug ...
+func (l *slogLogger) Debug(msg string) {
+	l.logger.Debug(msg)
+}
+
+// Info ...
+func (l *slogLogger) Info(msg string) {
+	l.logger.Info(msg)
+}
+
+// Warn ...
+func (l *slogLogger) Warn(msg string) {
+	l.logger.Warn(msg)
+}
+
+// Error ...
+func (l *slogLogger) Error(msg string) {
+	l.logger.Error(msg)
+}
@@ -0,0 +1,35 @@
+//go:build go1.21
+
+package celcoin_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/contbank/celcoin-sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSlogLogger ...
+func TestSlogLogger(t *testing.T) {
+	var buffer bytes.Buffer
+	logger := celcoin.NewSlogLogger(slog.New(slog.NewJSONHandler(&buffer, &slog.HandlerOptions{Level: slog.LevelDebug})))
+
+	logger.WithFields(celcoin.Fields{"service": "pix", "operation": "CreatePixKey"}).
+		WithField("request_id", "request-123").
+		WithError(errors.New("boom")).
+		Error("error creating pix key")
+
+	var record map[string]interface{}
+	require.NoError(t, json.Unmarshal(buffer.Bytes(), &record))
+	assert.Equal(t, "ERROR", record["level"])
+	assert.Equal(t, "error creating pix key", record["msg"])
+	assert.Equal(t, "pix", record["service"])
+	assert.Equal(t, "CreatePixKey", record["operation"])
+	assert.Equal(t, "request-123", record["request_id"])
+	assert.Equal(t, "boom", record["error"])
+}
@@ -0,0 +1,167 @@
+package celcoin_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/contbank/celcoin-sdk"
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+// LoggerTestSuite ...
+type LoggerTestSuite struct {
+	suite.Suite
+	assert *assert.Assertions
+	ctx    context.Context
+	server *httptest.Server
+}
+
+// TestLoggerTestSuite ...
+func TestLoggerTestSuite(t *testing.T) {
+	suite.Run(t, new(LoggerTestSuite))
+}
+
+// SetupTest ...
+func (s *LoggerTestSuite) SetupTest() {
+	s.assert = assert.New(s.T())
+	s.ctx = context.WithValue(context.Background(), "Request-Id", "request-123")
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":"SUCCESS","version":"1.0.0","body":{"amount":10}}`))
+	}))
+}
+
+// TearDownTest ...
+func (s *LoggerTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+// TestLogrusLoggerFields ...
+func (s *LoggerTestSuite) TestLogrusLoggerFields() {
+	logger, hook := test.NewNullLogger()
+	logger.SetLevel(logrus.DebugLevel)
+
+	balance := celcoin.NewBalance(http.DefaultClient, celcoin.Session{
+		APIEndpoint: s.server.URL,
+		Logger:      celcoin.NewLogrusLogger(logger),
+	})
+
+	_, err := balance.Balance(s.ctx, "123456")
+	s.Require().NoError(err)
+	s.Require().NotEmpty(hook.AllEntries())
+
+	var response *logrus.Entry
+	for _, entry := range hook.AllEntries() {
+		s.assert.Equal("balance", entry.Data["service"], entry.Message)
+		s.assert.Equal("Balance", entry.Data["operation"], entry.Message)
+		s.assert.Equal("request-123", entry.Data["request_id"], entry.Message)
+		if entry.Message == "HTTP Response Celcoin" {
+			response = entry
+		}
+	}
+	s.Require().NotNil(response)
+	s.assert.Equal(http.StatusOK, response.Data["celcoin_status"])
+}
+
+// TestServiceLogsArePersonalDataFree ...
+func (s *LoggerTestSuite) TestServiceLogsArePersonalDataFree() {
+	logger, hook := test.NewNullLogger()
+	logger.SetLevel(logrus.DebugLevel)
+	session := celcoin.Session{
+		APIEndpoint: s.server.URL,
+		Logger:      celcoin.NewLogrusLogger(logger),
+	}
+
+	_, err := celcoin.NewPix(http.DefaultClient, session).CreatePixKey(s.ctx, celcoin.PixKeyRequest{
+		Account: "300541976902",
+		KeyType: "EMAIL",
+		Key:     "maria@example.com",
+	})
+	s.Require().NoError(err)
+	_, err = celcoin.NewStatement(http.DefaultClient, session).GetStatements(s.ctx, &celcoin.StatementRequest{
+		Account:        celcoin.String("300541976902"),
+		DocumentNumber: celcoin.String("12345678909"),
+	})
+	s.Require().NoError(err)
+	_, err = celcoin.NewBalance(http.DefaultClient, session).Balance(s.ctx, "300541976902")
+	s.Require().NoError(err)
+
+	var logged strings.Builder
+	for _, entry := range hook.AllEntries() {
+		fmt.Fprintf(&logged, "%s %v\n", entry.Message, entry.Data)
+	}
+	s.assert.Contains(logged.String(), celcoin.RedactedValue)
+	for _, personal := range []string{"300541976902", "maria@example.com", "12345678909"} {
+		s.assert.NotContains(logged.String(), personal)
+	}
+}
+
+// TestDictLookupLogsArePersonalDataFree ...
+func (s *LoggerTestSuite) TestDictLookupLogsArePersonalDataFree() {
+	logger, hook := test.NewNullLogger()
+	logger.SetLevel(logrus.DebugLevel)
+	pix := celcoin.NewPix(http.DefaultClient, celcoin.Session{
+		APIEndpoint: s.server.URL,
+		Logger:      celcoin.NewLogrusLogger(logger),
+	})
+
+	_, err := pix.GetExternalPixKey(s.ctx, "30000001", "maria@example.com", "12345678909")
+	s.Require().NoError(err)
+	_, err = pix.GetExternalPixKeyDueDate(s.ctx, celcoin.String("30000001"), celcoin.String("12345678909"), celcoin.String("maria@example.com"))
+	s.Require().NoError(err)
+
+	var logged strings.Builder
+	var requestURL string
+	for _, entry := range hook.AllEntries() {
+		fmt.Fprintf(&logged, "%s %v\n", entry.Message, entry.Data)
+		if request, ok := entry.Data["celcoin_request"].(celcoin.Fields); ok && len(requestURL) == 0 {
+			requestURL, _ = request["url"].(string)
+		}
+	}
+	s.assert.Contains(requestURL, celcoin.PixDictExternalEntryV2Path+"/"+celcoin.RedactedValue+"?")
+	s.assert.Contains(requestURL, "ownerTaxId="+celcoin.RedactedValue)
+	for _, personal := range []string{"30000001", "maria@example.com", "maria%40example.com", "12345678909"} {
+		s.assert.NotContains(logged.String(), personal)
+	}
+}
+
+// TestLogrusLoggerChaining ...
+func (s *LoggerTestSuite) TestLogrusLoggerChaining() {
+	logger, hook := test.NewNullLogger()
+	base := celcoin.NewLogrusLogger(logger)
+
+	base.WithField("tenant", "acme").WithFields(celcoin.Fields{"operation": "Test"}).
+		WithError(errors.New("boom")).Warn("warning")
+	base.Info("without fields")
+
+	s.Require().Len(hook.AllEntries(), 2)
+	first := hook.AllEntries()[0]
+	s.assert.Equal(logrus.WarnLevel, first.Level)
+	s.assert.Equal("acme", first.Data["tenant"])
+	s.assert.Equal("Test", first.Data["operation"])
+	s.assert.EqualError(first.Data[logrus.ErrorKey].(error), "boom")
+	s.assert.NotContains(hook.LastEntry().Data, "tenant")
+}
+
+// TestNopLogger ...
+func (s *LoggerTestSuite) TestNopLogger() {
+	hook := test.NewGlobal()
+	defer logrus.StandardLogger().ReplaceHooks(make(logrus.LevelHooks))
+
+	balance := celcoin.NewBalance(http.DefaultClient, celcoin.Session{
+		APIEndpoint: s.server.URL,
+		Logger:      celcoin.NewNopLogger(),
+	})
+
+	_, err := balance.Balance(s.ctx, "123456")
+	s.Require().NoError(err)
+	s.assert.Empty(hook.AllEntries())
+}
@@ -0,0 +1,250 @@
+package celcoin
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// DefaultLatencyBuckets ... limites, em segundos, dos histogramas de latência
+var DefaultLatencyBuckets = []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30}
+
+const (
+	metricOperationsTotal       = "celcoin_operations_total"
+	metricOperationDuration     = "celcoin_operation_duration_seconds"
+	metricHTTPRequestsTotal     = "celcoin_http_requests_total"
+	metricHTTPRequestDuration   = "celcoin_http_request_duration_seconds"
+	metricOperationsTotalHelp   = "Celcoin operations by service, operation, HTTP status and Contbank error code."
+	metricOperationDurationHelp = "Duration of Celcoin operations, including retries."
+	metricHTTPRequestsTotalHelp = "HTTP requests sent to Celcoin."
+	metricHTTPDurationHelp      = "Duration of each HTTP request sent to Celcoin."
+)
+
+// MetricsCollector ... Observer que mantém em memória contadores e histogramas no formato de exposição do Prometheus.
+// Pode ser servido diretamente como http.Handler (ex.: em /metrics).
+type MetricsCollector struct {
+	buckets    []float64
+	mutex      sync.Mutex
+	counters   map[string]map[string]*counterSeries
+	histograms map[string]map[string]*histogramSeries
+	help       map[string]string
+}
+
+// counterSeries ...
+type counterSeries struct {
+	labels string
+	value  float64
+}
+
+// histogramSeries ...
+type histogramSeries struct {
+	labels string
+	counts []uint64
+	count  uint64
+	sum    float64
+}
+
+// NewMetricsCollector ... buckets vazio usa DefaultLatencyBuckets
+func NewMetricsCollector(buckets []float64) *MetricsCollector {
+	if len(buckets) == 0 {
+		buckets = DefaultLatencyBuckets
+	}
+	sorted := append([]float64{}, buckets...)
+	sort.Float64s(sorted)
+
+	return &MetricsCollector{
+		buckets:    sorted,
+		counters:   make(map[string]map[string]*counterSeries),
+		histograms: make(map[string]map[string]*histogramSeries),
+		help: map[string]string{
+			metricOperationsTotal:     metricOperationsTotalHelp,
+			metricOperationDuration:   metricOperationDurationHelp,
+			metricHTTPRequestsTotal:   metricHTTPRequestsTotalHelp,
+			metricHTTPRequestDuration: metricHTTPDurationHelp,
+		},
+	}
+}
+
+// Start ...
+func (c *MetricsCollector) Start(ctx context.Context, _ *Observation) context.Context {
+	return ctx
+}
+
+// End ...
+func (c *MetricsCollector) End(_ context.Context, observation *Observation) {
+	status := strconv.Itoa(observation.StatusCode)
+	seconds := observation.Duration.Seconds()
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	switch observation.Kind {
+	case ObservationOperation:
+		c.add(metricOperationsTotal, tenantLabels(observation,
+			"service", observation.Service,
+			"operation", observation.Operation,
+			"status_code", status,
+			"error_code", observation.ErrorCode,
+		))
+		c.observe(metricOperationDuration, tenantLabels(observation,
+			"service", observation.Service,
+			"operation", observation.Operation,
+		), seconds)
+	case ObservationHTTP:
+		c.add(metricHTTPRequestsTotal, tenantLabels(observation,
+			"service", observation.Service,
+			"operation", observation.Operation,
+			"method", observation.Method,
+			"endpoint", observation.Endpoint,
+			"status_code", status,
+			"error_code", observation.ErrorCode,
+		))
+		c.observe(metricHTTPRequestDuration, tenantLabels(observation,
+			"method", observation.Method,
+			"endpoint", observation.Endpoint,
+		), seconds)
+	}
+}
+
+// Value ... valor atual de um contador, ou de _count/_sum de um histograma, para os labels informados
+func (c *MetricsCollector) Value(name string, labelValues map[string]string) (float64, bool) {
+	pairs := make([]string, 0, len(labelValues)*2)
+	for key, value := range labelValues {
+		pairs = append(pairs, key, value)
+	}
+	key := labels(pairs...)
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if series, found := c.counters[name][key]; found {
+		return series.value, true
+	}
+	for _, suffix := range []string{"_count", "_sum"} {
+		if !strings.HasSuffix(name, suffix) {
+			continue
+		}
+		series, found := c.histograms[strings.TrimSuffix(name, suffix)][key]
+		if !found {
+			return 0, false
+		}
+		if suffix == "_count" {
+			return float64(series.count), true
+		}
+		return series.sum, true
+	}
+	return 0, false
+}
+
+// WritePrometheus ... escreve as métricas no formato de texto do Prometheus
+func (c *MetricsCollector) WritePrometheus(w io.Writer) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	buffer := bufio.NewWriter(w)
+	for _, name := range sortedKeys(c.counters) {
+		fmt.Fprintf(buffer, "# HELP %s %s\n# TYPE %s counter\n", name, c.help[name], name)
+		for _, key := range sortedKeys(c.counters[name]) {
+			series := c.counters[name][key]
+			fmt.Fprintf(buffer, "%s{%s} %s\n", name, series.labels, formatFloat(series.value))
+		}
+	}
+	for _, name := range sortedKeys(c.histograms) {
+		fmt.Fprintf(buffer, "# HELP %s %s\n# TYPE %s histogram\n", name, c.help[name], name)
+		for _, key := range sortedKeys(c.histograms[name]) {
+			series := c.histograms[name][key]
+			var cumulative uint64
+			for i, bound := range c.buckets {
+				cumulative += series.counts[i]
+				fmt.Fprintf(buffer, "%s_bucket{%s,le=\"%s\"} %d\n", name, series.labels, formatFloat(bound), cumulative)
+			}
+			fmt.Fprintf(buffer, "%s_bucket{%s,le=\"+Inf\"} %d\n", name, series.labels, series.count)
+			fmt.Fprintf(buffer, "%s_sum{%s} %s\n", name, series.labels, formatFloat(series.sum))
+			fmt.Fprintf(buffer, "%s_count{%s} %d\n", name, series.labels, series.count)
+		}
+	}
+	return buffer.Flush()
+}
+
+// ServeHTTP ... expõe as métricas para o scrape do Prometheus
+func (c *MetricsCollector) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	c.WritePrometheus(w)
+}
+
+// add ...
+func (c *MetricsCollector) add(name, key string) {
+	if c.counters[name] == nil {
+		c.counters[name] = make(map[string]*counterSeries)
+	}
+	series, found := c.counters[name][key]
+	if !found {
+		series = &counterSeries{labels: key}
+		c.counters[name][key] = series
+	}
+	series.value++
+}
+
+// observe ...
+func (c *MetricsCollector) observe(name, key string, value float64) {
+	if c.histograms[name] == nil {
+		c.histograms[name] = make(map[string]*histogramSeries)
+	}
+	series, found := c.histograms[name][key]
+	if !found {
+		series = &histogramSeries{labels: key, counts: make([]uint64, len(c.buckets))}
+		c.histograms[name][key] = series
+	}
+	for i, bound := range c.buckets {
+		if value <= bound {
+			series.counts[i]++
+			break
+		}
+	}
+	series.count++
+	series.sum += value
+}
+
+// labels ... labels ordenados pelo nome no formato name="value"
+func labels(pairs ...string) string {
+	values := make([]string, 0, len(pairs)/2)
+	for i := 0; i+1 < len(pairs); i += 2 {
+		values = append(values, fmt.Sprintf("%s=\"%s\"", pairs[i], escapeLabel(pairs[i+1])))
+	}
+	sort.Strings(values)
+	return strings.Join(values, ",")
+}
+
+// tenantLabels ... inclui o label tenant nas observações de sessões multi-tenant
+func tenantLabels(observation *Observation, pairs ...string) string {
+	if len(observation.Tenant) > 0 {
+		pairs = append(pairs, "tenant", observation.Tenant)
+	}
+	return labels(pairs...)
+}
+
+// escapeLabel ...
+func escapeLabel(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(value)
+}
+
+// formatFloat ...
+func formatFloat(value float64) string {
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
+
+// sortedKeys ...
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
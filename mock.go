@@ -3,126 +3,423 @@ package celcoin
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"math/rand"
 	"net/http"
 	"net/http/httptest"
-)
+	"net/url"
+	"strings"
+	"sync"
+	"time"
 
-// NewMockServer cria um servidor HTTP com rotas configuradas.
-func NewMockServer() *httptest.Server {
-	handler := http.NewServeMux()
+	"github.com/google/uuid"
+)
 
-	// Configurar rotas (pode ser modularizado por funcionalidade)
-	RegisterWebhookRoutes(handler)
-	RegisterAuthRoutes(handler) // Adicionando mock de login
+const (
+	// MockClientID ... client_id aceito pelo MockServer
+	MockClientID = "mock-client-id"
+	// MockClientSecret ... client_secret aceito pelo MockServer
+	MockClientSecret = "mock-client-secret"
+	// MockDefaultBranch ... agência das contas criadas no MockServer
+	MockDefaultBranch = "0001"
+	// mockVersion ... versão informada nas respostas do MockServer
+	mockVersion = "1.0.0"
+	// mockTokenTTL ... validade dos tokens emitidos pelo MockServer
+	mockTokenTTL = time.Hour
+	// mockWebhookTimeout ... tempo máximo de cada entrega de webhook
+	mockWebhookTimeout = 5 * time.Second
+)
 
-	// Retorna o servidor configurado
-	return httptest.NewServer(handler)
+// MockServer ... Celcoin falsa em memória, construída sobre httptest, que atende todos os endpoints do SDK.
+// O estado é consistente entre as rotas: um Pix cash-out debita o saldo, aparece no extrato e gera o webhook
+// pix-payment-out para as assinaturas ativas. Use Config para criar um Client apontando para o servidor.
+type MockServer struct {
+	*httptest.Server
+	mutex  sync.Mutex
+	routes []mockRoute
+	state  *mockState
+	tokens map[string]time.Time
+	// outbox ... webhooks gerados pela chamada atual, entregues após liberar o mutex
+	outbox     []mockDelivery
+	deliveries sync.WaitGroup
+	webhooks   *http.Client
+	now        func() time.Time
+	// faults ... regras de falha, avaliadas na ordem de registro
+	faults   []*MockFaultRule
+	random   *rand.Rand
+	requests []*MockRecordedRequest
+	// settlementDelay ... atraso entre PROCESSING e CONFIRMED; timers liquidam as operações pendentes
+	settlementDelay time.Duration
+	timers          []*time.Timer
+	closing         chan struct{}
+	closed          bool
 }
 
-// RegisterWebhookRoutes registra as rotas relacionadas a Webhooks no handler.
-func RegisterWebhookRoutes(handler *http.ServeMux) {
-	handler.HandleFunc("/baas-webhookmanager/v1/webhook/subscription", handleCreateSubscription)
-	handler.HandleFunc("/dda-servicewebhook-webservice/v1/webhook/register", handleCreateSubscriptionDda)
+// NewMockServer ... inicia um MockServer sem contas; use AddAccount e AddPixKey para preparar o cenário
+func NewMockServer() *MockServer {
+	m := newMockServer()
+	m.Server = httptest.NewServer(m)
+	return m
 }
 
-// RegisterAuthRoutes registra as rotas relacionadas a autenticação no handler.
-func RegisterAuthRoutes(handler *http.ServeMux) {
-	handler.HandleFunc("/auth/login", handleLogin) // Mockando a rota de login
+// newMockServer ...
+func newMockServer() *MockServer {
+	m := &MockServer{
+		state:    newMockState(),
+		tokens:   make(map[string]time.Time),
+		webhooks: &http.Client{Timeout: mockWebhookTimeout},
+		now:      time.Now,
+		random:   rand.New(rand.NewSource(time.Now().UnixNano())),
+		closing:  make(chan struct{}),
+	}
+	m.registerRoutes()
+	return m
 }
 
-// handleLogin simula o endpoint de login da API.
-func handleLogin(w http.ResponseWriter, r *http.Request) {
-	// Validar método HTTP
-	if r.Method != http.MethodPost {
-		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
-		return
+// Config ... Config que aponta o SDK para o MockServer com as credenciais aceitas por ele
+func (m *MockServer) Config() Config {
+	return Config{
+		APIEndpoint:   String(m.URL),
+		LoginEndpoint: String(m.URL),
+		ClientID:      String(MockClientID),
+		ClientSecret:  String(MockClientSecret),
+		Logger:        NewNopLogger(),
 	}
+}
 
-	// Validar headers
-	if r.Header.Get("Content-Type") != "application/json" {
-		http.Error(w, "Unsupported Media Type", http.StatusUnsupportedMediaType)
+// Close ... encerra o servidor, libera as requisições retidas por falhas e aguarda as entregas de webhook
+// em andamento. As liquidações pendentes são descartadas.
+func (m *MockServer) Close() {
+	m.mutex.Lock()
+	if m.closed {
+		m.mutex.Unlock()
 		return
 	}
+	m.closed = true
+	close(m.closing)
+	for _, timer := range m.timers {
+		timer.Stop()
+	}
+	m.mutex.Unlock()
+
+	m.Server.Close()
+	m.deliveries.Wait()
+}
+
+// registerRoutes ...
+func (m *MockServer) registerRoutes() {
+	m.handle(http.MethodPost, "/"+LoginPath, m.handleToken).public = true
 
-	// Simulando resposta de login bem-sucedido com token
-	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(map[string]string{
-		"token": "Bearer fake-api-key", // Mock de um token
+	m.registerAccountRoutes()
+	m.registerPixRoutes()
+	m.registerPaymentRoutes()
+	m.registerWebhookRoutes()
+	m.registerAdminRoutes()
+}
+
+// mockHandler ... executado com o mutex do MockServer
+type mockHandler func(r *mockRequest) mockResponse
+
+// mockRoute ... rota com segmentos literais e parâmetros no formato {nome}
+type mockRoute struct {
+	method   string
+	segments []string
+	handler  mockHandler
+	// public ... não exige o token Bearer
+	public bool
+}
+
+// handle ...
+func (m *MockServer) handle(method, pattern string, handler mockHandler) *mockRoute {
+	m.routes = append(m.routes, mockRoute{
+		method:   method,
+		segments: splitMockPath(pattern),
+		handler:  handler,
 	})
+	return &m.routes[len(m.routes)-1]
 }
 
-// handleCreateSubscription simula o endpoint de criação de Webhook Subscription.
-func handleCreateSubscription(w http.ResponseWriter, r *http.Request) {
-	// Validar método HTTP
-	if r.Method != http.MethodPost {
-		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
-		return
+// match ... rota do método e caminho, com os parâmetros do caminho
+func (m *MockServer) match(method, path string) (*mockRoute, map[string]string, bool) {
+	segments := splitMockPath(path)
+	pathFound := false
+	for i := range m.routes {
+		route := &m.routes[i]
+		params, ok := route.match(segments)
+		if !ok {
+			continue
+		}
+		pathFound = true
+		if route.method == method {
+			return route, params, true
+		}
 	}
+	return nil, nil, pathFound
+}
 
-	// Validar headers
-	if r.Header.Get("Content-Type") != "application/json" {
-		http.Error(w, "Unsupported Media Type", http.StatusUnsupportedMediaType)
-		return
+// match ...
+func (r *mockRoute) match(segments []string) (map[string]string, bool) {
+	if len(segments) != len(r.segments) {
+		return nil, false
 	}
+	params := make(map[string]string)
+	for i, segment := range r.segments {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			params[strings.Trim(segment, "{}")] = segments[i]
+			continue
+		}
+		if !strings.EqualFold(segment, segments[i]) {
+			return nil, false
+		}
+	}
+	return params, true
+}
 
-	// Validar payload
-	var req WebhookSubscriptionRequest
-	err := json.NewDecoder(r.Body).Decode(&req)
+// splitMockPath ...
+func splitMockPath(path string) []string {
+	path = strings.Trim(path, "/")
+	if len(path) == 0 {
+		return nil
+	}
+	return strings.Split(path, "/")
+}
+
+// mockRequest ... requisição recebida, com o corpo já lido e os parâmetros do caminho
+type mockRequest struct {
+	*http.Request
+	params map[string]string
+	body   []byte
+}
+
+// param ...
+func (r *mockRequest) param(name string) string {
+	return r.params[name]
+}
+
+// query ... parâmetro de query, sem diferenciar maiúsculas de minúsculas no nome
+func (r *mockRequest) query(name string) string {
+	values := r.URL.Query()
+	if value := values.Get(name); len(value) > 0 {
+		return value
+	}
+	for key := range values {
+		if strings.EqualFold(key, name) {
+			return values.Get(key)
+		}
+	}
+	return ""
+}
+
+// decode ...
+func (r *mockRequest) decode(v interface{}) error {
+	return json.Unmarshal(r.body, v)
+}
+
+// mockResponse ... body é serializado como JSON, exceto []byte, enviado como está
+type mockResponse struct {
+	status      int
+	body        interface{}
+	contentType string
+	header      http.Header
+}
+
+// mockJSON ...
+func mockJSON(status int, body interface{}) mockResponse {
+	return mockResponse{status: status, body: body}
+}
+
+// ServeHTTP ... registra a requisição e aplica a primeira MockFaultRule acionada antes ou depois da rota
+func (m *MockServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(r.Body)
 	if err != nil {
-		http.Error(w, "Bad Request", http.StatusBadRequest)
+		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
 
-	// Simular resposta de sucesso
-	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(WebhookSubscriptionResponse{
-		Version: "1.0.0",
-		Status:  "SUCCESS",
+	recorded := m.record(MockRecordedRequest{
+		Method: r.Method,
+		Path:   r.URL.Path,
+		Query:  r.URL.Query(),
+		Header: r.Header.Clone(),
+		Body:   body,
+		Time:   m.now(),
 	})
-}
 
-// handleCreateSubscriptionDda simula o endpoint de criação de Webhook Subscription DDA.
-func handleCreateSubscriptionDda(w http.ResponseWriter, r *http.Request) {
-	// Validar método HTTP
-	if r.Method != http.MethodPost {
-		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+	fault := m.fault(recorded)
+	if fault != nil && fault.Delay > 0 && !m.wait(r.Context(), fault.Delay) {
 		return
 	}
 
-	// Validar headers
-	if r.Header.Get("Content-Type") != "application/json" {
-		http.Error(w, "Unsupported Media Type", http.StatusUnsupportedMediaType)
-		return
+	var response mockResponse
+	if fault == nil || fault.Apply || !fault.replaces() {
+		response = m.serve(r, body)
+	}
+	if fault != nil && fault.replaces() {
+		if fault.Hang {
+			// A resposta só é escrita se o cliente não desistir dentro de mockHangLimit
+			if !m.wait(r.Context(), mockHangLimit) {
+				return
+			}
+			response = mockResponse{status: http.StatusGatewayTimeout}
+		} else {
+			response = fault.response()
+		}
 	}
 
-	// Validar payload
-	var req WebhookSubscriptionRequest
-	err := json.NewDecoder(r.Body).Decode(&req)
-	if err != nil {
-		http.Error(w, "Bad Request", http.StatusBadRequest)
-		return
+	m.respond(recorded, response.status)
+	writeMockResponse(w, response)
+}
+
+// serve ... executa a rota com o mutex e entrega os webhooks gerados
+func (m *MockServer) serve(r *http.Request, body []byte) mockResponse {
+	route, params, pathFound := m.match(r.Method, r.URL.Path)
+	if route == nil {
+		if pathFound {
+			return mockResponse{status: http.StatusMethodNotAllowed, body: []byte("method not allowed\n"),
+				contentType: "text/plain; charset=utf-8"}
+		}
+		return mockResponse{status: http.StatusNotFound, body: []byte("404 page not found\n"),
+			contentType: "text/plain; charset=utf-8"}
+	}
+
+	m.mutex.Lock()
+	var response mockResponse
+	if !route.public && !m.authorized(r) {
+		response = mockJSON(http.StatusUnauthorized, map[string]string{"error": "invalid_token"})
+	} else {
+		response = route.handler(&mockRequest{Request: r, params: params, body: body})
+	}
+	outbox := m.outbox
+	m.outbox = nil
+	m.mutex.Unlock()
+
+	m.deliver(outbox)
+	return response
+}
+
+// writeMockResponse ...
+func writeMockResponse(w http.ResponseWriter, response mockResponse) {
+	var data []byte
+	contentType := response.contentType
+	switch body := response.body.(type) {
+	case nil:
+	case []byte:
+		data = body
+	default:
+		data, _ = json.Marshal(body)
+		if len(contentType) == 0 {
+			contentType = "application/json"
+		}
+	}
+	for key, values := range response.header {
+		for _, value := range values {
+			w.Header().Add(key, value)
+		}
+	}
+	if len(contentType) > 0 {
+		w.Header().Set("Content-Type", contentType)
+	}
+	w.WriteHeader(response.status)
+	w.Write(data)
+}
+
+// handleToken ... emite tokens para client_credentials com MockClientID e MockClientSecret
+func (m *MockServer) handleToken(r *mockRequest) mockResponse {
+	if !strings.HasPrefix(r.Header.Get("Content-Type"), "application/x-www-form-urlencoded") {
+		return mockJSON(http.StatusUnsupportedMediaType, map[string]string{"error": "unsupported_media_type"})
 	}
 
-	// Simular resposta de sucesso
-	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(WebhookSubscriptionResponse{
-		Version: "1.0.0",
-		Status:  "SUCCESS",
+	form, err := url.ParseQuery(string(r.body))
+	if err != nil || form.Get("grant_type") != "client_credentials" {
+		return mockJSON(http.StatusBadRequest, map[string]string{"error": "unsupported_grant_type"})
+	}
+	if form.Get("client_id") != MockClientID || form.Get("client_secret") != MockClientSecret {
+		return mockJSON(http.StatusBadRequest, map[string]string{"error": "invalid_client"})
+	}
+
+	token := fmt.Sprintf("mock-token-%s", uuid.New().String())
+	m.tokens[token] = m.now().Add(mockTokenTTL)
+	return mockJSON(http.StatusOK, AuthenticationResponse{
+		AccessToken: token,
+		ExpiresIn:   int(mockTokenTTL.Seconds()),
+		TokenType:   "bearer",
 	})
 }
 
+// authorized ... o token Bearer foi emitido pelo MockServer e não expirou
+func (m *MockServer) authorized(r *http.Request) bool {
+	token := strings.TrimSpace(strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer"))
+	expiresAt, found := m.tokens[token]
+	return found && m.now().Before(expiresAt)
+}
+
+// mockError ... erro no envelope {"error": {"errorCode", "message"}} com a mensagem do catálogo
+func mockError(status int, domain ErrorDomain, code string) mockResponse {
+	return mockJSON(status, ErrorDefaultResponse{
+		Status:  String(fmt.Sprintf("%d", status)),
+		Version: String(mockVersion),
+		Error:   &ErrorDefault{ErrorCode: String(code), Message: String(mockErrorMessage(domain, code))},
+	})
+}
+
+// mockErroError ... erro no envelope {"erro": {...}} usado pelo DDA
+func mockErroError(status int, domain ErrorDomain, code string) mockResponse {
+	return mockJSON(status, ErroDefaultResponse{
+		Status:  &status,
+		Version: String(mockVersion),
+		Error:   &ErrorDefault{ErrorCode: String(code), Message: String(mockErrorMessage(domain, code))},
+	})
+}
+
+// mockErrorList ... erro no formato {"errors": [{"code", "messages"}]}
+func mockErrorList(status int, code, message string) mockResponse {
+	return mockJSON(status, ErrorResponse{
+		Errors: []ErrorModel{{Code: code, Messages: []string{message}}},
+		Status: FlexibleInt32(status),
+	})
+}
+
+// mockErrorMessage ... mensagem em português do catálogo de erros, ou o próprio código quando não catalogado
+func mockErrorMessage(domain ErrorDomain, code string) string {
+	if entry, found := DefaultErrorCatalog().Lookup(domain, code); found {
+		return entry.Message(LanguagePT)
+	}
+	return code
+}
+
+// mockSuccess ... resposta {"version", "status"} sem corpo
+func mockSuccess(status int) mockResponse {
+	return mockJSON(status, map[string]string{"version": mockVersion, "status": "SUCCESS"})
+}
+
+// roundCents ...
+func roundCents(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
+
+// formatMockAmount ...
+func formatMockAmount(amount float64) string {
+	return fmt.Sprintf("%.2f", amount)
+}
+
+// MockAuthentication ...
 type MockAuthentication struct {
 	TokenFunc func(ctx context.Context) (string, error)
 }
 
+// Token ...
 func (m *MockAuthentication) Token(ctx context.Context) (string, error) {
 	return m.TokenFunc(ctx)
 }
 
+// MockRoundTripper ...
 type MockRoundTripper struct {
 	RoundTripFunc func(req *http.Request) (*http.Response, error)
 }
 
+// RoundTrip ...
 func (m *MockRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
 	return m.RoundTripFunc(req)
 }
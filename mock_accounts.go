@@ -0,0 +1,398 @@
+package celcoin
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/contbank/grok"
+	"github.com/google/uuid"
+)
+
+// registerAccountRoutes ... saldo, extrato, informe de rendimentos, contas e onboarding
+func (m *MockServer) registerAccountRoutes() {
+	m.handle(http.MethodGet, BalancePath, m.handleBalance)
+	m.handle(http.MethodGet, StatementPath, m.handleStatement)
+	m.handle(http.MethodGet, IncomeReportPath, m.handleIncomeReport)
+
+	m.handle(http.MethodGet, CustomersPath, m.handleFindCustomer)
+	m.handle(http.MethodGet, BusinessPath, m.handleFindBusiness)
+	m.handle(http.MethodPut, UpdateAccountStatusPath, m.handleUpdateAccountStatus)
+	m.handle(http.MethodDelete, CancelAccountPath, m.handleCancelAccount)
+
+	m.handle(http.MethodPost, NaturalPersonOnboardingPath, m.handleNaturalPersonOnboarding)
+	m.handle(http.MethodPost, LegalPersonOnboardingPath, m.handleLegalPersonOnboarding)
+	m.handle(http.MethodGet, ProposalsPath, m.handleGetProposal)
+	m.handle(http.MethodGet, ProposalFilesPath, m.handleGetProposalFiles)
+}
+
+// handleBalance ...
+func (m *MockServer) handleBalance(r *mockRequest) mockResponse {
+	account, found := m.state.Accounts[r.query("account")]
+	if !found {
+		return mockError(http.StatusBadRequest, ErrorDomainStatement, "CBE039")
+	}
+
+	response := BalanceResponse{Status: "SUCCESS", Version: mockVersion}
+	response.Body.Amount = account.Balance
+	return mockJSON(http.StatusOK, response)
+}
+
+// handleStatement ... movimentos da conta no período, paginados por LimitPerPage e Page
+func (m *MockServer) handleStatement(r *mockRequest) mockResponse {
+	dateFrom, dateTo := r.query("DateFrom"), r.query("DateTo")
+	if len(dateFrom) == 0 || len(dateTo) == 0 {
+		return mockError(http.StatusBadRequest, ErrorDomainStatement, "CBE153")
+	}
+	account := m.findAccount(r.query("Account"), r.query("DocumentNumber"))
+	if account == nil {
+		return mockError(http.StatusBadRequest, ErrorDomainStatement, "CBE039")
+	}
+
+	limit := mockIntQuery(r, "LimitPerPage", 50)
+	page := mockIntQuery(r, "Page", 1)
+	if limit < 1 || limit > 200 {
+		return mockError(http.StatusBadRequest, ErrorDomainStatement, "CBE066")
+	}
+	if page < 1 {
+		return mockError(http.StatusBadRequest, ErrorDomainStatement, "CBE080")
+	}
+
+	movements := []StatementMovement{}
+	for _, movement := range m.state.Movements[account.Account] {
+		if inMockRange(movement.Date, dateFrom, dateTo) {
+			movements = append(movements, movement.StatementMovement)
+		}
+	}
+
+	total := len(movements)
+	start := (page - 1) * limit
+	if start > total {
+		start = total
+	}
+	end := start + limit
+	if end > total {
+		end = total
+	}
+
+	return mockJSON(http.StatusOK, StatementResponse{
+		Status:       "SUCCESS",
+		Version:      mockVersion,
+		TotalItems:   total,
+		CurrentPage:  page,
+		LimitPerPage: limit,
+		TotalPages:   (total + limit - 1) / limit,
+		DateFrom:     dateFrom,
+		DateTo:       dateTo,
+		Body: StatementBody{
+			Account:        account.Account,
+			DocumentNumber: account.DocumentNumber,
+			Movements:      movements[start:end],
+		},
+	})
+}
+
+// mockIntQuery ...
+func mockIntQuery(r *mockRequest, name string, defaultValue int) int {
+	value, err := strconv.Atoi(r.query(name))
+	if err != nil {
+		return defaultValue
+	}
+	return value
+}
+
+// handleIncomeReport ... saldo da conta no fim do ano-calendário, calculado pelos movimentos do extrato
+func (m *MockServer) handleIncomeReport(r *mockRequest) mockResponse {
+	calendarYear := r.query("calendarYear")
+	if len(calendarYear) == 0 {
+		return mockError(http.StatusBadRequest, ErrorDomainIncomeReport, "CBE445")
+	}
+	number := r.query("account")
+	if len(number) == 0 {
+		return mockError(http.StatusBadRequest, ErrorDomainIncomeReport, "CBE091")
+	}
+	account, found := m.state.Accounts[number]
+	year, err := strconv.Atoi(calendarYear)
+	if !found || err != nil {
+		return mockError(http.StatusBadRequest, ErrorDomainIncomeReport, "CBE078")
+	}
+
+	yearEnd := time.Date(year+1, time.January, 1, 0, 0, 0, 0, time.Local)
+	balance := 0.0
+	for _, movement := range m.state.Movements[account.Account] {
+		if !movement.Date.Before(yearEnd) {
+			continue
+		}
+		if movement.BalanceType == "DEBIT" {
+			balance -= movement.Amount
+		} else {
+			balance += movement.Amount
+		}
+	}
+
+	ownerType := "PF"
+	if account.legalPerson() {
+		ownerType = "PJ"
+	}
+	return mockJSON(http.StatusOK, IncomeReportResponse{
+		Version: mockVersion,
+		Status:  "SUCCESS",
+		Body: IncomeReportBody{
+			PayerSource: IncomeReportPayerSource{Name: CelcoinBankName, DocumentNumber: "13935893000109"},
+			Owner: IncomeReportOwner{
+				DocumentNumber: account.DocumentNumber,
+				Name:           account.Name,
+				Type:           ownerType,
+				CreateDate:     account.CreateDate.Format(mockDateTimeLayout),
+			},
+			Account: IncomeReportAccount{Branch: account.Branch, Account: account.Account},
+			Balances: []IncomeReportBalance{{
+				CalendarYear: calendarYear,
+				Amount:       roundCents(balance),
+				Currency:     "BRL",
+				Type:         "SALDO",
+			}},
+			FileType: "pdf",
+		},
+	})
+}
+
+// handleFindCustomer ...
+func (m *MockServer) handleFindCustomer(r *mockRequest) mockResponse {
+	account := m.findAccount(r.query("account"), r.query("documentNumber"))
+	if account == nil || account.legalPerson() {
+		return mockErrorList(http.StatusNotFound, "CBE078", mockErrorMessage(ErrorDomainCancelAccount, "CBE078"))
+	}
+
+	return mockJSON(http.StatusOK, CustomerResponse{
+		Version: mockVersion,
+		Status:  "SUCCESS",
+		Body: CustomerResponseBody{
+			StatusAccount:  account.Status,
+			DocumentNumber: account.DocumentNumber,
+			PhoneNumber:    account.PhoneNumber,
+			Email:          account.Email,
+			ClientCode:     account.ClientCode,
+			FullName:       account.Name,
+			Account:        Account{Branch: account.Branch, Account: account.Account},
+			CreateDate:     CustomTime{Time: account.CreateDate},
+		},
+	})
+}
+
+// handleFindBusiness ...
+func (m *MockServer) handleFindBusiness(r *mockRequest) mockResponse {
+	account := m.findAccount(r.query("account"), r.query("documentNumber"))
+	if account == nil || !account.legalPerson() {
+		return mockErrorList(http.StatusNotFound, "CBE078", mockErrorMessage(ErrorDomainCancelAccount, "CBE078"))
+	}
+
+	return mockJSON(http.StatusOK, BusinessResponse{
+		Version: mockVersion,
+		Status:  "SUCCESS",
+		Body: BusinessResponseBody{
+			StatusAccount:       account.Status,
+			DocumentNumber:      account.DocumentNumber,
+			ClientCode:          account.ClientCode,
+			BusinessPhoneNumber: account.PhoneNumber,
+			BusinessEmail:       account.Email,
+			CreateDate:          CustomTime{Time: account.CreateDate},
+			BusinessName:        account.Name,
+			TradingName:         account.Name,
+			BusinessAccount:     BusinessAccount{Branch: account.Branch, Account: account.Account},
+		},
+	})
+}
+
+// mockAccountStatus ... status da conta a partir dos valores aceitos pela Celcoin em português ou inglês
+func mockAccountStatus(status string) string {
+	switch strings.ToUpper(strings.TrimSpace(status)) {
+	case "ATIVO", MockAccountActive:
+		return MockAccountActive
+	case "BLOQUEADO", MockAccountBlocked:
+		return MockAccountBlocked
+	case "ENCERRADO", MockAccountClosed:
+		return MockAccountClosed
+	}
+	return ""
+}
+
+// handleUpdateAccountStatus ...
+func (m *MockServer) handleUpdateAccountStatus(r *mockRequest) mockResponse {
+	var request struct {
+		Status string `json:"status"`
+		Reason string `json:"reason"`
+	}
+	if err := r.decode(&request); err != nil {
+		return mockError(http.StatusBadRequest, ErrorDomainUpdateAccountStatus, "CBE039")
+	}
+
+	account := m.findAccount(r.query("account"), r.query("documentNumber"))
+	switch {
+	case len(r.query("account")) == 0 && len(r.query("documentNumber")) == 0:
+		return mockError(http.StatusBadRequest, ErrorDomainUpdateAccountStatus, "CBE073")
+	case account == nil:
+		return mockError(http.StatusBadRequest, ErrorDomainUpdateAccountStatus, "CBE078")
+	case account.Status == MockAccountClosed:
+		return mockError(http.StatusBadRequest, ErrorDomainUpdateAccountStatus, "CBE075")
+	case len(strings.TrimSpace(request.Reason)) == 0:
+		return mockError(http.StatusBadRequest, ErrorDomainUpdateAccountStatus, "CBE074")
+	}
+
+	status := mockAccountStatus(request.Status)
+	if len(status) == 0 || status == MockAccountClosed {
+		return mockError(http.StatusBadRequest, ErrorDomainUpdateAccountStatus, "CBE039")
+	}
+	account.Status = status
+	return mockSuccess(http.StatusOK)
+}
+
+// handleCancelAccount ... encerra contas sem saldo e sem chaves Pix
+func (m *MockServer) handleCancelAccount(r *mockRequest) mockResponse {
+	account := m.findAccount(r.query("account"), r.query("documentNumber"))
+	switch {
+	case len(r.query("account")) == 0 && len(r.query("documentNumber")) == 0:
+		return mockError(http.StatusBadRequest, ErrorDomainCancelAccount, "CBE073")
+	case len(strings.TrimSpace(r.query("reason"))) == 0:
+		return mockError(http.StatusBadRequest, ErrorDomainCancelAccount, "CBE074")
+	case account == nil:
+		return mockError(http.StatusBadRequest, ErrorDomainCancelAccount, "CBE078")
+	case account.Status == MockAccountClosed:
+		return mockError(http.StatusBadRequest, ErrorDomainCancelAccount, "CBE075")
+	case account.Balance > 0:
+		return mockError(http.StatusBadRequest, ErrorDomainCancelAccount, "CBE062")
+	case len(m.accountKeys(account.Account)) > 0:
+		return mockError(http.StatusBadRequest, ErrorDomainCancelAccount, "CBE281")
+	}
+
+	account.Status = MockAccountClosed
+	return mockSuccess(http.StatusOK)
+}
+
+// mockOnboarding ... dados comuns às propostas de pessoa física e jurídica
+type mockOnboarding struct {
+	ClientCode     string `json:"clientCode"`
+	DocumentNumber string `json:"documentNumber"`
+	Email          string `json:"email"`
+	BusinessEmail  string `json:"businessEmail"`
+	PhoneNumber    string `json:"phoneNumber"`
+	ContactNumber  string `json:"contactNumber"`
+	FullName       string `json:"fullName"`
+	BusinessName   string `json:"businessName"`
+}
+
+// handleNaturalPersonOnboarding ...
+func (m *MockServer) handleNaturalPersonOnboarding(r *mockRequest) mockResponse {
+	return m.onboard(r, ProposalTypeNaturalPerson)
+}
+
+// handleLegalPersonOnboarding ...
+func (m *MockServer) handleLegalPersonOnboarding(r *mockRequest) mockResponse {
+	return m.onboard(r, ProposalTypeLegalPerson)
+}
+
+// onboard ... a proposta é aprovada imediatamente e a conta criada ativa e sem saldo
+func (m *MockServer) onboard(r *mockRequest, proposalType string) mockResponse {
+	var request mockOnboarding
+	if err := r.decode(&request); err != nil {
+		return mockError(http.StatusBadRequest, ErrorDomainOnboarding, "OBE034")
+	}
+
+	document := grok.OnlyDigits(request.DocumentNumber)
+	switch {
+	case len(request.ClientCode) == 0:
+		return mockError(http.StatusBadRequest, ErrorDomainOnboarding, "OBE007")
+	case proposalType == ProposalTypeNaturalPerson && len(document) != 11:
+		return mockError(http.StatusBadRequest, ErrorDomainOnboarding, "OBE008")
+	case proposalType == ProposalTypeLegalPerson && len(document) != 14:
+		return mockError(http.StatusBadRequest, ErrorDomainOnboarding, "OBE009")
+	}
+	for _, proposal := range m.state.Proposals {
+		if proposal.ClientCode == request.ClientCode {
+			return mockError(http.StatusBadRequest, ErrorDomainOnboarding, "OBE062")
+		}
+	}
+	if existing := m.accountByDocument(document); existing != nil && existing.Status != MockAccountClosed {
+		return mockError(http.StatusBadRequest, ErrorDomainOnboarding, "OBE064")
+	}
+
+	name, email, phone := request.FullName, request.Email, request.PhoneNumber
+	if proposalType == ProposalTypeLegalPerson {
+		name, email, phone = request.BusinessName, request.BusinessEmail, request.ContactNumber
+	}
+	m.addAccount(MockAccount{
+		DocumentNumber: document,
+		Name:           name,
+		ClientCode:     request.ClientCode,
+		Email:          email,
+		PhoneNumber:    phone,
+	})
+
+	now := m.now().Format(mockDateTimeLayout)
+	proposal := &Proposal{
+		ProposalID:     uuid.New().String(),
+		ClientCode:     request.ClientCode,
+		DocumentNumber: document,
+		Status:         OnboardingStatusApproved,
+		ProposalType:   proposalType,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+	m.state.Proposals[proposal.ProposalID] = proposal
+
+	return mockJSON(http.StatusOK, CustomerOnboardingResponse{
+		Version: mockVersion,
+		Status:  OnboardingStatusProcessing,
+		Body: CustomerOnboardingResponseBody{
+			ProposalID:     proposal.ProposalID,
+			ClientCode:     proposal.ClientCode,
+			DocumentNumber: proposal.DocumentNumber,
+		},
+	})
+}
+
+// handleGetProposal ...
+func (m *MockServer) handleGetProposal(r *mockRequest) mockResponse {
+	proposal, found := m.state.Proposals[r.query("proposalId")]
+	if !found {
+		return mockError(http.StatusBadRequest, ErrorDomainOnboarding, "OBE049")
+	}
+
+	return mockJSON(http.StatusOK, OnboardingProposalResponse{
+		Version: mockVersion,
+		Status:  "SUCCESS",
+		Body: OnboardingProposalResponseBody{
+			Limit:        1,
+			CurrentPage:  1,
+			LimitPerPage: 1,
+			TotalPages:   1,
+			TotalItems:   1,
+			Proposals:    []Proposal{*proposal},
+		},
+	})
+}
+
+// handleGetProposalFiles ... um documento fictício por proposta, com URL servida pelo próprio MockServer
+func (m *MockServer) handleGetProposalFiles(r *mockRequest) mockResponse {
+	proposal, found := m.state.Proposals[r.query("proposalId")]
+	if !found {
+		return mockError(http.StatusBadRequest, ErrorDomainOnboarding, "OBE055")
+	}
+
+	return mockJSON(http.StatusOK, map[string]interface{}{
+		"version": mockVersion,
+		"status":  "SUCCESS",
+		"body": map[string]interface{}{
+			"files": []map[string]string{{
+				"type":           "SELFIE",
+				"url":            fmt.Sprintf("http://%s/files/%s/selfie.jpg", r.Host, proposal.ProposalID),
+				"expirationTime": m.now().Add(time.Hour).Format(time.RFC3339),
+			}},
+			"clientCode":     proposal.ClientCode,
+			"documentNumber": proposal.DocumentNumber,
+			"proposalId":     proposal.ProposalID,
+		},
+	})
+}
@@ -0,0 +1,256 @@
+package celcoin
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"time"
+)
+
+// MockAdminPath ... prefixo das rotas administrativas do MockServer. As rotas não exigem token:
+//
+//	GET  /_mock/state                                  estado completo, no formato de SaveState
+//	POST /_mock/accounts                               cadastra uma MockAccount
+//	POST /_mock/pix-keys                               cadastra uma MockPixKey
+//	POST /_mock/cash-in                                Pix recebido de outra instituição (MockCashIn)
+//	POST /_mock/pix-charges/{transactionIdentification}/pay   pagamento de uma cobrança Pix
+//	POST /_mock/settlements                            liquida as operações PROCESSING pendentes
+//	PUT  /_mock/settlement-delay                       atraso da liquidação (MockSettlementDelay)
+//	POST /_mock/webhooks                               gera um webhook para as assinaturas da entidade (MockWebhookTrigger)
+const MockAdminPath = "/_mock"
+
+// ErrMockInvalidSeed ... massa de dados inconsistente
+var ErrMockInvalidSeed = errors.New("invalid mock seed")
+
+// MockSeed ... massa de dados inicial do MockServer. As chaves Pix referenciam contas pelo número, então contas
+// com chaves precisam informar Account.
+type MockSeed struct {
+	Accounts []MockAccount                `json:"accounts"`
+	PixKeys  []MockPixKey                 `json:"pixKeys"`
+	Webhooks []WebhookSubscriptionRequest `json:"webhooks"`
+}
+
+// MockCashIn ... corpo de POST /_mock/cash-in
+type MockCashIn struct {
+	Account     string  `json:"account"`
+	Amount      float64 `json:"amount"`
+	Description string  `json:"description"`
+}
+
+// MockSettlementDelay ... corpo de PUT /_mock/settlement-delay, com a duração no formato de time.ParseDuration
+type MockSettlementDelay struct {
+	Delay string `json:"delay"`
+}
+
+// MockWebhookTrigger ... corpo de POST /_mock/webhooks
+type MockWebhookTrigger struct {
+	Entity string          `json:"entity"`
+	Status string          `json:"status"`
+	Body   json.RawMessage `json:"body"`
+}
+
+// mockSnapshot ... estado persistido por SaveState; os tokens permitem que clientes continuem autenticados
+// após reiniciar o servidor
+type mockSnapshot struct {
+	State  *mockState           `json:"state"`
+	Tokens map[string]time.Time `json:"tokens"`
+}
+
+// NewMockServerListener ... inicia um MockServer no listener informado (ex.: net.Listen("tcp", ":8080")),
+// para uso fora dos testes
+func NewMockServerListener(listener net.Listener) *MockServer {
+	m := newMockServer()
+	m.Server = httptest.NewUnstartedServer(m)
+	m.Server.Listener.Close()
+	m.Server.Listener = listener
+	m.Server.Start()
+	return m
+}
+
+// Seed ... cadastra contas, chaves Pix e assinaturas de webhook
+func (m *MockServer) Seed(seed MockSeed) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	for _, account := range seed.Accounts {
+		if _, found := m.state.Accounts[account.Account]; found && len(account.Account) > 0 {
+			return fmt.Errorf("%w: duplicated account %s", ErrMockInvalidSeed, account.Account)
+		}
+		m.addAccount(account)
+	}
+	for _, key := range seed.PixKeys {
+		if _, err := m.addPixKey(key); err != nil {
+			return fmt.Errorf("%w: pix key %s: %v", ErrMockInvalidSeed, key.Key, err)
+		}
+	}
+	for _, request := range seed.Webhooks {
+		if _, code := m.addSubscription(request); len(code) > 0 {
+			return fmt.Errorf("%w: webhook %s: %s", ErrMockInvalidSeed, request.Entity, mockErrorMessage(ErrorDomainWebhook, code))
+		}
+	}
+	return nil
+}
+
+// SaveState ... grava em JSON o estado do MockServer, incluindo as liquidações pendentes e os tokens emitidos.
+// Regras de falha e requisições registradas não fazem parte do estado.
+func (m *MockServer) SaveState(w io.Writer) error {
+	m.mutex.Lock()
+	data, err := json.MarshalIndent(mockSnapshot{State: m.state, Tokens: m.tokens}, "", "  ")
+	m.mutex.Unlock()
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+// LoadState ... substitui o estado pelo gravado com SaveState e reagenda as liquidações pendentes
+func (m *MockServer) LoadState(r io.Reader) error {
+	snapshot := mockSnapshot{State: newMockState(), Tokens: make(map[string]time.Time)}
+	if err := json.NewDecoder(r).Decode(&snapshot); err != nil {
+		return err
+	}
+	if snapshot.State == nil {
+		snapshot.State = newMockState()
+	}
+	if snapshot.Tokens == nil {
+		snapshot.Tokens = make(map[string]time.Time)
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	for _, timer := range m.timers {
+		timer.Stop()
+	}
+	m.timers = nil
+	m.state = snapshot.State
+	m.tokens = snapshot.Tokens
+	for _, settlement := range m.state.Settlements {
+		m.scheduleSettlement(settlement)
+	}
+	return nil
+}
+
+// registerAdminRoutes ...
+func (m *MockServer) registerAdminRoutes() {
+	m.handle(http.MethodGet, MockAdminPath+"/state", m.handleAdminState).public = true
+	m.handle(http.MethodPost, MockAdminPath+"/accounts", m.handleAdminAccount).public = true
+	m.handle(http.MethodPost, MockAdminPath+"/pix-keys", m.handleAdminPixKey).public = true
+	m.handle(http.MethodPost, MockAdminPath+"/cash-in", m.handleAdminCashIn).public = true
+	m.handle(http.MethodPost, MockAdminPath+"/pix-charges/{transactionIdentification}/pay", m.handleAdminPayPixCharge).public = true
+	m.handle(http.MethodPost, MockAdminPath+"/settlements", m.handleAdminSettle).public = true
+	m.handle(http.MethodPut, MockAdminPath+"/settlement-delay", m.handleAdminSettlementDelay).public = true
+	m.handle(http.MethodPost, MockAdminPath+"/webhooks", m.handleAdminWebhook).public = true
+}
+
+// adminError ...
+func adminError(status int, message string) mockResponse {
+	return mockJSON(status, map[string]string{"error": message})
+}
+
+// handleAdminState ...
+func (m *MockServer) handleAdminState(r *mockRequest) mockResponse {
+	return mockJSON(http.StatusOK, mockSnapshot{State: m.state, Tokens: m.tokens})
+}
+
+// handleAdminAccount ...
+func (m *MockServer) handleAdminAccount(r *mockRequest) mockResponse {
+	var account MockAccount
+	if err := r.decode(&account); err != nil {
+		return adminError(http.StatusBadRequest, err.Error())
+	}
+	if _, found := m.state.Accounts[account.Account]; found && len(account.Account) > 0 {
+		return adminError(http.StatusConflict, "account already exists")
+	}
+	return mockJSON(http.StatusCreated, m.addAccount(account))
+}
+
+// handleAdminPixKey ...
+func (m *MockServer) handleAdminPixKey(r *mockRequest) mockResponse {
+	var key MockPixKey
+	if err := r.decode(&key); err != nil {
+		return adminError(http.StatusBadRequest, err.Error())
+	}
+	stored, err := m.addPixKey(key)
+	if err != nil {
+		return adminError(http.StatusNotFound, err.Error())
+	}
+	return mockJSON(http.StatusCreated, stored)
+}
+
+// handleAdminCashIn ...
+func (m *MockServer) handleAdminCashIn(r *mockRequest) mockResponse {
+	var request MockCashIn
+	if err := r.decode(&request); err != nil {
+		return adminError(http.StatusBadRequest, err.Error())
+	}
+	if roundCents(request.Amount) <= 0 {
+		return adminError(http.StatusBadRequest, "amount must be positive")
+	}
+	account, found := m.state.Accounts[request.Account]
+	if !found {
+		return adminError(http.StatusNotFound, ErrMockAccountNotFound.Error())
+	}
+	description := request.Description
+	if len(description) == 0 {
+		description = "Pix recebido"
+	}
+	return mockJSON(http.StatusCreated, m.receivePix(account, roundCents(request.Amount), description, nil, nil))
+}
+
+// handleAdminPayPixCharge ...
+func (m *MockServer) handleAdminPayPixCharge(r *mockRequest) mockResponse {
+	charge := m.pixChargeByIdentification(r.param("transactionIdentification"))
+	if charge == nil {
+		return adminError(http.StatusNotFound, ErrMockChargeNotFound.Error())
+	}
+	account, found := m.state.Accounts[charge.Account]
+	if !found {
+		return adminError(http.StatusNotFound, ErrMockAccountNotFound.Error())
+	}
+	return mockJSON(http.StatusCreated, m.receivePix(account, charge.Amount, "Pix recebido", nil, charge))
+}
+
+// handleAdminSettle ...
+func (m *MockServer) handleAdminSettle(r *mockRequest) mockResponse {
+	return mockJSON(http.StatusOK, map[string]int{"settled": m.applySettlements(time.Time{})})
+}
+
+// handleAdminSettlementDelay ...
+func (m *MockServer) handleAdminSettlementDelay(r *mockRequest) mockResponse {
+	var request MockSettlementDelay
+	if err := r.decode(&request); err != nil {
+		return adminError(http.StatusBadRequest, err.Error())
+	}
+	delay, err := time.ParseDuration(request.Delay)
+	if err != nil || delay < 0 {
+		return adminError(http.StatusBadRequest, "invalid delay")
+	}
+	m.settlementDelay = delay
+	return mockJSON(http.StatusOK, MockSettlementDelay{Delay: delay.String()})
+}
+
+// handleAdminWebhook ...
+func (m *MockServer) handleAdminWebhook(r *mockRequest) mockResponse {
+	var request MockWebhookTrigger
+	if err := r.decode(&request); err != nil {
+		return adminError(http.StatusBadRequest, err.Error())
+	}
+	if len(request.Entity) == 0 {
+		return adminError(http.StatusBadRequest, "entity is required")
+	}
+	if len(request.Status) == 0 {
+		request.Status = mockConfirmed
+	}
+	body := request.Body
+	if len(body) == 0 {
+		body = json.RawMessage("{}")
+	}
+
+	m.emit(request.Entity, request.Status, body)
+	return mockJSON(http.StatusCreated, m.state.WebhookEvents[len(m.state.WebhookEvents)-1].Event)
+}
@@ -0,0 +1,150 @@
+package celcoin
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// errInvalidEMV ...
+var errInvalidEMV = errors.New("invalid emv")
+
+// mockEMV ... dados de um BR Code (EMV QRCPS) do Pix
+type mockEMV struct {
+	Key                       string
+	URL                       string
+	Amount                    float64
+	TransactionIdentification string
+	AdditionalInformation     string
+	MerchantCategoryCode      string
+	MerchantName              string
+	MerchantCity              string
+	PostalCode                string
+}
+
+// encode ... BR Code com CRC16; QR codes dinâmicos informam a URL do payload no lugar da chave
+func (e mockEMV) encode() string {
+	account := emvField("00", "br.gov.bcb.pix")
+	if len(e.URL) > 0 {
+		account += emvField("25", e.URL)
+	} else {
+		account += emvField("01", e.Key)
+		if len(e.AdditionalInformation) > 0 {
+			account += emvField("02", e.AdditionalInformation)
+		}
+	}
+
+	mcc := e.MerchantCategoryCode
+	if len(mcc) == 0 {
+		mcc = "0000"
+	}
+	// QR codes dinâmicos informam o txid apenas no payload da URL
+	txid := e.TransactionIdentification
+	if len(txid) == 0 || len(e.URL) > 0 {
+		txid = "***"
+	}
+
+	payload := emvField("00", "01")
+	if len(e.URL) > 0 {
+		payload += emvField("01", "12")
+	}
+	payload += emvField("26", account) + emvField("52", mcc) + emvField("53", "986")
+	if e.Amount > 0 {
+		payload += emvField("54", formatMockAmount(e.Amount))
+	}
+	payload += emvField("58", "BR") + emvField("59", truncateEMV(e.MerchantName, 25)) +
+		emvField("60", truncateEMV(e.MerchantCity, 15))
+	if len(e.PostalCode) > 0 {
+		payload += emvField("61", e.PostalCode)
+	}
+	payload += emvField("62", emvField("05", truncateEMV(txid, 25))) + "6304"
+	return payload + fmt.Sprintf("%04X", crc16CCITT(payload))
+}
+
+// decodeMockEMV ... lê um BR Code e valida o CRC16
+func decodeMockEMV(emv string) (*mockEMV, error) {
+	emv = strings.TrimSpace(emv)
+	if len(emv) < 8 || emv[len(emv)-8:len(emv)-4] != "6304" {
+		return nil, errInvalidEMV
+	}
+	if fmt.Sprintf("%04X", crc16CCITT(emv[:len(emv)-4])) != strings.ToUpper(emv[len(emv)-4:]) {
+		return nil, errInvalidEMV
+	}
+
+	fields, err := parseEMVFields(emv[:len(emv)-8])
+	if err != nil {
+		return nil, err
+	}
+	account, err := parseEMVFields(fields["26"])
+	if err != nil {
+		return nil, err
+	}
+	additional, err := parseEMVFields(fields["62"])
+	if err != nil {
+		return nil, err
+	}
+
+	decoded := &mockEMV{
+		Key:                       account["01"],
+		URL:                       account["25"],
+		AdditionalInformation:     account["02"],
+		TransactionIdentification: additional["05"],
+		MerchantCategoryCode:      fields["52"],
+		MerchantName:              fields["59"],
+		MerchantCity:              fields["60"],
+		PostalCode:                fields["61"],
+	}
+	if amount, found := fields["54"]; found {
+		if decoded.Amount, err = strconv.ParseFloat(amount, 64); err != nil {
+			return nil, errInvalidEMV
+		}
+	}
+	return decoded, nil
+}
+
+// parseEMVFields ... campos ID (2 dígitos), tamanho (2 dígitos) e valor
+func parseEMVFields(data string) (map[string]string, error) {
+	fields := make(map[string]string)
+	for len(data) > 0 {
+		if len(data) < 4 {
+			return nil, errInvalidEMV
+		}
+		size, err := strconv.Atoi(data[2:4])
+		if err != nil || len(data) < 4+size {
+			return nil, errInvalidEMV
+		}
+		fields[data[:2]] = data[4 : 4+size]
+		data = data[4+size:]
+	}
+	return fields, nil
+}
+
+// emvField ...
+func emvField(id, value string) string {
+	return fmt.Sprintf("%s%02d%s", id, len(value), value)
+}
+
+// truncateEMV ...
+func truncateEMV(value string, size int) string {
+	if len(value) > size {
+		return value[:size]
+	}
+	return value
+}
+
+// crc16CCITT ... CRC16 CCITT-FALSE (polinômio 0x1021, valor inicial 0xFFFF) exigido pelo BR Code
+func crc16CCITT(data string) uint16 {
+	crc := uint16(0xFFFF)
+	for i := 0; i < len(data); i++ {
+		crc ^= uint16(data[i]) << 8
+		for bit := 0; bit < 8; bit++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
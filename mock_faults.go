@@ -0,0 +1,406 @@
+package celcoin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// mockHangLimit ... tempo máximo que uma falha Hang segura a requisição quando o cliente não desiste
+const mockHangLimit = time.Minute
+
+const (
+	// mockProcessing ... operação aceita, aguardando liquidação
+	mockProcessing = "PROCESSING"
+	// mockConfirmed ... operação liquidada
+	mockConfirmed = "CONFIRMED"
+)
+
+// MockFault ... resposta anômala devolvida pelo MockServer quando uma MockFaultRule é acionada
+type MockFault struct {
+	// Delay ... latência antes de atender a requisição
+	Delay time.Duration
+	// Apply ... executa a rota (debitos, webhooks, etc.) antes de devolver a falha
+	Apply bool
+	// Hang ... não responde até o cliente desistir (timeout do contexto)
+	Hang bool
+	// Status ... status HTTP da falha; zero mantém a resposta da rota
+	Status int
+	// Header ... cabeçalhos da resposta da falha
+	Header http.Header
+	// Body ... corpo enviado como está; vazio envia a resposta sem corpo
+	Body []byte
+}
+
+// MockTimeoutAfterApply ... a Celcoin processa a operação, mas a resposta nunca chega ao cliente
+func MockTimeoutAfterApply() MockFault {
+	return MockFault{Apply: true, Hang: true}
+}
+
+// MockEmptyResponse ... resposta sem corpo, como os 5xx do gateway da Celcoin
+func MockEmptyResponse(status int) MockFault {
+	return MockFault{Status: status}
+}
+
+// MockMalformedJSON ... resposta declarada como JSON com o corpo truncado
+func MockMalformedJSON(status int) MockFault {
+	return MockFault{
+		Status: status,
+		Header: http.Header{"Content-Type": []string{"application/json"}},
+		Body:   []byte(`{"version":"1.0.0","status":"PROCESS`),
+	}
+}
+
+// MockRateLimited ... 429 com o cabeçalho Retry-After em segundos
+func MockRateLimited(retryAfter time.Duration) MockFault {
+	return MockFault{
+		Status: http.StatusTooManyRequests,
+		Header: http.Header{"Retry-After": []string{fmt.Sprintf("%d", int(math.Ceil(retryAfter.Seconds())))}},
+	}
+}
+
+// MockCelcoinError ... erro {"error": {"errorCode", "message"}} com a mensagem do catálogo para o código CBE
+func MockCelcoinError(status int, domain ErrorDomain, code string) MockFault {
+	data, _ := json.Marshal(mockError(status, domain, code).body)
+	return MockFault{
+		Status: status,
+		Header: http.Header{"Content-Type": []string{"application/json"}},
+		Body:   data,
+	}
+}
+
+// MockLatency ... atende a requisição normalmente após a espera
+func MockLatency(delay time.Duration) MockFault {
+	return MockFault{Delay: delay}
+}
+
+// replaces ... a falha substitui a resposta da rota
+func (f *MockFault) replaces() bool {
+	return f.Hang || f.Status != 0
+}
+
+// response ...
+func (f *MockFault) response() mockResponse {
+	return mockResponse{status: f.Status, body: f.Body, header: f.Header}
+}
+
+// MockFaultRule ... regra que injeta uma MockFault nas requisições de uma rota. Por padrão a regra vale para
+// todas as requisições; use Times, After, WithProbability, WhenBodyContains e When para restringi-la.
+type MockFaultRule struct {
+	server      *MockServer
+	method      string
+	route       *mockRoute
+	fault       MockFault
+	times       int
+	after       int
+	probability float64
+	conditions  []func(request MockRecordedRequest) bool
+	matched     int
+	hits        int
+}
+
+// Fault ... registra uma regra de falha para o método e o caminho; o caminho aceita parâmetros no formato
+// {nome} (ex.: PixDictPath+"/{key}"). Método ou caminho vazios valem para qualquer requisição.
+func (m *MockServer) Fault(method, path string, fault MockFault) *MockFaultRule {
+	rule := &MockFaultRule{server: m, method: method, fault: fault, probability: 1}
+	if len(path) > 0 {
+		rule.route = &mockRoute{segments: splitMockPath(path)}
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.faults = append(m.faults, rule)
+	return rule
+}
+
+// ClearFaults ... remove todas as regras de falha
+func (m *MockServer) ClearFaults() {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.faults = nil
+}
+
+// SetFaultSeed ... semente das regras com WithProbability, para cenários reproduzíveis
+func (m *MockServer) SetFaultSeed(seed int64) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.random.Seed(seed)
+}
+
+// Times ... injeta a falha apenas nas n primeiras requisições elegíveis
+func (r *MockFaultRule) Times(n int) *MockFaultRule {
+	r.server.mutex.Lock()
+	defer r.server.mutex.Unlock()
+	r.times = n
+	return r
+}
+
+// After ... deixa passar as n primeiras requisições da rota antes de injetar a falha
+func (r *MockFaultRule) After(n int) *MockFaultRule {
+	r.server.mutex.Lock()
+	defer r.server.mutex.Unlock()
+	r.after = n
+	return r
+}
+
+// WithProbability ... injeta a falha com a probabilidade p (0 a 1)
+func (r *MockFaultRule) WithProbability(p float64) *MockFaultRule {
+	r.server.mutex.Lock()
+	defer r.server.mutex.Unlock()
+	r.probability = p
+	return r
+}
+
+// WhenBodyContains ... restringe a regra às requisições cujo corpo contém o trecho
+func (r *MockFaultRule) WhenBodyContains(substring string) *MockFaultRule {
+	return r.When(func(request MockRecordedRequest) bool {
+		return bytes.Contains(request.Body, []byte(substring))
+	})
+}
+
+// When ... restringe a regra às requisições aceitas pela função, executada com o mutex do MockServer
+// (não chame métodos do MockServer dentro dela)
+func (r *MockFaultRule) When(condition func(request MockRecordedRequest) bool) *MockFaultRule {
+	r.server.mutex.Lock()
+	defer r.server.mutex.Unlock()
+	r.conditions = append(r.conditions, condition)
+	return r
+}
+
+// Hits ... quantidade de falhas injetadas pela regra
+func (r *MockFaultRule) Hits() int {
+	r.server.mutex.Lock()
+	defer r.server.mutex.Unlock()
+	return r.hits
+}
+
+// matches ... requisição da rota que atende às condições da regra
+func (r *MockFaultRule) matches(request MockRecordedRequest) bool {
+	if len(r.method) > 0 && r.method != request.Method {
+		return false
+	}
+	if r.route != nil {
+		if _, ok := r.route.match(splitMockPath(request.Path)); !ok {
+			return false
+		}
+	}
+	for _, condition := range r.conditions {
+		if !condition(request) {
+			return false
+		}
+	}
+	return true
+}
+
+// fault ... falha da primeira regra acionada pela requisição
+func (m *MockServer) fault(request *MockRecordedRequest) *MockFault {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	for _, rule := range m.faults {
+		if !rule.matches(*request) {
+			continue
+		}
+		rule.matched++
+		if rule.matched <= rule.after || (rule.times > 0 && rule.hits >= rule.times) {
+			continue
+		}
+		if rule.probability < 1 && m.random.Float64() >= rule.probability {
+			continue
+		}
+		rule.hits++
+		request.Fault = true
+		fault := rule.fault
+		return &fault
+	}
+	return nil
+}
+
+// wait ... aguarda a duração; false quando o cliente desistiu ou o servidor foi encerrado antes
+func (m *MockServer) wait(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+	case <-m.closing:
+	}
+	return false
+}
+
+// MockRecordedRequest ... requisição recebida pelo MockServer
+type MockRecordedRequest struct {
+	Method string
+	Path   string
+	Query  url.Values
+	Header http.Header
+	Body   []byte
+	// Status ... status HTTP respondido; zero enquanto não há resposta ou quando o cliente desistiu antes dela
+	Status int
+	// Fault ... a requisição acionou uma MockFaultRule
+	Fault bool
+	Time  time.Time
+}
+
+// Decode ... lê o corpo JSON da requisição
+func (r MockRecordedRequest) Decode(v interface{}) error {
+	return json.Unmarshal(r.Body, v)
+}
+
+// record ... registra a requisição na chegada; o status é preenchido por respond
+func (m *MockServer) record(request MockRecordedRequest) *MockRecordedRequest {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.requests = append(m.requests, &request)
+	return &request
+}
+
+// respond ...
+func (m *MockServer) respond(request *MockRecordedRequest, status int) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	request.Status = status
+}
+
+// Requests ... requisições recebidas, na ordem de chegada
+func (m *MockServer) Requests() []MockRecordedRequest {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	requests := make([]MockRecordedRequest, 0, len(m.requests))
+	for _, request := range m.requests {
+		requests = append(requests, *request)
+	}
+	return requests
+}
+
+// ReceivedRequests ... requisições recebidas para o método e o caminho, no mesmo formato de Fault
+func (m *MockServer) ReceivedRequests(method, path string) []MockRecordedRequest {
+	filter := MockFaultRule{method: method}
+	if len(path) > 0 {
+		filter.route = &mockRoute{segments: splitMockPath(path)}
+	}
+
+	var requests []MockRecordedRequest
+	for _, request := range m.Requests() {
+		if filter.matches(request) {
+			requests = append(requests, request)
+		}
+	}
+	return requests
+}
+
+// ResetRequests ... descarta as requisições registradas
+func (m *MockServer) ResetRequests() {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.requests = nil
+}
+
+const (
+	// mockSettlementPixPayment ... Pix cash-out
+	mockSettlementPixPayment = "pix-payment"
+	// mockSettlementTransfer ... transferência interna ou TED
+	mockSettlementTransfer = "transfer"
+	// mockSettlementBillPayment ... pagamento de conta
+	mockSettlementBillPayment = "bill-payment"
+)
+
+// mockSettlement ... liquidação pendente de uma operação PROCESSING; faz parte do estado persistido
+type mockSettlement struct {
+	Kind string    `json:"kind"`
+	ID   string    `json:"id"`
+	Due  time.Time `json:"due"`
+}
+
+// SetSettlementDelay ... tempo que Pix cash-outs, transferências e pagamentos de contas permanecem PROCESSING
+// antes de passarem a CONFIRMED (com o crédito do destino e os webhooks). Zero liquida na própria requisição.
+func (m *MockServer) SetSettlementDelay(delay time.Duration) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.settlementDelay = delay
+}
+
+// SettlePending ... liquida imediatamente as operações pendentes e retorna quantas foram liquidadas
+func (m *MockServer) SettlePending() int {
+	m.mutex.Lock()
+	defer m.flush()
+	return m.applySettlements(time.Time{})
+}
+
+// PendingSettlements ... quantidade de operações aguardando liquidação
+func (m *MockServer) PendingSettlements() int {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return len(m.state.Settlements)
+}
+
+// settle ... liquida a operação agora ou após o atraso configurado; executado com o mutex
+func (m *MockServer) settle(kind, id string) {
+	settlement := &mockSettlement{Kind: kind, ID: id, Due: m.now().Add(m.settlementDelay)}
+	if m.settlementDelay <= 0 {
+		m.applySettlement(settlement)
+		return
+	}
+	m.state.Settlements = append(m.state.Settlements, settlement)
+	m.scheduleSettlement(settlement)
+}
+
+// scheduleSettlement ... timer que liquida a operação no vencimento
+func (m *MockServer) scheduleSettlement(settlement *mockSettlement) {
+	delay := settlement.Due.Sub(m.now())
+	if delay < 0 {
+		delay = 0
+	}
+	m.timers = append(m.timers, time.AfterFunc(delay, m.settleDue))
+}
+
+// settleDue ... liquida as operações vencidas, a partir do timer
+func (m *MockServer) settleDue() {
+	m.mutex.Lock()
+	if m.closed {
+		m.mutex.Unlock()
+		return
+	}
+	defer m.flush()
+	m.applySettlements(m.now())
+}
+
+// applySettlements ... liquida, na ordem de criação, as operações vencidas até o instante; zero liquida todas
+func (m *MockServer) applySettlements(until time.Time) int {
+	var pending []*mockSettlement
+	settled := 0
+	for _, settlement := range m.state.Settlements {
+		if !until.IsZero() && settlement.Due.After(until) {
+			pending = append(pending, settlement)
+			continue
+		}
+		m.applySettlement(settlement)
+		settled++
+	}
+	m.state.Settlements = pending
+	return settled
+}
+
+// applySettlement ...
+func (m *MockServer) applySettlement(settlement *mockSettlement) {
+	switch settlement.Kind {
+	case mockSettlementPixPayment:
+		if payment, found := m.state.PixPayments[settlement.ID]; found {
+			m.confirmPixPayment(payment)
+		}
+	case mockSettlementTransfer:
+		if transfer, found := m.state.Transfers[settlement.ID]; found {
+			m.confirmTransfer(transfer)
+		}
+	case mockSettlementBillPayment:
+		if payment, found := m.state.BillPayments[settlement.ID]; found {
+			m.confirmBillPayment(payment)
+		}
+	}
+}
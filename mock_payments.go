@@ -0,0 +1,793 @@
+package celcoin
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/contbank/grok"
+	"github.com/google/uuid"
+)
+
+const (
+	// mockBoletoBank ... código de compensação usado nos boletos emitidos pelo MockServer
+	mockBoletoBank = "509"
+	// mockBoletoAssignor ... cedente dos boletos emitidos pelo MockServer
+	mockBoletoAssignor = "CELCOIN INSTITUICAO DE PAGAMENTO S.A."
+
+	// mockChargeActive ... boleto aguardando pagamento
+	mockChargeActive = "ACTIVE"
+	// mockChargeConfirmed ... boleto pago
+	mockChargeConfirmed = "CONFIRMED"
+	// mockChargeCancelled ... boleto cancelado pelo emissor
+	mockChargeCancelled = "CANCELLED"
+)
+
+// mockBoletoBaseDate ... data base do fator de vencimento dos boletos
+var mockBoletoBaseDate = time.Date(1997, time.October, 7, 0, 0, 0, 0, time.UTC)
+
+// mockTransfer ... transferência interna ou TED
+type mockTransfer struct {
+	TransfersBodyResponse
+	Internal   bool      `json:"internal"`
+	Status     string    `json:"status"`
+	CreateDate time.Time `json:"createDate"`
+}
+
+// mockCharge ... boleto emitido pelo MockServer
+type mockCharge struct {
+	ChargeBody
+	CreateDate time.Time `json:"createDate"`
+}
+
+// mockBillAuthorization ... autorização de pagamento de conta
+type mockBillAuthorization struct {
+	TransactionID int64     `json:"transactionId"`
+	BarCode       string    `json:"barCode"`
+	Digitable     string    `json:"digitable"`
+	Amount        float64   `json:"amount"`
+	DueDate       time.Time `json:"dueDate"`
+	// Charge ... transactionId do boleto quando emitido pelo MockServer
+	Charge string `json:"charge,omitempty"`
+}
+
+// mockBillPayment ... pagamento de conta executado
+type mockBillPayment struct {
+	ExecPaymentResponseBody
+	Account     string    `json:"account"`
+	Status      string    `json:"status"`
+	PaymentDate time.Time `json:"paymentDate"`
+}
+
+// registerPaymentRoutes ...
+func (m *MockServer) registerPaymentRoutes() {
+	m.handle(http.MethodPost, InternalTransfersPath, m.handleInternalTransfer)
+	m.handle(http.MethodGet, InternalTransfersPath+"/status", m.handleInternalTransferStatus)
+	m.handle(http.MethodPost, ExternalTransfersPath, m.handleExternalTransfer)
+	m.handle(http.MethodGet, ExternalTransfersPath+"/status", m.handleExternalTransferStatus)
+
+	m.handle(http.MethodPost, BaasV2ChargePath, m.handleCreateCharge)
+	m.handle(http.MethodGet, BaasV2ChargePath, m.handleGetCharge)
+	m.handle(http.MethodDelete, BaasV2ChargePath+"/{id}", m.handleCancelCharge)
+	m.handle(http.MethodGet, BaasV2ChargePath+"/pdf/{id}", m.handleChargePDF)
+
+	m.handle(http.MethodPost, BillPaymentAuthorizeBasePath+"/"+BillPaymentAuthorizePath, m.handleAuthorizeBillPayment)
+	m.handle(http.MethodPost, BillPaymentConfirmBasePath, m.handleExecuteBillPayment)
+	m.handle(http.MethodGet, BillPaymenStatusBasePath+"/"+BillPaymentStatusPath, m.handleGetBillPayment)
+
+	m.handle(http.MethodPost, DdaSubscriptionPath, m.handleRegisterDdaUser)
+	m.handle(http.MethodDelete, DdaSubscriptionPath, m.handleDeleteDdaUser)
+}
+
+// transferError ... erro no formato das APIs de transferência; as mensagens seguem o catálogo de Pix
+func transferError(status int, code string) mockResponse {
+	return mockJSON(status, TransfersResponse{
+		Version: mockVersion,
+		Status:  strconv.Itoa(status),
+		Error:   &TransfersError{ErrorCode: code, Message: mockErrorMessage(ErrorDomainPix, code)},
+	})
+}
+
+// handleInternalTransfer ... transferência entre contas do MockServer
+func (m *MockServer) handleInternalTransfer(r *mockRequest) mockResponse {
+	return m.transfer(r, true)
+}
+
+// handleExternalTransfer ... TED para outra instituição; apenas debita a conta de origem
+func (m *MockServer) handleExternalTransfer(r *mockRequest) mockResponse {
+	return m.transfer(r, false)
+}
+
+// transfer ...
+func (m *MockServer) transfer(r *mockRequest, internal bool) mockResponse {
+	var request TransfersRequest
+	if err := r.decode(&request); err != nil {
+		return transferError(http.StatusBadRequest, "CBE224")
+	}
+
+	amount := roundCents(request.Amount)
+	switch {
+	case len(request.ClientCode) == 0:
+		return transferError(http.StatusBadRequest, "CBE001")
+	case amount <= 0:
+		return transferError(http.StatusBadRequest, "CBE095")
+	}
+	debtor, found := m.state.Accounts[request.DebitParty.AccountNumber]
+	if !found {
+		return transferError(http.StatusBadRequest, "CBE109")
+	}
+	for _, transfer := range m.state.Transfers {
+		if transfer.ClientCode == request.ClientCode {
+			return transferError(http.StatusBadRequest, "CBE101")
+		}
+	}
+
+	var creditor *MockAccount
+	if internal {
+		creditor, found = m.state.Accounts[request.CreditParty.AccountNumber]
+		if !found || creditor.Status == MockAccountClosed {
+			return transferError(http.StatusBadRequest, "CBE117")
+		}
+	}
+	if code := debitable(debtor, amount); len(code) > 0 {
+		return transferError(http.StatusBadRequest, code)
+	}
+
+	transfer := &mockTransfer{
+		TransfersBodyResponse: TransfersBodyResponse{
+			ID:              uuid.New().String(),
+			Amount:          amount,
+			ClientCode:      request.ClientCode,
+			ClientRequestId: request.ClientRequestId,
+			DebitParty: TransfersDebitPartyResponse{
+				AccountNumber: debtor.Account,
+				AccountBranch: debtor.Branch,
+				Identifier:    debtor.DocumentNumber,
+				AccountName:   debtor.Name,
+				AccountType:   "CACC",
+				PersonType:    debtor.personType(),
+				BankISPB:      CelcoinBankISPB,
+			},
+			CreditParty: TransfersCreditPartyResponse{TransfersCreditPartyRequest: request.CreditParty},
+			Description: request.Description,
+		},
+		Internal:   internal,
+		Status:     mockProcessing,
+		CreateDate: m.now(),
+	}
+	m.state.Transfers[transfer.ID] = transfer
+
+	if internal {
+		m.debit(debtor, amount, "TEFTRANSFEROUT", request.ClientCode, "Transferência enviada - "+creditor.Name)
+		m.settle(mockSettlementTransfer, transfer.ID)
+	} else {
+		transfer.EndToEndId = m.newEndToEndID()
+		m.debit(debtor, amount, "SPBTRANSFEROUT", request.ClientCode, "TED enviada - "+request.CreditParty.AccountName)
+		m.settle(mockSettlementTransfer, transfer.ID)
+	}
+
+	return mockJSON(http.StatusOK, TransfersResponse{
+		Version: mockVersion,
+		Status:  mockProcessing,
+		Body:    &transfer.TransfersBodyResponse,
+	})
+}
+
+// confirmTransfer ... conclui a transferência e, quando interna, credita a conta de destino
+func (m *MockServer) confirmTransfer(transfer *mockTransfer) {
+	transfer.Status = mockConfirmed
+	if !transfer.Internal {
+		m.emit(MockEntitySpbTransferOut, transfer.Status, transfer.TransfersBodyResponse)
+		return
+	}
+
+	if creditor, found := m.state.Accounts[transfer.CreditParty.AccountNumber]; found {
+		m.credit(creditor, transfer.Amount, "TEFTRANSFERIN", transfer.ClientCode,
+			"Transferência recebida - "+transfer.DebitParty.AccountName)
+	}
+	m.emit(MockEntityInternalTransfer, transfer.Status, transfer.TransfersBodyResponse)
+}
+
+// handleInternalTransferStatus ...
+func (m *MockServer) handleInternalTransferStatus(r *mockRequest) mockResponse {
+	return m.transferStatus(r.query("id"), r.query("ClientRequestId"), true)
+}
+
+// handleExternalTransferStatus ...
+func (m *MockServer) handleExternalTransferStatus(r *mockRequest) mockResponse {
+	return m.transferStatus(r.query("id"), r.query("clientCode"), false)
+}
+
+// transferStatus ... transferência pelo id ou pelo código do cliente
+func (m *MockServer) transferStatus(id, clientCode string, internal bool) mockResponse {
+	for _, transfer := range m.state.Transfers {
+		if transfer.Internal != internal {
+			continue
+		}
+		if transfer.ID == id || (len(clientCode) > 0 && transfer.ClientCode == clientCode) {
+			return mockJSON(http.StatusOK, TransfersResponse{
+				Version: mockVersion,
+				Status:  transfer.Status,
+				Body:    &transfer.TransfersBodyResponse,
+			})
+		}
+	}
+	return transferError(http.StatusNotFound, "CBE150")
+}
+
+// chargeError ...
+func chargeError(status int, code string) mockResponse {
+	return mockError(status, ErrorDomainCharge, code)
+}
+
+// handleCreateCharge ... emite um boleto híbrido: o QR code Pix usa a chave informada ou a primeira chave da conta
+func (m *MockServer) handleCreateCharge(r *mockRequest) mockResponse {
+	var request CreateBoletoRequest
+	if err := r.decode(&request); err != nil {
+		return chargeError(http.StatusBadRequest, "CSE001")
+	}
+	if request.Amount == nil || *request.Amount <= 0 || request.DueDate == nil || request.Debtor == nil ||
+		request.Receiver == nil {
+		return chargeError(http.StatusBadRequest, "CSE001")
+	}
+	receiver, found := m.state.Accounts[request.Receiver.Account]
+	if !found || receiver.Status != MockAccountActive {
+		return chargeError(http.StatusBadRequest, "CSE001")
+	}
+	dueDate, ok := parseMockDate(*request.DueDate)
+	if !ok {
+		return chargeError(http.StatusBadRequest, "CSE001")
+	}
+
+	amount := roundCents(*request.Amount)
+	charge := &mockCharge{
+		ChargeBody: ChargeBody{
+			TransactionID: uuid.New().String(),
+			Amount:        amount,
+			DueDate:       dueDate.Format(mockDateLayout),
+			Status:        mockChargeActive,
+			Debtor: ChargeDebtor{
+				Name:         request.Debtor.Name,
+				Document:     request.Debtor.Document,
+				PostalCode:   request.Debtor.PostalCode,
+				PublicArea:   request.Debtor.PublicArea,
+				Number:       request.Debtor.Number,
+				Neighborhood: request.Debtor.Neighborhood,
+				City:         request.Debtor.City,
+				State:        request.Debtor.State,
+			},
+			Receiver: ChargeReceiver{
+				Name:     receiver.Name,
+				Document: receiver.DocumentNumber,
+				Account:  receiver.Account,
+			},
+			Split: []interface{}{},
+		},
+		CreateDate: m.now(),
+	}
+	if request.ExternalID != nil {
+		charge.ExternalID = *request.ExternalID
+	}
+	if instructions := request.Instructions; instructions != nil {
+		if instructions.Fine != nil {
+			charge.Instructions.Fine = *instructions.Fine
+		}
+		if instructions.Interest != nil {
+			charge.Instructions.Interest = *instructions.Interest
+		}
+		if discount := instructions.Discount; discount != nil {
+			if discount.Amount != nil {
+				charge.Instructions.Discount.Amount = *discount.Amount
+			}
+			if discount.Modality != nil {
+				charge.Instructions.Discount.Modality = *discount.Modality
+			}
+			if discount.LimitDate != nil {
+				charge.Instructions.Discount.LimitDate = *discount.LimitDate
+			}
+		}
+	}
+
+	barCode := mockBarCode(m.nextSequence(), amount, dueDate)
+	charge.Boleto = ChargeDetails{
+		TransactionID: charge.TransactionID,
+		Status:        mockChargeActive,
+		BankEmissor:   "celcoin",
+		BankNumber:    mockBoletoBank,
+		BankAgency:    receiver.Branch,
+		BankAccount:   receiver.Account,
+		BarCode:       barCode,
+		BankLine:      mockDigitableLine(barCode),
+		BankAssignor:  mockBoletoAssignor,
+	}
+
+	var key *MockPixKey
+	if request.Key != nil && len(*request.Key) > 0 {
+		if key, _ = m.chargeKey(*request.Key); key == nil || key.Account != receiver.Account {
+			return chargeError(http.StatusBadRequest, "CSE001")
+		}
+	} else if keys := m.accountKeys(receiver.Account); len(keys) > 0 {
+		key = keys[0]
+	}
+	if key != nil {
+		pix := &mockPixCharge{
+			Kind:                      "CHARGE",
+			TransactionID:             m.nextSequence(),
+			TransactionIdentification: newTransactionIdentification(),
+			Key:                       key.Key,
+			Account:                   receiver.Account,
+			Amount:                    amount,
+			Status:                    mockPixChargeActive,
+			CreateDate:                m.now(),
+			LastUpdate:                m.now(),
+		}
+		pix.EMV = mockEMV{
+			Key:                       key.Key,
+			Amount:                    amount,
+			TransactionIdentification: pix.TransactionIdentification,
+			MerchantName:              receiver.Name,
+			MerchantCity:              "SAO PAULO",
+		}.encode()
+		m.state.PixCharges[strconv.FormatInt(pix.TransactionID, 10)] = pix
+		charge.Pix = ChargePix{
+			TransactionID:             strconv.FormatInt(pix.TransactionID, 10),
+			TransactionIdentification: pix.TransactionIdentification,
+			Status:                    pix.Status,
+			Key:                       pix.Key,
+			Emv:                       pix.EMV,
+		}
+	}
+	m.state.Charges[charge.TransactionID] = charge
+
+	return mockJSON(http.StatusCreated, map[string]interface{}{
+		"version": mockVersion,
+		"status":  "SUCCESS",
+		"body":    CreateBoletoResponse{TransactionID: charge.TransactionID, Status: charge.Status},
+	})
+}
+
+// handleGetCharge ... consulta pelo transactionId ou pelo externalId
+func (m *MockServer) handleGetCharge(r *mockRequest) mockResponse {
+	transactionID, externalID := r.query("transactionId"), r.query("externalId")
+	if len(transactionID) == 0 && len(externalID) == 0 {
+		return chargeError(http.StatusBadRequest, "CSE001")
+	}
+
+	for _, charge := range m.state.Charges {
+		if (len(transactionID) > 0 && charge.TransactionID == transactionID) ||
+			(len(externalID) > 0 && charge.ExternalID == externalID) {
+			return mockJSON(http.StatusOK, ChargeResponse{
+				Body:    charge.ChargeBody,
+				Version: mockVersion,
+				Status:  "SUCCESS",
+			})
+		}
+	}
+	return chargeError(http.StatusNotFound, "CSE002")
+}
+
+// handleCancelCharge ...
+func (m *MockServer) handleCancelCharge(r *mockRequest) mockResponse {
+	var request CancelInput
+	if err := r.decode(&request); err != nil || len(request.Reason) == 0 {
+		return chargeError(http.StatusBadRequest, "CSE001")
+	}
+	charge, found := m.state.Charges[r.param("id")]
+	if !found {
+		return chargeError(http.StatusNotFound, "CSE002")
+	}
+	if charge.Status != mockChargeActive {
+		return chargeError(http.StatusBadRequest, "CSE001")
+	}
+
+	m.setChargeStatus(charge, mockChargeCancelled)
+	if pix := m.pixChargeByIdentification(charge.Pix.TransactionIdentification); pix != nil {
+		pix.Status = mockPixChargeRemoved
+		pix.LastUpdate = m.now()
+	}
+	return mockSuccess(http.StatusOK)
+}
+
+// handleChargePDF ... PDF mínimo com a linha digitável do boleto
+func (m *MockServer) handleChargePDF(r *mockRequest) mockResponse {
+	charge, found := m.state.Charges[r.param("id")]
+	if !found {
+		return chargeError(http.StatusNotFound, "CSE002")
+	}
+
+	content := fmt.Sprintf("BT /F1 12 Tf 72 720 Td (%s) Tj ET", charge.Boleto.BankLine)
+	pdf := fmt.Sprintf("%%PDF-1.4\n1 0 obj << /Type /Catalog /Pages 2 0 R >> endobj\n"+
+		"2 0 obj << /Type /Pages /Kids [3 0 R] /Count 1 >> endobj\n"+
+		"3 0 obj << /Type /Page /Parent 2 0 R /MediaBox [0 0 612 792] /Contents 4 0 R >> endobj\n"+
+		"4 0 obj << /Length %d >> stream\n%s\nendstream endobj\ntrailer << /Root 1 0 R >>\n%%%%EOF\n",
+		len(content), content)
+	return mockResponse{status: http.StatusOK, body: []byte(pdf), contentType: "application/pdf"}
+}
+
+// setChargeStatus ...
+func (m *MockServer) setChargeStatus(charge *mockCharge, status string) {
+	charge.Status = status
+	charge.Boleto.Status = status
+}
+
+// confirmCharge ... baixa o boleto pago e gera o webhook charge-in
+func (m *MockServer) confirmCharge(charge *mockCharge, paidWith string) {
+	m.setChargeStatus(charge, mockChargeConfirmed)
+	if pix := m.pixChargeByIdentification(charge.Pix.TransactionIdentification); pix != nil {
+		if pix.Status == mockPixChargeActive {
+			pix.Status = mockPixChargeRemoved
+		}
+		charge.Pix.Status = pix.Status
+	}
+	m.emit(MockEntityChargeIn, charge.Status, map[string]interface{}{
+		"transactionId": charge.TransactionID,
+		"externalId":    charge.ExternalID,
+		"amount":        charge.Amount,
+		"paidWith":      paidWith,
+	})
+}
+
+// chargeByPix ... boleto híbrido do QR code Pix pago
+func (m *MockServer) chargeByPix(transactionIdentification string) *mockCharge {
+	if len(transactionIdentification) == 0 {
+		return nil
+	}
+	for _, charge := range m.state.Charges {
+		if charge.Pix.TransactionIdentification == transactionIdentification {
+			return charge
+		}
+	}
+	return nil
+}
+
+// paymentError ...
+func paymentError(status int, code string) mockResponse {
+	return mockError(status, ErrorDomainPayment, code)
+}
+
+// handleAuthorizeBillPayment ... valida o código de barras ou a linha digitável e devolve o valor a pagar
+func (m *MockServer) handleAuthorizeBillPayment(r *mockRequest) mockResponse {
+	var request ValidatePaymentRequest
+	if err := r.decode(&request); err != nil {
+		return paymentError(http.StatusBadRequest, "PCE024")
+	}
+	if request.BarCode == nil || (request.BarCode.BarCode == nil && request.BarCode.DigitableLine == nil) {
+		return paymentError(http.StatusBadRequest, "PCE004")
+	}
+
+	barCode := ""
+	if request.BarCode.BarCode != nil {
+		barCode = grok.OnlyDigits(*request.BarCode.BarCode)
+	}
+	if request.BarCode.DigitableLine != nil && len(*request.BarCode.DigitableLine) > 0 {
+		var ok bool
+		if barCode, ok = mockBarCodeFromLine(grok.OnlyDigits(*request.BarCode.DigitableLine)); !ok {
+			return paymentError(http.StatusBadRequest, "PCE024")
+		}
+	}
+	amount, dueDate, ok := parseMockBarCode(barCode)
+	if !ok {
+		return paymentError(http.StatusBadRequest, "PCE024")
+	}
+
+	authorization := &mockBillAuthorization{
+		TransactionID: m.nextSequence(),
+		BarCode:       barCode,
+		Digitable:     mockDigitableLine(barCode),
+		Amount:        amount,
+		DueDate:       dueDate,
+	}
+	assignor := "BANCO EXTERNO"
+	for _, charge := range m.state.Charges {
+		if charge.Boleto.BarCode != barCode {
+			continue
+		}
+		if charge.Status != mockChargeActive {
+			return paymentError(http.StatusBadRequest, "PCE019")
+		}
+		authorization.Charge = charge.TransactionID
+		assignor = charge.Boleto.BankAssignor
+	}
+	m.state.BillAuthorizations[strconv.FormatInt(authorization.TransactionID, 10)] = authorization
+
+	transactionID := int(authorization.TransactionID)
+	paymentType := int(PaymentCategoryCompensationForm)
+	settleDate := m.now().Format(mockDateLayout)
+	return mockJSON(http.StatusOK, PaymentResponse{
+		Assignor:      &assignor,
+		SettleDate:    &settleDate,
+		DueDate:       &authorization.DueDate,
+		Digitable:     &authorization.Digitable,
+		TransactionID: &transactionID,
+		Type:          &paymentType,
+		Value:         &authorization.Amount,
+		MaxValue:      &authorization.Amount,
+		MinValue:      &authorization.Amount,
+		RegisterData: &PaymentRegisterData{
+			PayDueDate:        authorization.DueDate.Format(mockDateTimeLayout),
+			DueDateRegister:   authorization.DueDate.Format(mockDateTimeLayout),
+			Recipient:         assignor,
+			OriginalValue:     authorization.Amount,
+			TotalUpdated:      authorization.Amount,
+			TotalWithDiscount: authorization.Amount,
+			MaxValue:          authorization.Amount,
+			MinValue:          authorization.Amount,
+		},
+	})
+}
+
+// handleExecuteBillPayment ... debita a conta e baixa o boleto quando emitido pelo MockServer
+func (m *MockServer) handleExecuteBillPayment(r *mockRequest) mockResponse {
+	var request ExecPaymentRequest
+	if err := r.decode(&request); err != nil {
+		return paymentError(http.StatusBadRequest, "PCE024")
+	}
+
+	amount := roundCents(request.Amount)
+	switch {
+	case len(request.ClientRequestID) == 0:
+		return paymentError(http.StatusBadRequest, "PCE001")
+	case len(request.Account) == 0:
+		return paymentError(http.StatusBadRequest, "PCE003")
+	case request.TransactionIDAuthorize == 0:
+		return paymentError(http.StatusBadRequest, "PCE009")
+	case amount <= 0:
+		return paymentError(http.StatusBadRequest, "PCE014")
+	}
+	account, found := m.state.Accounts[request.Account]
+	switch {
+	case !found:
+		return paymentError(http.StatusBadRequest, "PCE010")
+	case account.Status == MockAccountClosed:
+		return paymentError(http.StatusBadRequest, "PCE006")
+	case account.Status == MockAccountBlocked:
+		return paymentError(http.StatusBadRequest, "PCE007")
+	}
+	authorization, found := m.state.BillAuthorizations[strconv.Itoa(request.TransactionIDAuthorize)]
+	if !found {
+		return paymentError(http.StatusBadRequest, "PCE018")
+	}
+	for _, payment := range m.state.BillPayments {
+		if payment.ClientRequestID == request.ClientRequestID {
+			return paymentError(http.StatusBadRequest, "PCE025")
+		}
+		if payment.TransactionIDAuthorize == request.TransactionIDAuthorize {
+			return paymentError(http.StatusBadRequest, "PCE026")
+		}
+	}
+	if account.Balance < amount {
+		return paymentError(http.StatusBadRequest, "PCE019")
+	}
+
+	payment := &mockBillPayment{
+		ExecPaymentResponseBody: ExecPaymentResponseBody{
+			ID:                     uuid.New().String(),
+			ClientRequestID:        request.ClientRequestID,
+			Amount:                 amount,
+			TransactionIDAuthorize: request.TransactionIDAuthorize,
+			Tags:                   request.Tags,
+			BarCodeInfo: ExecPaymentBarCodeInfo{
+				Digitable: authorization.Digitable,
+				BarCode:   authorization.BarCode,
+			},
+		},
+		Account:     account.Account,
+		Status:      mockProcessing,
+		PaymentDate: m.now(),
+	}
+	m.state.BillPayments[payment.ID] = payment
+
+	m.debit(account, amount, "BILLPAYMENT", request.ClientRequestID, "Pagamento de conta")
+	m.settle(mockSettlementBillPayment, payment.ID)
+
+	return mockJSON(http.StatusOK, ExecPaymentResponse{
+		Body:    payment.ExecPaymentResponseBody,
+		Status:  mockProcessing,
+		Version: mockVersion,
+	})
+}
+
+// confirmBillPayment ... conclui o pagamento e, para boletos do MockServer, credita o recebedor e baixa o boleto
+func (m *MockServer) confirmBillPayment(payment *mockBillPayment) {
+	payment.Status = mockConfirmed
+	m.emit(MockEntityBillPayment, payment.Status, payment.ExecPaymentResponseBody)
+
+	authorization, found := m.state.BillAuthorizations[strconv.Itoa(payment.TransactionIDAuthorize)]
+	if !found {
+		return
+	}
+	charge, found := m.state.Charges[authorization.Charge]
+	if !found || charge.Status != mockChargeActive {
+		return
+	}
+	if receiver, found := m.state.Accounts[charge.Receiver.Account]; found {
+		payer := payment.Account
+		if account, found := m.state.Accounts[payment.Account]; found {
+			payer = account.Name
+		}
+		m.credit(receiver, payment.Amount, "BOLETOPAYMENTIN", charge.TransactionID, "Boleto recebido - "+payer)
+	}
+	m.confirmCharge(charge, "BOLETO")
+}
+
+// handleGetBillPayment ... consulta pelo clientRequestId ou pelo id
+func (m *MockServer) handleGetBillPayment(r *mockRequest) mockResponse {
+	clientRequestID, id := r.query("clientRequestId"), r.query("id")
+	if len(clientRequestID) == 0 && len(id) == 0 {
+		return paymentError(http.StatusBadRequest, "PCE016")
+	}
+
+	for _, payment := range m.state.BillPayments {
+		if (len(id) > 0 && payment.ID == id) || (len(clientRequestID) > 0 && payment.ClientRequestID == clientRequestID) {
+			account, _ := strconv.Atoi(payment.Account)
+			return mockJSON(http.StatusOK, GetPaymentResponse{
+				Body: GetPaymentResponseBody{
+					ID:                     payment.ID,
+					ClientRequestID:        payment.ClientRequestID,
+					Account:                account,
+					Amount:                 payment.Amount,
+					TransactionIDAuthorize: payment.TransactionIDAuthorize,
+					Tags:                   mockPaymentTags(payment.Tags),
+					BarCodeInfo:            GetPaymentBarCodeInfo{Digitable: payment.BarCodeInfo.Digitable},
+					PaymentDate:            payment.PaymentDate.Format(mockDateTimeLayout),
+				},
+				Status:  payment.Status,
+				Version: mockVersion,
+			})
+		}
+	}
+	return paymentError(http.StatusNotFound, "PCE018")
+}
+
+// mockPaymentTags ...
+func mockPaymentTags(tags []ExecPaymentTag) []GetPaymentTag {
+	result := []GetPaymentTag{}
+	for _, tag := range tags {
+		result = append(result, GetPaymentTag{Key: tag.Key, Value: tag.Value})
+	}
+	return result
+}
+
+// mockBarCode ... código de barras de 44 posições: banco, moeda, DV, fator de vencimento, valor e campo livre
+func mockBarCode(sequence int64, amount float64, dueDate time.Time) string {
+	days := int(dueDate.UTC().Truncate(24*time.Hour).Sub(mockBoletoBaseDate).Hours() / 24)
+	// o fator de vencimento reinicia em 1000 ao atingir 9999
+	factor := days
+	if factor > 9999 {
+		factor = (days-1000)%9000 + 1000
+	}
+	free := fmt.Sprintf("%025d", sequence)
+	partial := fmt.Sprintf("%s9%04d%010d%s", mockBoletoBank, factor, int64(math.Round(amount*100)), free)
+	return partial[:4] + strconv.Itoa(mod11BarCode(partial)) + partial[4:]
+}
+
+// parseMockBarCode ... valor e vencimento de um código de barras com DV válido
+func parseMockBarCode(barCode string) (float64, time.Time, bool) {
+	if len(barCode) != 44 || barCode[4:5] != strconv.Itoa(mod11BarCode(barCode[:4]+barCode[5:])) {
+		return 0, time.Time{}, false
+	}
+	factor, _ := strconv.Atoi(barCode[5:9])
+	cents, _ := strconv.ParseInt(barCode[9:19], 10, 64)
+	// o fator reinicia em 1000 a cada 9000 dias; usa o ciclo mais próximo da data atual
+	dueDate := mockBoletoBaseDate.AddDate(0, 0, factor)
+	for time.Since(dueDate) > 4500*24*time.Hour {
+		dueDate = dueDate.AddDate(0, 0, 9000)
+	}
+	return float64(cents) / 100, dueDate, true
+}
+
+// mockDigitableLine ... linha digitável de 47 posições do código de barras
+func mockDigitableLine(barCode string) string {
+	field1 := barCode[0:4] + barCode[19:24]
+	field2 := barCode[24:34]
+	field3 := barCode[34:44]
+	return field1 + strconv.Itoa(mod10BarCode(field1)) +
+		field2 + strconv.Itoa(mod10BarCode(field2)) +
+		field3 + strconv.Itoa(mod10BarCode(field3)) +
+		barCode[4:5] + barCode[5:19]
+}
+
+// mockBarCodeFromLine ... código de barras de uma linha digitável com DVs válidos
+func mockBarCodeFromLine(line string) (string, bool) {
+	if len(line) != 47 {
+		return "", false
+	}
+	for _, field := range [][2]int{{0, 9}, {10, 20}, {21, 31}} {
+		if line[field[1]:field[1]+1] != strconv.Itoa(mod10BarCode(line[field[0]:field[1]])) {
+			return "", false
+		}
+	}
+	return line[0:4] + line[32:47] + line[4:9] + line[10:20] + line[21:31], true
+}
+
+// mod10BarCode ... DV módulo 10 dos campos da linha digitável
+func mod10BarCode(digits string) int {
+	sum, weight := 0, 2
+	for i := len(digits) - 1; i >= 0; i-- {
+		product := int(digits[i]-'0') * weight
+		sum += product/10 + product%10
+		weight = 3 - weight
+	}
+	return (10 - sum%10) % 10
+}
+
+// mod11BarCode ... DV geral módulo 11 do código de barras, calculado sem a posição do DV
+func mod11BarCode(digits string) int {
+	sum, weight := 0, 2
+	for i := len(digits) - 1; i >= 0; i-- {
+		sum += int(digits[i]-'0') * weight
+		weight++
+		if weight > 9 {
+			weight = 2
+		}
+	}
+	dv := 11 - sum%11
+	if dv == 0 || dv == 10 || dv == 11 {
+		return 1
+	}
+	return dv
+}
+
+// ddaError ...
+func ddaError(code string) mockResponse {
+	return mockErroError(http.StatusBadRequest, ErrorDomainDda, code)
+}
+
+// handleRegisterDdaUser ... inscreve o documento no DDA
+func (m *MockServer) handleRegisterDdaUser(r *mockRequest) mockResponse {
+	var request DdaRegisterUserRequest
+	if err := r.decode(&request); err != nil {
+		return ddaError("CDDA100")
+	}
+	document := grok.OnlyDigits(request.Document)
+	if len(document) == 0 || len(request.ClientName) == 0 {
+		return ddaError("CDDA001")
+	}
+	if _, found := m.state.DdaUsers[document]; found {
+		return ddaError("CDDA102")
+	}
+	for _, user := range m.state.DdaUsers {
+		if len(request.ClientRequestId) > 0 && user.ClientRequestId == request.ClientRequestId {
+			return ddaError("CDDA107")
+		}
+	}
+
+	user := &DdaRegisterUserBodyResponse{
+		Document:        document,
+		ClientRequestId: request.ClientRequestId,
+		ResponseDate:    m.now().Format(mockDateTimeLayout),
+		Status:          "ACTIVE",
+		SubscriptionId:  uuid.New().String(),
+	}
+	m.state.DdaUsers[document] = user
+	m.emit(MockEntityDdaSubscription, user.Status, user)
+
+	return mockJSON(http.StatusCreated, DdaRegisterUserResponse{Status: http.StatusCreated, Body: user})
+}
+
+// handleDeleteDdaUser ... cancela a inscrição do documento no DDA
+func (m *MockServer) handleDeleteDdaUser(r *mockRequest) mockResponse {
+	var request DdaDeleteUserRequest
+	if err := r.decode(&request); err != nil {
+		return ddaError("CDDA100")
+	}
+	document := grok.OnlyDigits(request.Document)
+	if len(document) == 0 {
+		return ddaError("CDDA001")
+	}
+	user, found := m.state.DdaUsers[document]
+	if !found {
+		return ddaError("CDDA104")
+	}
+
+	delete(m.state.DdaUsers, document)
+	deleted := *user
+	deleted.ClientRequestId = request.ClientRequestId
+	deleted.ResponseDate = m.now().Format(mockDateTimeLayout)
+	deleted.Status = "DELETED"
+	m.emit(MockEntityDdaSubscription, deleted.Status, deleted)
+
+	return mockJSON(http.StatusOK, DdaRegisterUserResponse{Status: http.StatusOK, Body: &deleted})
+}
@@ -0,0 +1,1175 @@
+package celcoin
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/contbank/grok"
+	"github.com/google/uuid"
+)
+
+const (
+	// mockPixKeyLimitNaturalPerson ... limite de chaves de contas de pessoa física
+	mockPixKeyLimitNaturalPerson = 5
+	// mockPixKeyLimitLegalPerson ... limite de chaves de contas de pessoa jurídica
+	mockPixKeyLimitLegalPerson = 20
+
+	// mockPixChargeActive ... cobrança Pix aguardando pagamento
+	mockPixChargeActive = "ACTIVE"
+	// mockPixChargeConcluded ... cobrança Pix paga
+	mockPixChargeConcluded = "CONCLUDED"
+	// mockPixChargeRemoved ... cobrança Pix removida pelo recebedor
+	mockPixChargeRemoved = "REMOVED_BY_RECEIVING_USER"
+)
+
+// mockPixPayment ... Pix cash-out
+type mockPixPayment struct {
+	PixCashoutStatusTransactionBody
+	Status     string    `json:"status"`
+	CreateDate time.Time `json:"createDate"`
+}
+
+// mockPixReceipt ... Pix recebido por uma conta do MockServer
+type mockPixReceipt struct {
+	TransactionID             int64     `json:"transactionId"`
+	Account                   string    `json:"account"`
+	Amount                    float64   `json:"amount"`
+	EndToEndID                string    `json:"endToEndId"`
+	TransactionIdentification string    `json:"transactionIdentification"`
+	Description               string    `json:"description"`
+	CreateDate                time.Time `json:"createDate"`
+}
+
+// mockPixCharge ... cobrança Pix: QR code estático, imediato (COB) ou com vencimento (COBV)
+type mockPixCharge struct {
+	Kind                      string                     `json:"kind"`
+	TransactionID             int64                      `json:"transactionId"`
+	TransactionIdentification string                     `json:"transactionIdentification"`
+	Key                       string                     `json:"key"`
+	Account                   string                     `json:"account"`
+	Amount                    float64                    `json:"amount"`
+	Status                    string                     `json:"status"`
+	EMV                       string                     `json:"emv"`
+	LocationID                string                     `json:"locationId,omitempty"`
+	Immediate                 *PixCashInImmediateRequest `json:"immediate,omitempty"`
+	DueDate                   *PixCashInDueDateRequest   `json:"dueDate,omitempty"`
+	CreateDate                time.Time                  `json:"createDate"`
+	LastUpdate                time.Time                  `json:"lastUpdate"`
+}
+
+// bacenStatus ... status da cobrança no formato do payload do Banco Central
+func (c *mockPixCharge) bacenStatus() string {
+	switch c.Status {
+	case mockPixChargeConcluded:
+		return "CONCLUIDA"
+	case mockPixChargeRemoved:
+		return "REMOVIDA_PELO_USUARIO_RECEBEDOR"
+	}
+	return "ATIVA"
+}
+
+// mockLocation ... location de QR code dinâmico
+type mockLocation struct {
+	PixQrCodeLocationResponse
+	// Charge ... transactionId da cobrança vinculada à location
+	Charge int64 `json:"charge,omitempty"`
+}
+
+// registerPixRoutes ...
+func (m *MockServer) registerPixRoutes() {
+	m.handle(http.MethodPost, PixDictPath, m.handleCreatePixKey)
+	m.handle(http.MethodGet, PixDictPath+"/{account}", m.handleGetPixKeys)
+	m.handle(http.MethodDelete, PixDictPath+"/{key}", m.handleDeletePixKey)
+	m.handle(http.MethodGet, PixDictExternalEntryV2Path+"/{account}", m.handleGetExternalPixKey)
+	m.handle(http.MethodGet, PixDictExternalEntryV2Path, m.handleGetExternalPixKey)
+	m.handle(http.MethodPost, PixDictExternalEntryV2Path, m.handleGetExternalPixKeyDeprecated)
+
+	m.handle(http.MethodGet, PixPaymentV2Path+"/status", m.handlePixCashOutStatus)
+	m.handle(http.MethodPost, PixPaymentV2Path, m.handlePixCashOut)
+	m.handle(http.MethodGet, PixCashInStatusPath, m.handlePixCashInStatus)
+	m.handle(http.MethodPost, PixEmvPath, m.handleDecodeEMV)
+
+	m.handle(http.MethodPost, PixStaticPath, m.handlePixStatic)
+	m.handle(http.MethodPost, PixQrCodeLocationPath, m.handleQrCodeLocation)
+	m.handle(http.MethodPost, PixCashInDynamicPath+"/immediate", m.handleCreateImmediate)
+	m.handle(http.MethodGet, PixCashInDynamicPath+"/immediate/payload/{url}", m.handleImmediatePayload)
+	m.handle(http.MethodGet, PixCashInDynamicPath+"/immediate/{id}", m.handleGetImmediate)
+	m.handle(http.MethodPut, PixCashInDynamicPath+"/immediate/{id}", m.handleUpdateImmediate)
+	m.handle(http.MethodDelete, PixCashInDynamicPath+"/immediate/{id}", m.handleDeletePixCharge)
+	m.handle(http.MethodPost, PixCashInDynamicPath+"/duedate", m.handleCreateDueDate)
+	m.handle(http.MethodGet, PixCashInDynamicPath+"/duedate/payload/{url}", m.handleDueDatePayload)
+	m.handle(http.MethodGet, PixCashInDynamicPath+"/duedate/{id}", m.handleGetDueDate)
+	m.handle(http.MethodPut, PixCashInDynamicPath+"/duedate/{id}", m.handleUpdateDueDate)
+	m.handle(http.MethodDelete, PixCashInDynamicPath+"/duedate/{id}", m.handleDeletePixCharge)
+
+	m.handle(http.MethodPost, PixClaimPath, m.handleCreateClaim)
+	m.handle(http.MethodPost, PixClaimPath+"/confirm", m.handleConfirmClaim)
+	m.handle(http.MethodPost, PixClaimPath+"/cancel", m.handleCancelClaim)
+	m.handle(http.MethodGet, PixClaimPath, m.handleListClaims)
+	m.handle(http.MethodGet, PixClaimPath+"/{id}", m.handleGetClaim)
+}
+
+// pixError ...
+func pixError(code string) mockResponse {
+	return mockError(http.StatusBadRequest, ErrorDomainPix, code)
+}
+
+// pixKeyAccount ...
+func pixKeyAccount(key *MockPixKey) PixKeyAccount {
+	return PixKeyAccount{
+		Participant:   key.Participant,
+		Branch:        key.Branch,
+		Account:       key.Account,
+		AccountNumber: key.Account,
+		AccountType:   "TRAN",
+		CreateDate:    key.CreateDate,
+	}
+}
+
+// pixKeyOwner ...
+func pixKeyOwner(key *MockPixKey) PixKeyOwner {
+	ownerType := ProposalTypeNaturalPerson
+	if len(grok.OnlyDigits(key.DocumentNumber)) > 11 {
+		ownerType = ProposalTypeLegalPerson
+	}
+	return PixKeyOwner{Type: ownerType, DocumentNumber: key.DocumentNumber, Name: key.Name}
+}
+
+// activeAccount ... código de erro quando a conta não existe ou não está ativa
+func (m *MockServer) activeAccount(number string) (*MockAccount, string) {
+	account, found := m.state.Accounts[number]
+	switch {
+	case !found:
+		return nil, "CBE039"
+	case account.Status == MockAccountClosed:
+		return nil, "CBE176"
+	case account.Status == MockAccountBlocked:
+		return nil, "CBE177"
+	}
+	return account, ""
+}
+
+// handleCreatePixKey ...
+func (m *MockServer) handleCreatePixKey(r *mockRequest) mockResponse {
+	var request PixKeyRequest
+	if err := r.decode(&request); err != nil {
+		return pixError("CBE224")
+	}
+
+	account, code := m.activeAccount(request.Account)
+	if account == nil {
+		return pixError(code)
+	}
+
+	switch PixType(request.KeyType) {
+	case PixEVP:
+		if len(request.Key) > 0 {
+			return pixError("CBE178")
+		}
+		request.Key = uuid.New().String()
+	case PixCPF, PixCNPJ:
+		if grok.OnlyDigits(request.Key) != grok.OnlyDigits(account.DocumentNumber) {
+			return pixError("CBE181")
+		}
+	case PixEMAIL, PixPHONE:
+		if len(request.Key) == 0 {
+			return pixError("CBE179")
+		}
+	default:
+		return pixError("CBE173")
+	}
+
+	if existing, found := m.state.PixKeys[request.Key]; found {
+		if existing.internal() && existing.Account == account.Account {
+			return pixError("CBE236")
+		}
+		return pixError("CBE230")
+	}
+
+	limit := mockPixKeyLimitNaturalPerson
+	code = "CBE187"
+	if account.legalPerson() {
+		limit, code = mockPixKeyLimitLegalPerson, "CBE188"
+	}
+	if len(m.accountKeys(account.Account)) >= limit {
+		return pixError(code)
+	}
+
+	key := &MockPixKey{
+		Key:            request.Key,
+		KeyType:        request.KeyType,
+		Account:        account.Account,
+		Participant:    CelcoinBankISPB,
+		Branch:         account.Branch,
+		DocumentNumber: account.DocumentNumber,
+		Name:           account.Name,
+		CreateDate:     m.now(),
+	}
+	m.state.PixKeys[key.Key] = key
+
+	return mockJSON(http.StatusOK, PixKeyResponse{
+		Version: mockVersion,
+		Status:  "SUCCESS",
+		Body: PixKeyResponseBody{
+			KeyType: key.KeyType,
+			Key:     key.Key,
+			Account: pixKeyAccount(key),
+			Owner:   pixKeyOwner(key),
+		},
+	})
+}
+
+// handleGetPixKeys ...
+func (m *MockServer) handleGetPixKeys(r *mockRequest) mockResponse {
+	if _, found := m.state.Accounts[r.param("account")]; !found {
+		return pixError("CBE039")
+	}
+
+	items := []PixKeyListItem{}
+	for _, key := range m.accountKeys(r.param("account")) {
+		items = append(items, PixKeyListItem{
+			KeyType: key.KeyType,
+			Key:     key.Key,
+			Account: pixKeyAccount(key),
+			Owner:   pixKeyOwner(key),
+		})
+	}
+	return mockJSON(http.StatusOK, PixKeyListResponse{
+		Version: mockVersion,
+		Status:  "SUCCESS",
+		Body:    PixKeyListResponseBody{ListKeys: items},
+	})
+}
+
+// handleDeletePixKey ...
+func (m *MockServer) handleDeletePixKey(r *mockRequest) mockResponse {
+	var request struct {
+		Account string `json:"account"`
+	}
+	if err := r.decode(&request); err != nil {
+		return pixError("CBE224")
+	}
+
+	key, found := m.state.PixKeys[r.param("key")]
+	switch {
+	case !found || !key.internal():
+		return pixError("CBE180")
+	case key.Account != request.Account:
+		return pixError("CBE190")
+	}
+
+	delete(m.state.PixKeys, key.Key)
+	return mockSuccess(http.StatusOK)
+}
+
+// mockDictAccount ... conta da consulta ao DICT, com os campos das versões da API usadas pelo SDK
+type mockDictAccount struct {
+	Participant   string    `json:"participant"`
+	Branch        string    `json:"branch"`
+	Account       string    `json:"account"`
+	AccountNumber string    `json:"accountNumber"`
+	AccountType   string    `json:"accountType"`
+	CreateDate    time.Time `json:"createDate"`
+	OpeningDate   time.Time `json:"openingDate"`
+}
+
+// mockDictEntry ... resposta da consulta ao DICT
+type mockDictEntry struct {
+	KeyType          string          `json:"keyType"`
+	Key              string          `json:"key"`
+	Account          mockDictAccount `json:"account"`
+	Owner            PixKeyOwner     `json:"owner"`
+	EndToEndID       string          `json:"endtoEndId"`
+	CreationDate     time.Time       `json:"creationDate"`
+	KeyOwnershipDate time.Time       `json:"keyOwnershipDate"`
+	IsSameTaxID      bool            `json:"isSameTaxId"`
+}
+
+// dictEntry ... consulta a chave no DICT; o endtoEndId deve ser usado no pagamento seguinte
+func (m *MockServer) dictEntry(value, ownerTaxID string) mockResponse {
+	key, found := m.state.PixKeys[value]
+	if !found {
+		return mockError(http.StatusNotFound, ErrorDomainPix, "CBE180")
+	}
+
+	return mockJSON(http.StatusOK, map[string]interface{}{
+		"version": mockVersion,
+		"status":  "SUCCESS",
+		"body": mockDictEntry{
+			KeyType: key.KeyType,
+			Key:     key.Key,
+			Account: mockDictAccount{
+				Participant:   key.Participant,
+				Branch:        key.Branch,
+				Account:       key.Account,
+				AccountNumber: key.Account,
+				AccountType:   "TRAN",
+				CreateDate:    key.CreateDate,
+				OpeningDate:   key.CreateDate,
+			},
+			Owner:            pixKeyOwner(key),
+			EndToEndID:       m.newEndToEndID(),
+			CreationDate:     key.CreateDate,
+			KeyOwnershipDate: key.CreateDate,
+			IsSameTaxID:      len(ownerTaxID) > 0 && grok.OnlyDigits(ownerTaxID) == grok.OnlyDigits(key.DocumentNumber),
+		},
+	})
+}
+
+// handleGetExternalPixKey ...
+func (m *MockServer) handleGetExternalPixKey(r *mockRequest) mockResponse {
+	if len(r.query("key")) == 0 {
+		return pixError("CBE179")
+	}
+	return m.dictEntry(r.query("key"), r.query("ownerTaxId"))
+}
+
+// handleGetExternalPixKeyDeprecated ...
+func (m *MockServer) handleGetExternalPixKeyDeprecated(r *mockRequest) mockResponse {
+	var request struct {
+		PayerID string `json:"payerId"`
+		Key     string `json:"key"`
+	}
+	if err := r.decode(&request); err != nil {
+		return pixError("CBE224")
+	}
+	if len(request.Key) == 0 {
+		return pixError("CBE179")
+	}
+	return m.dictEntry(request.Key, request.PayerID)
+}
+
+// pixDestination ... conta do MockServer creditada pelo Pix; nil para contas de outras instituições
+func (m *MockServer) pixDestination(request PixCashOutRequest) (*MockAccount, string) {
+	if len(request.CreditParty.Key) > 0 {
+		key, found := m.state.PixKeys[request.CreditParty.Key]
+		if !found {
+			return nil, "CBE180"
+		}
+		if !key.internal() {
+			return nil, ""
+		}
+		return m.state.Accounts[key.Account], ""
+	}
+
+	if grok.OnlyDigits(request.CreditParty.Bank) != CelcoinBankISPB {
+		return nil, ""
+	}
+	account, found := m.state.Accounts[request.CreditParty.Account]
+	if !found || account.Status == MockAccountClosed {
+		return nil, "CBE117"
+	}
+	return account, ""
+}
+
+// handlePixCashOut ... debita a conta e, quando o recebedor é uma conta do MockServer, credita o destino.
+// A resposta é PROCESSING, como na Celcoin; a confirmação segue o atraso de SetSettlementDelay.
+func (m *MockServer) handlePixCashOut(r *mockRequest) mockResponse {
+	var request PixCashOutRequest
+	if err := r.decode(&request); err != nil {
+		return pixError("CBE224")
+	}
+
+	amount := roundCents(request.Amount)
+	switch {
+	case len(request.ClientCode) == 0:
+		return pixError("CBE001")
+	case amount <= 0:
+		return pixError("CBE095")
+	case len(request.DebitParty.Account) == 0:
+		return pixError("CBE108")
+	}
+	debtor, found := m.state.Accounts[request.DebitParty.Account]
+	if !found {
+		return pixError("CBE109")
+	}
+	for _, payment := range m.state.PixPayments {
+		if payment.ClientCode == request.ClientCode {
+			return pixError("CBE101")
+		}
+		if len(request.EndToEndId) > 0 && payment.EndToEndID == request.EndToEndId {
+			return pixError("CBE138")
+		}
+	}
+
+	_, code := m.pixDestination(request)
+	if len(code) > 0 {
+		return pixError(code)
+	}
+	if code := debitable(debtor, amount); len(code) > 0 {
+		return pixError(code)
+	}
+
+	endToEndID := request.EndToEndId
+	if len(endToEndID) == 0 {
+		endToEndID = m.newEndToEndID()
+	}
+	var transactionIdentification *string
+	if len(request.TransactionIdentification) > 0 {
+		transactionIdentification = String(request.TransactionIdentification)
+	}
+
+	debitParty := request.DebitParty
+	debitParty.Bank = CelcoinBankISPB
+	debitParty.Branch = debtor.Branch
+	debitParty.TaxId = debtor.DocumentNumber
+	debitParty.Name = debtor.Name
+	payment := &mockPixPayment{
+		PixCashoutStatusTransactionBody: PixCashoutStatusTransactionBody{
+			ID:                        uuid.New().String(),
+			Amount:                    amount,
+			ClientCode:                request.ClientCode,
+			TransactionIdentification: transactionIdentification,
+			EndToEndID:                endToEndID,
+			InitiationType:            request.InitiationType,
+			PaymentType:               request.PaymentType,
+			Urgency:                   request.Urgency,
+			TransactionType:           request.TransactionType,
+			DebitParty:                debitParty,
+			CreditParty:               request.CreditParty,
+			RemittanceInformation:     request.RemittanceInformation,
+		},
+		Status:     mockProcessing,
+		CreateDate: m.now(),
+	}
+	m.state.PixPayments[payment.ID] = payment
+
+	m.debit(debtor, amount, "PIXPAYMENTOUT", request.ClientCode, "Pix enviado - "+request.CreditParty.Name)
+	m.settle(mockSettlementPixPayment, payment.ID)
+
+	return mockJSON(http.StatusOK, PixCashOutResponse{
+		Status:  mockProcessing,
+		Version: mockVersion,
+		Body: PixCashOutResponseBody{
+			ID:                        payment.ID,
+			Amount:                    payment.Amount,
+			ClientCode:                payment.ClientCode,
+			TransactionIdentification: payment.TransactionIdentification,
+			EndToEndID:                payment.EndToEndID,
+			InitiationType:            payment.InitiationType,
+			PaymentType:               payment.PaymentType,
+			Urgency:                   payment.Urgency,
+			TransactionType:           payment.TransactionType,
+			DebitParty:                payment.DebitParty,
+			CreditParty:               payment.CreditParty,
+			RemittanceInformation:     payment.RemittanceInformation,
+		},
+	})
+}
+
+// confirmPixPayment ... conclui o cash-out e, quando o recebedor é uma conta do MockServer, credita o destino
+func (m *MockServer) confirmPixPayment(payment *mockPixPayment) {
+	payment.Status = mockConfirmed
+	m.emit(MockEntityPixPaymentOut, payment.Status, payment.PixCashoutStatusTransactionBody)
+
+	creditor, _ := m.pixDestination(PixCashOutRequest{CreditParty: payment.CreditParty})
+	if creditor == nil {
+		return
+	}
+	var charge *mockPixCharge
+	if payment.TransactionIdentification != nil {
+		charge = m.pixChargeByIdentification(*payment.TransactionIdentification)
+	}
+	m.receivePix(creditor, payment.Amount, "Pix recebido - "+payment.DebitParty.Name, payment, charge)
+}
+
+// receivePix ... credita um Pix recebido, conclui a cobrança paga e gera o webhook pix-payment-in.
+// payment é o cash-out de origem quando o pagador também é uma conta do MockServer.
+func (m *MockServer) receivePix(account *MockAccount, amount float64, description string,
+	payment *mockPixPayment, charge *mockPixCharge) *mockPixReceipt {
+	receipt := &mockPixReceipt{
+		TransactionID: m.nextSequence(),
+		Account:       account.Account,
+		Amount:        amount,
+		EndToEndID:    m.newEndToEndID(),
+		Description:   description,
+		CreateDate:    m.now(),
+	}
+	debitParty := DebitParty{Bank: "00000000", Name: "Pagador externo"}
+	if payment != nil {
+		receipt.EndToEndID = payment.EndToEndID
+		debitParty = payment.DebitParty
+	}
+	if charge != nil && charge.Account == account.Account && charge.Status == mockPixChargeActive {
+		charge.Status = mockPixChargeConcluded
+		charge.LastUpdate = m.now()
+		receipt.TransactionIdentification = charge.TransactionIdentification
+		if boleto := m.chargeByPix(charge.TransactionIdentification); boleto != nil && boleto.Status == mockChargeActive {
+			m.confirmCharge(boleto, "PIX")
+		}
+	}
+	m.state.PixReceipts[strconv.FormatInt(receipt.TransactionID, 10)] = receipt
+
+	m.credit(account, amount, "PIXPAYMENTIN", receipt.EndToEndID, description)
+	m.emit(MockEntityPixPaymentIn, "CONFIRMED", map[string]interface{}{
+		"id":                        receipt.TransactionID,
+		"amount":                    amount,
+		"endToEndId":                receipt.EndToEndID,
+		"transactionIdentification": receipt.TransactionIdentification,
+		"debitParty":                debitParty,
+		"creditParty": CreditParty{
+			Bank:    CelcoinBankISPB,
+			Account: account.Account,
+			Branch:  account.Branch,
+			TaxId:   account.DocumentNumber,
+			Name:    account.Name,
+		},
+	})
+	return receipt
+}
+
+// handlePixCashOutStatus ...
+func (m *MockServer) handlePixCashOutStatus(r *mockRequest) mockResponse {
+	id, endToEndID, clientCode := r.query("id"), r.query("endtoendId"), r.query("clientCode")
+	if len(id) == 0 && len(endToEndID) == 0 && len(clientCode) == 0 {
+		return pixError("CBE150")
+	}
+
+	for _, payment := range m.state.PixPayments {
+		if (len(id) > 0 && payment.ID == id) || (len(endToEndID) > 0 && payment.EndToEndID == endToEndID) ||
+			(len(clientCode) > 0 && payment.ClientCode == clientCode) {
+			return mockJSON(http.StatusOK, PixCashoutStatusTransactionResponse{
+				Status:  payment.Status,
+				Version: mockVersion,
+				Body:    payment.PixCashoutStatusTransactionBody,
+			})
+		}
+	}
+	return mockError(http.StatusNotFound, ErrorDomainPix, "CBE150")
+}
+
+// handlePixCashInStatus ... consulta um Pix recebido pelo transactionId
+func (m *MockServer) handlePixCashInStatus(r *mockRequest) mockResponse {
+	receipt, found := m.state.PixReceipts[r.query("transactionId")]
+	if !found {
+		return mockError(http.StatusNotFound, ErrorDomainPix, "CBE150")
+	}
+
+	return mockJSON(http.StatusOK, PixCashinStatusTransactionResponse{
+		Status:               "CONFIRMED",
+		ReturnIdentification: r.query("returnIdentification"),
+		TransactionId:        receipt.TransactionID,
+		TransactionIdPayment: receipt.TransactionID,
+		TransactionType:      "RECEIVEPIX",
+		Amount:               receipt.Amount,
+		CreatedAt:            receipt.CreateDate.Format(mockDateTimeLayout),
+	})
+}
+
+// handleDecodeEMV ...
+func (m *MockServer) handleDecodeEMV(r *mockRequest) mockResponse {
+	var request struct {
+		EMV string `json:"emv"`
+	}
+	if err := r.decode(&request); err != nil {
+		return pixError("CBE224")
+	}
+	emv, err := decodeMockEMV(request.EMV)
+	if err != nil {
+		return pixError("CBE226")
+	}
+
+	qrType := "STATIC"
+	var merchantURL interface{}
+	if len(emv.URL) > 0 {
+		qrType, merchantURL = "DYNAMIC", "https://"+emv.URL
+	}
+	mcc, _ := strconv.Atoi(emv.MerchantCategoryCode)
+	return mockJSON(http.StatusOK, QRCodeResponse{
+		Type:                   qrType,
+		PayloadFormatIndicator: "01",
+		MerchantAccountInformation: MerchantAccountInformation{
+			URL:                   merchantURL,
+			GUI:                   "br.gov.bcb.pix",
+			Key:                   emv.Key,
+			AdditionalInformation: emv.AdditionalInformation,
+		},
+		MerchantCategoryCode:      mcc,
+		TransactionCurrency:       986,
+		TransactionAmount:         emv.Amount,
+		CountryCode:               "BR",
+		MerchantName:              emv.MerchantName,
+		MerchantCity:              emv.MerchantCity,
+		PostalCode:                emv.PostalCode,
+		TransactionIdentification: emv.TransactionIdentification,
+	})
+}
+
+// chargeKey ... chave Pix de uma conta do MockServer que recebe a cobrança
+func (m *MockServer) chargeKey(value string) (*MockPixKey, string) {
+	key, found := m.state.PixKeys[value]
+	if !found || !key.internal() {
+		return nil, "CBE180"
+	}
+	return key, ""
+}
+
+// pixChargeByIdentification ...
+func (m *MockServer) pixChargeByIdentification(transactionIdentification string) *mockPixCharge {
+	for _, charge := range m.state.PixCharges {
+		if charge.TransactionIdentification == transactionIdentification {
+			return charge
+		}
+	}
+	return nil
+}
+
+// newTransactionIdentification ... txid de cobranças dinâmicas (26 a 35 caracteres)
+func newTransactionIdentification() string {
+	return strings.ReplaceAll(uuid.New().String(), "-", "")[:32]
+}
+
+// handlePixStatic ...
+func (m *MockServer) handlePixStatic(r *mockRequest) mockResponse {
+	var request PixCashInStaticRequest
+	if err := r.decode(&request); err != nil {
+		return pixError("CBE224")
+	}
+	key, code := m.chargeKey(request.Key)
+	if key == nil {
+		return pixError(code)
+	}
+
+	charge := &mockPixCharge{
+		Kind:                      "STATIC",
+		TransactionID:             m.nextSequence(),
+		TransactionIdentification: request.TransactionIdentification,
+		Key:                       key.Key,
+		Account:                   key.Account,
+		Amount:                    roundCents(request.Amount),
+		Status:                    mockPixChargeActive,
+		CreateDate:                m.now(),
+		LastUpdate:                m.now(),
+	}
+	charge.EMV = mockEMV{
+		Key:                       key.Key,
+		Amount:                    charge.Amount,
+		TransactionIdentification: request.TransactionIdentification,
+		AdditionalInformation:     request.AdditionalInformation,
+		MerchantCategoryCode:      request.Merchant.MerchantCategoryCode,
+		MerchantName:              request.Merchant.Name,
+		MerchantCity:              request.Merchant.City,
+		PostalCode:                request.Merchant.PostalCode,
+	}.encode()
+	m.state.PixCharges[strconv.FormatInt(charge.TransactionID, 10)] = charge
+
+	return mockJSON(http.StatusOK, PixCashInStaticResponse{
+		TransactionId:             int(charge.TransactionID),
+		EMVQRCode:                 charge.EMV,
+		TransactionIdentification: charge.TransactionIdentification,
+	})
+}
+
+// handleQrCodeLocation ...
+func (m *MockServer) handleQrCodeLocation(r *mockRequest) mockResponse {
+	var request PixQrCodeLocationRequest
+	if err := r.decode(&request); err != nil {
+		return pixError("CBE224")
+	}
+	if request.Type != "COB" && request.Type != "COBV" {
+		return pixError("CBE226")
+	}
+
+	location := &mockLocation{PixQrCodeLocationResponse: PixQrCodeLocationResponse{
+		LocationID:      m.nextSequence(),
+		Status:          "CREATED",
+		ClientRequestID: request.ClientRequestID,
+		URL:             fmt.Sprintf("pix.mock.celcoin.dev/qr/v2/%s/%s", strings.ToLower(request.Type), uuid.New().String()),
+		Type:            request.Type,
+		Merchant:        request.Merchant,
+	}}
+	location.EMV = mockEMV{
+		URL:                  location.URL,
+		MerchantCategoryCode: request.Merchant.MerchantCategoryCode,
+		MerchantName:         request.Merchant.Name,
+		MerchantCity:         request.Merchant.City,
+		PostalCode:           request.Merchant.PostalCode,
+	}.encode()
+	m.state.Locations[strconv.FormatInt(location.LocationID, 10)] = location
+
+	return mockJSON(http.StatusOK, location.PixQrCodeLocationResponse)
+}
+
+// chargeLocation ... location livre do tipo informado
+func (m *MockServer) chargeLocation(id int64, locationType string) (*mockLocation, string) {
+	location, found := m.state.Locations[strconv.FormatInt(id, 10)]
+	if !found || location.Type != locationType || location.Charge != 0 {
+		return nil, "DE004"
+	}
+	return location, ""
+}
+
+// pixLocation ...
+func (l *mockLocation) pixLocation() PixLocation {
+	return PixLocation{
+		Merchant: PixMerchant{
+			PostalCode:           l.Merchant.PostalCode,
+			City:                 l.Merchant.City,
+			MerchantCategoryCode: l.Merchant.MerchantCategoryCode,
+			Name:                 l.Merchant.Name,
+		},
+		URL:        l.URL,
+		EMV:        l.EMV,
+		Type:       l.Type,
+		LocationID: strconv.FormatInt(l.LocationID, 10),
+	}
+}
+
+// pixCharge ... cobrança dinâmica do tipo informado pelo transactionId do caminho
+func (m *MockServer) pixCharge(r *mockRequest, kind string) *mockPixCharge {
+	charge, found := m.state.PixCharges[r.param("id")]
+	if !found || charge.Kind != kind {
+		return nil
+	}
+	return charge
+}
+
+// parseMockAmount ... valor informado como texto nas cobranças imediatas
+func parseMockAmount(value *string) float64 {
+	if value == nil {
+		return 0
+	}
+	amount, _ := strconv.ParseFloat(strings.ReplaceAll(*value, ",", "."), 64)
+	return roundCents(amount)
+}
+
+// handleCreateImmediate ...
+func (m *MockServer) handleCreateImmediate(r *mockRequest) mockResponse {
+	var request PixCashInImmediateRequest
+	if err := r.decode(&request); err != nil {
+		return pixError("CBE224")
+	}
+	key, code := m.chargeKey(request.Key)
+	if key == nil {
+		return pixError(code)
+	}
+	location, code := m.chargeLocation(request.LocationID, "COB")
+	if location == nil {
+		return pixError(code)
+	}
+
+	charge := &mockPixCharge{
+		Kind:                      "IMMEDIATE",
+		TransactionID:             m.nextSequence(),
+		TransactionIdentification: newTransactionIdentification(),
+		Key:                       key.Key,
+		Account:                   key.Account,
+		Amount:                    parseMockAmount(request.Amount.Original),
+		Status:                    mockPixChargeActive,
+		EMV:                       location.EMV,
+		LocationID:                strconv.FormatInt(location.LocationID, 10),
+		Immediate:                 &request,
+		CreateDate:                m.now(),
+		LastUpdate:                m.now(),
+	}
+	location.Charge = charge.TransactionID
+	m.state.PixCharges[strconv.FormatInt(charge.TransactionID, 10)] = charge
+	return mockJSON(http.StatusOK, m.immediateResponse(charge))
+}
+
+// immediateResponse ...
+func (m *MockServer) immediateResponse(charge *mockPixCharge) PixCashInImmediateResponse {
+	request := charge.Immediate
+	response := PixCashInImmediateResponse{
+		TransactionID:             charge.TransactionID,
+		ClientRequestID:           request.ClientRequestID,
+		Status:                    charge.Status,
+		LastUpdate:                &charge.LastUpdate,
+		PayerQuestion:             request.PayerQuestion,
+		AdditionalInformation:     request.AdditionalInformation,
+		Debtor:                    request.Debtor,
+		Amount:                    request.Amount,
+		Key:                       charge.Key,
+		Calendar:                  request.Calendar,
+		CreatedAt:                 charge.CreateDate,
+		TransactionIdentification: charge.TransactionIdentification,
+	}
+	if location, found := m.state.Locations[charge.LocationID]; found {
+		response.Location = location.pixLocation()
+	}
+	return response
+}
+
+// handleGetImmediate ...
+func (m *MockServer) handleGetImmediate(r *mockRequest) mockResponse {
+	charge := m.pixCharge(r, "IMMEDIATE")
+	if charge == nil {
+		return mockError(http.StatusNotFound, ErrorDomainPix, "CBE351")
+	}
+	return mockJSON(http.StatusOK, m.immediateResponse(charge))
+}
+
+// handleUpdateImmediate ...
+func (m *MockServer) handleUpdateImmediate(r *mockRequest) mockResponse {
+	charge := m.pixCharge(r, "IMMEDIATE")
+	if charge == nil {
+		return mockError(http.StatusNotFound, ErrorDomainPix, "CBE351")
+	}
+	if charge.Status != mockPixChargeActive {
+		return pixError("CBE410")
+	}
+
+	var request PixCashInImmediateRequest
+	if err := r.decode(&request); err != nil {
+		return pixError("CBE224")
+	}
+	request.Key = charge.Key
+	charge.Immediate = &request
+	charge.Amount = parseMockAmount(request.Amount.Original)
+	charge.LastUpdate = m.now()
+	return mockJSON(http.StatusOK, m.immediateResponse(charge))
+}
+
+// handleDeletePixCharge ...
+func (m *MockServer) handleDeletePixCharge(r *mockRequest) mockResponse {
+	charge, found := m.state.PixCharges[r.param("id")]
+	if !found || (charge.Kind != "IMMEDIATE" && charge.Kind != "DUEDATE") {
+		return mockError(http.StatusNotFound, ErrorDomainPix, "CBE351")
+	}
+	if charge.Status != mockPixChargeActive {
+		return pixError("CBE410")
+	}
+
+	charge.Status = mockPixChargeRemoved
+	charge.LastUpdate = m.now()
+	return mockJSON(http.StatusOK, PixDeleteResponse{
+		Message:       "Charge removed successfully",
+		TransactionID: charge.TransactionID,
+		Status:        http.StatusOK,
+	})
+}
+
+// handleCreateDueDate ...
+func (m *MockServer) handleCreateDueDate(r *mockRequest) mockResponse {
+	var request PixCashInDueDateRequest
+	if err := r.decode(&request); err != nil {
+		return pixError("CBE224")
+	}
+	key, code := m.chargeKey(request.Key)
+	if key == nil {
+		return pixError(code)
+	}
+	location, code := m.chargeLocation(request.LocationID, "COBV")
+	if location == nil {
+		return pixError(code)
+	}
+
+	charge := &mockPixCharge{
+		Kind:                      "DUEDATE",
+		TransactionID:             m.nextSequence(),
+		TransactionIdentification: newTransactionIdentification(),
+		Key:                       key.Key,
+		Account:                   key.Account,
+		Amount:                    roundCents(request.Amount),
+		Status:                    mockPixChargeActive,
+		EMV:                       location.EMV,
+		LocationID:                strconv.FormatInt(location.LocationID, 10),
+		DueDate:                   &request,
+		CreateDate:                m.now(),
+		LastUpdate:                m.now(),
+	}
+	location.Charge = charge.TransactionID
+	m.state.PixCharges[strconv.FormatInt(charge.TransactionID, 10)] = charge
+	return mockJSON(http.StatusOK, m.dueDateResponse(charge))
+}
+
+// dueDateResponse ...
+func (m *MockServer) dueDateResponse(charge *mockPixCharge) PixCashInDueDateResponse {
+	request := charge.DueDate
+	amount := charge.Amount
+	response := PixCashInDueDateResponse{
+		TransactionIdentification: charge.TransactionIdentification,
+		TransactionID:             charge.TransactionID,
+		ClientRequestID:           request.ClientRequestID,
+		Status:                    charge.Status,
+		LastUpdate:                &charge.LastUpdate,
+		PayerQuestion:             request.PayerQuestion,
+		AdditionalInformation:     request.AdditionalInformation,
+		Debtor:                    request.Debtor,
+		Amount:                    PixAmountCashIn{Original: &amount, Final: &amount},
+		Key:                       charge.Key,
+		Receiver:                  request.Receiver,
+		Calendar: PixCalendar{
+			DueDate:                 request.DueDate,
+			ExpirationAfterPayment:  strconv.Itoa(request.ExpirationAfterPayment),
+			ValidateAfterExpiration: request.ExpirationAfterPayment,
+		},
+		CreateAt: charge.CreateDate,
+	}
+	if location, found := m.state.Locations[charge.LocationID]; found {
+		pixLocation := location.pixLocation()
+		response.Location = &pixLocation
+	}
+	return response
+}
+
+// handleGetDueDate ...
+func (m *MockServer) handleGetDueDate(r *mockRequest) mockResponse {
+	charge := m.pixCharge(r, "DUEDATE")
+	if charge == nil {
+		return mockError(http.StatusNotFound, ErrorDomainPix, "CBE351")
+	}
+	return mockJSON(http.StatusOK, m.dueDateResponse(charge))
+}
+
+// handleUpdateDueDate ...
+func (m *MockServer) handleUpdateDueDate(r *mockRequest) mockResponse {
+	charge := m.pixCharge(r, "DUEDATE")
+	if charge == nil {
+		return mockError(http.StatusNotFound, ErrorDomainPix, "CBE351")
+	}
+	if charge.Status != mockPixChargeActive {
+		return pixError("CBE410")
+	}
+
+	var request PixCashInDueDateRequest
+	if err := r.decode(&request); err != nil {
+		return pixError("CBE224")
+	}
+	request.Key = charge.Key
+	charge.DueDate = &request
+	charge.Amount = roundCents(request.Amount)
+	charge.LastUpdate = m.now()
+	return mockJSON(http.StatusOK, m.dueDateResponse(charge))
+}
+
+// payloadCharge ... cobrança vinculada à location da URL do QR code dinâmico
+func (m *MockServer) payloadCharge(r *mockRequest) *mockPixCharge {
+	merchantURL, err := url.PathUnescape(r.param("url"))
+	if err != nil {
+		return nil
+	}
+	for _, location := range m.state.Locations {
+		if location.URL == merchantURL && location.Charge != 0 {
+			return m.state.PixCharges[strconv.FormatInt(location.Charge, 10)]
+		}
+	}
+	return nil
+}
+
+// handleImmediatePayload ... payload da cobrança imediata no formato do Banco Central
+func (m *MockServer) handleImmediatePayload(r *mockRequest) mockResponse {
+	charge := m.payloadCharge(r)
+	if charge == nil || charge.Immediate == nil {
+		return mockError(http.StatusNotFound, ErrorDomainPix, "CBE351")
+	}
+
+	amount := formatMockAmount(charge.Amount)
+	return mockJSON(http.StatusOK, QRCodeImmediateResponse{
+		Status: charge.bacenStatus(),
+		TxID:   charge.TransactionIdentification,
+		Chave:  charge.Key,
+		Valor:  QRCodeValor{Original: amount, Final: amount},
+		Calendario: QRCodeCalendario{
+			Criacao:      charge.CreateDate.Format(time.RFC3339),
+			Apresentacao: m.now().Format(time.RFC3339),
+			Expiracao:    charge.Immediate.Calendar.Expiration,
+		},
+	})
+}
+
+// handleDueDatePayload ... payload da cobrança com vencimento
+func (m *MockServer) handleDueDatePayload(r *mockRequest) mockResponse {
+	charge := m.payloadCharge(r)
+	if charge == nil || charge.DueDate == nil {
+		return mockError(http.StatusNotFound, ErrorDomainPix, "CBE351")
+	}
+
+	amount := formatMockAmount(charge.Amount)
+	return mockJSON(http.StatusOK, QRCodeDueDateResponse{
+		Calendar: PixCalendar{
+			CreatedAt:    charge.CreateDate.Format(time.RFC3339),
+			DueDate:      charge.DueDate.DueDate,
+			Presentation: m.now().Format(time.RFC3339),
+		},
+		Debtor:        charge.DueDate.Debtor,
+		Receiver:      charge.DueDate.Receiver,
+		TransactionID: charge.TransactionIdentification,
+		Revision:      "0",
+		Status:        charge.bacenStatus(),
+		Key:           charge.Key,
+		Amount:        PixQrCodeAmount{Original: &amount, Final: &amount},
+	})
+}
+
+// claimResponse ...
+func claimResponse(claim *PixClaimResponseBody) PixClaimResponse {
+	return PixClaimResponse{Version: mockVersion, Status: "SUCCESS", Body: *claim}
+}
+
+// handleCreateClaim ... reivindicação de posse; a chave pode estar em outra conta do MockServer ou no DICT externo
+func (m *MockServer) handleCreateClaim(r *mockRequest) mockResponse {
+	var request PixClaimRequest
+	if err := r.decode(&request); err != nil {
+		return pixError("CBE224")
+	}
+
+	account, code := m.activeAccount(request.Account)
+	switch {
+	case account == nil:
+		return pixError(code)
+	case len(request.ClaimType) == 0:
+		return pixError("CBE346")
+	case request.ClaimType != string(Ownership) && request.ClaimType != string(Portability):
+		return pixError("CBE349")
+	case PixType(request.KeyType) == PixEVP:
+		return pixError("CBE286")
+	case PixType(request.KeyType) == PixCPF || PixType(request.KeyType) == PixCNPJ:
+		return pixError("CBE287")
+	}
+	for _, claim := range m.state.Claims {
+		if claim.Key == request.Key && (claim.Status == string(Open) || claim.Status == string(WaitingResolution)) {
+			return pixError("CBE290")
+		}
+	}
+
+	donorParticipant := "00000000"
+	donorAccount := PixClaimKeyAccount{}
+	if key, found := m.state.PixKeys[request.Key]; found {
+		if key.internal() && key.Account == account.Account {
+			return pixError("CBE236")
+		}
+		donorParticipant = key.Participant
+		donorAccount = PixClaimKeyAccount{
+			Participant: key.Participant,
+			Branch:      key.Branch,
+			Account:     key.Account,
+			TaxID:       key.DocumentNumber,
+			Name:        key.Name,
+		}
+	}
+
+	now := m.now()
+	claim := &PixClaimResponseBody{
+		ID:        uuid.New().String(),
+		ClaimType: request.ClaimType,
+		Key:       request.Key,
+		KeyType:   request.KeyType,
+		ClaimerAccount: PixClaimKeyAccount{
+			Participant: CelcoinBankISPB,
+			Branch:      account.Branch,
+			Account:     account.Account,
+			AccountType: "TRAN",
+		},
+		Claimer: PixClaimKeyOwner{
+			PersonType: string(account.personType()),
+			TaxID:      account.DocumentNumber,
+			Name:       account.Name,
+		},
+		DonorParticipant:    donorParticipant,
+		DonorAccount:        donorAccount,
+		Status:              string(Open),
+		CreateTimestamp:     now.Format(time.RFC3339),
+		ResolutionPeriodEnd: now.Add(7 * 24 * time.Hour).Format(time.RFC3339),
+		CompletionPeriodEnd: now.Add(14 * 24 * time.Hour).Format(time.RFC3339),
+		LastModified:        now.Format(time.RFC3339),
+	}
+	m.state.Claims[claim.ID] = claim
+	return mockJSON(http.StatusOK, claimResponse(claim))
+}
+
+// actionClaim ...
+func (m *MockServer) actionClaim(r *mockRequest) (*PixClaimResponseBody, PixClaimActionRequest, string) {
+	var request PixClaimActionRequest
+	if err := r.decode(&request); err != nil {
+		return nil, request, "CBE224"
+	}
+	if len(request.ID) == 0 {
+		return nil, request, "CBE303"
+	}
+	claim, found := m.state.Claims[request.ID]
+	if !found {
+		return nil, request, "CBE320"
+	}
+	if claim.Status != string(Open) && claim.Status != string(WaitingResolution) {
+		return nil, request, "CBE306"
+	}
+	return claim, request, ""
+}
+
+// handleConfirmClaim ... confirma a reivindicação e transfere a chave para a conta reivindicadora
+func (m *MockServer) handleConfirmClaim(r *mockRequest) mockResponse {
+	claim, request, code := m.actionClaim(r)
+	if claim == nil {
+		return pixError(code)
+	}
+
+	claim.Status = string(Confirmed)
+	claim.ConfirmReason = request.Reason
+	claim.LastModified = m.now().Format(time.RFC3339)
+	if account, found := m.state.Accounts[claim.ClaimerAccount.Account]; found {
+		m.state.PixKeys[claim.Key] = &MockPixKey{
+			Key:            claim.Key,
+			KeyType:        claim.KeyType,
+			Account:        account.Account,
+			Participant:    CelcoinBankISPB,
+			Branch:         account.Branch,
+			DocumentNumber: account.DocumentNumber,
+			Name:           account.Name,
+			CreateDate:     m.now(),
+		}
+	}
+	return mockJSON(http.StatusOK, claimResponse(claim))
+}
+
+// handleCancelClaim ...
+func (m *MockServer) handleCancelClaim(r *mockRequest) mockResponse {
+	claim, request, code := m.actionClaim(r)
+	if claim == nil {
+		return pixError(code)
+	}
+
+	claim.Status = string(CanceledClaim)
+	claim.CancelReason = request.Reason
+	claim.CancelledBy = "CLAIMER"
+	claim.LastModified = m.now().Format(time.RFC3339)
+	return mockJSON(http.StatusOK, claimResponse(claim))
+}
+
+// handleGetClaim ...
+func (m *MockServer) handleGetClaim(r *mockRequest) mockResponse {
+	claim, found := m.state.Claims[r.param("id")]
+	if !found {
+		return pixError("CBE320")
+	}
+	return mockJSON(http.StatusOK, claimResponse(claim))
+}
+
+// handleListClaims ... reivindicações filtradas por período, status e tipo, paginadas por LimitPerPage e Page
+func (m *MockServer) handleListClaims(r *mockRequest) mockResponse {
+	status, claimType := r.query("Status"), r.query("claimType")
+	claims := []PixClaimResponseBody{}
+	for _, claim := range m.state.Claims {
+		created, _ := time.Parse(time.RFC3339, claim.CreateTimestamp)
+		if (len(status) > 0 && claim.Status != status) || (len(claimType) > 0 && claim.ClaimType != claimType) ||
+			!inMockRange(created, r.query("DateFrom"), r.query("DateTo")) {
+			continue
+		}
+		claims = append(claims, *claim)
+	}
+	sort.Slice(claims, func(i, j int) bool { return claims[i].CreateTimestamp < claims[j].CreateTimestamp })
+
+	limit, page := mockIntQuery(r, "LimitPerPage", 50), mockIntQuery(r, "Page", 1)
+	if limit > 0 && page > 0 {
+		start := (page - 1) * limit
+		if start > len(claims) {
+			start = len(claims)
+		}
+		end := start + limit
+		if end > len(claims) {
+			end = len(claims)
+		}
+		claims = claims[start:end]
+	}
+
+	return mockJSON(http.StatusOK, PixClaimListResponse{
+		Version: mockVersion,
+		Status:  "SUCCESS",
+		Body:    PixClaimListResponseBody{Claims: claims},
+	})
+}
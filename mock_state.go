@@ -0,0 +1,451 @@
+package celcoin
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/contbank/grok"
+	"github.com/google/uuid"
+)
+
+const (
+	// MockAccountActive ... conta ativa
+	MockAccountActive = "ACTIVE"
+	// MockAccountBlocked ... conta bloqueada; não pode ser debitada
+	MockAccountBlocked = "BLOCKED"
+	// MockAccountClosed ... conta encerrada
+	MockAccountClosed = "CLOSED"
+
+	// mockDateTimeLayout ... formato de data e hora das respostas da Celcoin
+	mockDateTimeLayout = "2006-01-02T15:04:05"
+	// mockDateLayout ...
+	mockDateLayout = "2006-01-02"
+)
+
+var (
+	// ErrMockAccountNotFound ... conta não cadastrada no MockServer
+	ErrMockAccountNotFound = errors.New("mock account not found")
+	// ErrMockChargeNotFound ... cobrança Pix não cadastrada no MockServer
+	ErrMockChargeNotFound = errors.New("mock pix charge not found")
+)
+
+// MockAccount ... conta de pagamento do MockServer
+type MockAccount struct {
+	Account        string `json:"account"`
+	Branch         string `json:"branch"`
+	DocumentNumber string `json:"documentNumber"`
+	Name           string `json:"name"`
+	ClientCode     string `json:"clientCode"`
+	Email          string `json:"email"`
+	PhoneNumber    string `json:"phoneNumber"`
+	// Status ... MockAccountActive, MockAccountBlocked ou MockAccountClosed
+	Status string `json:"status"`
+	// Balance ... saldo atual; em AddAccount é o saldo inicial, lançado como crédito no extrato
+	Balance    float64   `json:"balance"`
+	CreateDate time.Time `json:"createDate"`
+}
+
+// legalPerson ... conta de pessoa jurídica (CNPJ)
+func (a *MockAccount) legalPerson() bool {
+	return len(grok.OnlyDigits(a.DocumentNumber)) > 11
+}
+
+// ownerType ... tipo do titular no formato do DICT
+func (a *MockAccount) ownerType() string {
+	if a.legalPerson() {
+		return ProposalTypeLegalPerson
+	}
+	return ProposalTypeNaturalPerson
+}
+
+// personType ...
+func (a *MockAccount) personType() PersonType {
+	if a.legalPerson() {
+		return LegalPersonType
+	}
+	return NaturalPersonType
+}
+
+// MockPixKey ... chave Pix do DICT do MockServer. Chaves com Participant diferente de CelcoinBankISPB
+// representam contas de outras instituições: podem ser consultadas e receber pagamentos, sem crédito no MockServer.
+type MockPixKey struct {
+	Key     string `json:"key"`
+	KeyType string `json:"keyType"`
+	Account string `json:"account"`
+	// Participant ... ISPB da instituição da conta (padrão CelcoinBankISPB)
+	Participant    string    `json:"participant"`
+	Branch         string    `json:"branch"`
+	DocumentNumber string    `json:"documentNumber"`
+	Name           string    `json:"name"`
+	CreateDate     time.Time `json:"createDate"`
+}
+
+// internal ... a chave pertence a uma conta do MockServer
+func (k *MockPixKey) internal() bool {
+	return k.Participant == CelcoinBankISPB
+}
+
+// MockWebhookEvent ... webhook enviado às assinaturas ativas da entidade
+type MockWebhookEvent struct {
+	WebhookID       string          `json:"webhookId"`
+	Entity          string          `json:"entity"`
+	CreateTimestamp time.Time       `json:"createTimestamp"`
+	Status          string          `json:"status"`
+	Body            json.RawMessage `json:"body"`
+}
+
+// mockMovement ... lançamento no extrato de uma conta
+type mockMovement struct {
+	StatementMovement
+	Date time.Time `json:"date"`
+}
+
+// mockWebhookRecord ... webhook gerado e o resultado da entrega
+type mockWebhookRecord struct {
+	Event     MockWebhookEvent `json:"event"`
+	Delivered bool             `json:"delivered"`
+	Attempts  int              `json:"attempts"`
+}
+
+// mockState ... estado do MockServer; todos os campos são serializáveis em JSON
+type mockState struct {
+	Sequence           int64                                   `json:"sequence"`
+	Accounts           map[string]*MockAccount                 `json:"accounts"`
+	Movements          map[string][]mockMovement               `json:"movements"`
+	PixKeys            map[string]*MockPixKey                  `json:"pixKeys"`
+	PixPayments        map[string]*mockPixPayment              `json:"pixPayments"`
+	PixReceipts        map[string]*mockPixReceipt              `json:"pixReceipts"`
+	PixCharges         map[string]*mockPixCharge               `json:"pixCharges"`
+	Locations          map[string]*mockLocation                `json:"locations"`
+	Claims             map[string]*PixClaimResponseBody        `json:"claims"`
+	Transfers          map[string]*mockTransfer                `json:"transfers"`
+	Charges            map[string]*mockCharge                  `json:"charges"`
+	BillAuthorizations map[string]*mockBillAuthorization       `json:"billAuthorizations"`
+	BillPayments       map[string]*mockBillPayment             `json:"billPayments"`
+	DdaUsers           map[string]*DdaRegisterUserBodyResponse `json:"ddaUsers"`
+	DdaWebhooks        map[string]*WebhookSubscriptionDdaBody  `json:"ddaWebhooks"`
+	Webhooks           map[string]*WebhookSubscription         `json:"webhooks"`
+	WebhookEvents      []*mockWebhookRecord                    `json:"webhookEvents"`
+	Proposals          map[string]*Proposal                    `json:"proposals"`
+	Settlements        []*mockSettlement                       `json:"settlements"`
+}
+
+// newMockState ...
+func newMockState() *mockState {
+	return &mockState{
+		Accounts:           make(map[string]*MockAccount),
+		Movements:          make(map[string][]mockMovement),
+		PixKeys:            make(map[string]*MockPixKey),
+		PixPayments:        make(map[string]*mockPixPayment),
+		PixReceipts:        make(map[string]*mockPixReceipt),
+		PixCharges:         make(map[string]*mockPixCharge),
+		Locations:          make(map[string]*mockLocation),
+		Claims:             make(map[string]*PixClaimResponseBody),
+		Transfers:          make(map[string]*mockTransfer),
+		Charges:            make(map[string]*mockCharge),
+		BillAuthorizations: make(map[string]*mockBillAuthorization),
+		BillPayments:       make(map[string]*mockBillPayment),
+		DdaUsers:           make(map[string]*DdaRegisterUserBodyResponse),
+		DdaWebhooks:        make(map[string]*WebhookSubscriptionDdaBody),
+		Webhooks:           make(map[string]*WebhookSubscription),
+		Proposals:          make(map[string]*Proposal),
+	}
+}
+
+// nextSequence ... identificador numérico crescente, usado em contas, cobranças e autorizações
+func (m *MockServer) nextSequence() int64 {
+	m.state.Sequence++
+	return m.state.Sequence
+}
+
+// AddAccount ... cadastra uma conta. Agência, número da conta, clientCode e status recebem padrões quando vazios;
+// um saldo inicial é lançado como crédito no extrato.
+func (m *MockServer) AddAccount(account MockAccount) MockAccount {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return *m.addAccount(account)
+}
+
+// addAccount ...
+func (m *MockServer) addAccount(account MockAccount) *MockAccount {
+	sequence := m.nextSequence()
+	if len(account.Branch) == 0 {
+		account.Branch = MockDefaultBranch
+	}
+	if len(account.Account) == 0 {
+		account.Account = fmt.Sprintf("3%07d", sequence)
+	}
+	if len(account.ClientCode) == 0 {
+		account.ClientCode = uuid.New().String()
+	}
+	if len(account.Status) == 0 {
+		account.Status = MockAccountActive
+	}
+	if account.CreateDate.IsZero() {
+		account.CreateDate = m.now()
+	}
+
+	opening := roundCents(account.Balance)
+	account.Balance = 0
+	stored := &account
+	m.state.Accounts[account.Account] = stored
+	if opening > 0 {
+		m.credit(stored, opening, "ENTRYCREDIT", account.ClientCode, "Saldo inicial")
+	}
+	return stored
+}
+
+// Account ... conta cadastrada com o número informado
+func (m *MockServer) Account(number string) (MockAccount, bool) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	account, found := m.state.Accounts[number]
+	if !found {
+		return MockAccount{}, false
+	}
+	return *account, true
+}
+
+// AddPixKey ... cadastra uma chave Pix. Para contas do MockServer, titular e agência são obtidos da conta.
+func (m *MockServer) AddPixKey(key MockPixKey) (MockPixKey, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	stored, err := m.addPixKey(key)
+	if err != nil {
+		return MockPixKey{}, err
+	}
+	return *stored, nil
+}
+
+// addPixKey ...
+func (m *MockServer) addPixKey(key MockPixKey) (*MockPixKey, error) {
+	if len(key.Participant) == 0 {
+		key.Participant = CelcoinBankISPB
+	}
+	if key.internal() {
+		account, found := m.state.Accounts[key.Account]
+		if !found {
+			return nil, ErrMockAccountNotFound
+		}
+		key.Branch = account.Branch
+		key.DocumentNumber = account.DocumentNumber
+		key.Name = account.Name
+	}
+	if len(key.KeyType) == 0 {
+		key.KeyType = string(PixEVP)
+	}
+	if len(key.Key) == 0 {
+		key.Key = uuid.New().String()
+	}
+	if key.CreateDate.IsZero() {
+		key.CreateDate = m.now()
+	}
+	m.state.PixKeys[key.Key] = &key
+	return &key, nil
+}
+
+// CashIn ... simula um Pix recebido de outra instituição, creditando a conta e gerando o webhook pix-payment-in
+func (m *MockServer) CashIn(account string, amount float64, description string) error {
+	m.mutex.Lock()
+	defer m.flush()
+
+	target, found := m.state.Accounts[account]
+	if !found {
+		return ErrMockAccountNotFound
+	}
+	m.receivePix(target, roundCents(amount), description, nil, nil)
+	return nil
+}
+
+// PayPixCharge ... simula o pagamento, por outra instituição, da cobrança Pix (QR code estático, imediato
+// ou com vencimento) com o transactionIdentification informado
+func (m *MockServer) PayPixCharge(transactionIdentification string) error {
+	m.mutex.Lock()
+	defer m.flush()
+
+	charge := m.pixChargeByIdentification(transactionIdentification)
+	if charge == nil {
+		return ErrMockChargeNotFound
+	}
+	account, found := m.state.Accounts[charge.Account]
+	if !found {
+		return ErrMockAccountNotFound
+	}
+	m.receivePix(account, charge.Amount, "Pix recebido", nil, charge)
+	return nil
+}
+
+// WebhookEvents ... webhooks gerados para a entidade, entregues ou não, em ordem de criação
+func (m *MockServer) WebhookEvents(entity string) []MockWebhookEvent {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	var events []MockWebhookEvent
+	for _, record := range m.state.WebhookEvents {
+		if record.Event.Entity == entity {
+			events = append(events, record.Event)
+		}
+	}
+	return events
+}
+
+// flush ... libera o mutex e entrega os webhooks gerados, para os métodos chamados fora de uma requisição
+func (m *MockServer) flush() {
+	outbox := m.outbox
+	m.outbox = nil
+	m.mutex.Unlock()
+	m.deliver(outbox)
+}
+
+// debit ... debita a conta e lança o movimento no extrato
+func (m *MockServer) debit(account *MockAccount, amount float64, movementType, clientCode, description string) {
+	account.Balance = roundCents(account.Balance - amount)
+	m.addMovement(account, amount, "DEBIT", movementType, clientCode, description)
+}
+
+// credit ... credita a conta e lança o movimento no extrato
+func (m *MockServer) credit(account *MockAccount, amount float64, movementType, clientCode, description string) {
+	account.Balance = roundCents(account.Balance + amount)
+	m.addMovement(account, amount, "CREDIT", movementType, clientCode, description)
+}
+
+// addMovement ...
+func (m *MockServer) addMovement(account *MockAccount, amount float64, balanceType, movementType, clientCode, description string) {
+	now := m.now()
+	m.state.Movements[account.Account] = append(m.state.Movements[account.Account], mockMovement{
+		StatementMovement: StatementMovement{
+			ID:             uuid.New().String(),
+			ClientCode:     clientCode,
+			Description:    description,
+			CreateDate:     now.Format(mockDateTimeLayout),
+			LastUpdateDate: now.Format(mockDateTimeLayout),
+			Amount:         amount,
+			Status:         "CONFIRMED",
+			BalanceType:    balanceType,
+			MovementType:   movementType,
+		},
+		Date: now,
+	})
+}
+
+// debitable ... código de erro Pix quando a conta não pode ser debitada no valor informado
+func debitable(account *MockAccount, amount float64) string {
+	switch {
+	case account.Status == MockAccountClosed:
+		return "CBE124"
+	case account.Status == MockAccountBlocked:
+		return "CBE159"
+	case account.Balance < amount:
+		return "CBE123"
+	}
+	return ""
+}
+
+// accountByDocument ... conta não encerrada do documento, ou a mais recente
+func (m *MockServer) accountByDocument(documentNumber string) *MockAccount {
+	documentNumber = grok.OnlyDigits(documentNumber)
+	var found *MockAccount
+	for _, account := range m.sortedAccounts() {
+		if grok.OnlyDigits(account.DocumentNumber) != documentNumber {
+			continue
+		}
+		if found == nil || found.Status == MockAccountClosed {
+			found = account
+		}
+	}
+	return found
+}
+
+// findAccount ... conta pelo número ou, quando não informado, pelo documento
+func (m *MockServer) findAccount(number, documentNumber string) *MockAccount {
+	if len(number) > 0 {
+		return m.state.Accounts[number]
+	}
+	if len(documentNumber) > 0 {
+		return m.accountByDocument(documentNumber)
+	}
+	return nil
+}
+
+// sortedAccounts ... contas em ordem de número
+func (m *MockServer) sortedAccounts() []*MockAccount {
+	accounts := make([]*MockAccount, 0, len(m.state.Accounts))
+	for _, account := range m.state.Accounts {
+		accounts = append(accounts, account)
+	}
+	sort.Slice(accounts, func(i, j int) bool { return accounts[i].Account < accounts[j].Account })
+	return accounts
+}
+
+// accountKeys ... chaves Pix da conta em ordem de criação
+func (m *MockServer) accountKeys(account string) []*MockPixKey {
+	var keys []*MockPixKey
+	for _, key := range m.state.PixKeys {
+		if key.internal() && key.Account == account {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].CreateDate.Before(keys[j].CreateDate) })
+	return keys
+}
+
+// newEndToEndID ... identificador E2E no formato do SPI: E + ISPB + data e hora + 11 caracteres
+func (m *MockServer) newEndToEndID() string {
+	const alphabet = "ABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+	suffix := make([]byte, 11)
+	for i := range suffix {
+		suffix[i] = alphabet[rand.Intn(len(alphabet))]
+	}
+	return "E" + CelcoinBankISPB + m.now().UTC().Format("200601021504") + string(suffix)
+}
+
+// emit ... registra o webhook e o agenda para as assinaturas ativas da entidade
+func (m *MockServer) emit(entity, status string, body interface{}) {
+	data, _ := json.Marshal(body)
+	record := &mockWebhookRecord{Event: MockWebhookEvent{
+		WebhookID:       uuid.New().String(),
+		Entity:          entity,
+		CreateTimestamp: m.now(),
+		Status:          status,
+		Body:            data,
+	}}
+	m.state.WebhookEvents = append(m.state.WebhookEvents, record)
+	m.schedule(record)
+}
+
+// parseMockDate ... aceita data, data e hora ou RFC3339; o valor pode chegar escapado (replay de webhooks)
+func parseMockDate(value string) (time.Time, bool) {
+	if unescaped, err := url.QueryUnescape(value); err == nil {
+		value = unescaped
+	}
+	value = strings.TrimSpace(value)
+	for _, layout := range []string{time.RFC3339, mockDateTimeLayout, mockDateLayout} {
+		if t, err := time.ParseInLocation(layout, value, time.Local); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// inMockRange ... a data está entre from e to; to sem horário inclui o dia inteiro
+func inMockRange(date time.Time, from, to string) bool {
+	if start, ok := parseMockDate(from); ok && date.Before(start) {
+		return false
+	}
+	if end, ok := parseMockDate(to); ok {
+		if len(strings.TrimSpace(to)) == len(mockDateLayout) {
+			end = end.Add(24 * time.Hour)
+		}
+		if !date.Before(end) {
+			return false
+		}
+	}
+	return true
+}
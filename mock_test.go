@@ -0,0 +1,423 @@
+package celcoin_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/contbank/celcoin-sdk"
+)
+
+// MockServerTestSuite ... fluxos completos do SDK contra o MockServer
+type MockServerTestSuite struct {
+	suite.Suite
+	assert *assert.Assertions
+	ctx    context.Context
+	server *celcoin.MockServer
+	client *celcoin.Client
+	payer  celcoin.MockAccount
+	payee  celcoin.MockAccount
+}
+
+func TestMockServerTestSuite(t *testing.T) {
+	suite.Run(t, new(MockServerTestSuite))
+}
+
+func (s *MockServerTestSuite) SetupTest() {
+	s.assert = assert.New(s.T())
+	s.ctx = context.Background()
+	s.server = celcoin.NewMockServer()
+
+	client, err := celcoin.NewClient(s.server.Config())
+	s.Require().NoError(err)
+	s.client = client
+
+	s.payer = s.server.AddAccount(celcoin.MockAccount{
+		DocumentNumber: "12345678909",
+		Name:           "Maria Pagadora",
+		Balance:        100,
+	})
+	s.payee = s.server.AddAccount(celcoin.MockAccount{
+		DocumentNumber: "98765432100",
+		Name:           "Joao Recebedor",
+	})
+}
+
+func (s *MockServerTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+// balance ...
+func (s *MockServerTestSuite) balance(account string) float64 {
+	response, err := s.client.Balance.Balance(s.ctx, account)
+	s.Require().NoError(err)
+	return response.Body.Amount
+}
+
+// pixCashOut ... Pix por chave, com o endToEndId da consulta ao DICT
+func (s *MockServerTestSuite) pixCashOut(clientCode, key string, amount float64) (*celcoin.PixCashOutResponse, error) {
+	entry, err := s.client.Pix.GetExternalPixKey(s.ctx, s.payer.Account, key, s.payer.DocumentNumber)
+	s.Require().NoError(err)
+
+	return s.client.Pix.PaymentPixCashOut(s.ctx, celcoin.PixCashOutRequest{
+		Amount:         amount,
+		ClientCode:     clientCode,
+		EndToEndId:     entry.Body.EndToEndId,
+		InitiationType: "DICT",
+		PaymentType:    "IMMEDIATE",
+		Urgency:        "HIGH",
+		DebitParty:     celcoin.DebitParty{Account: s.payer.Account},
+		CreditParty: celcoin.CreditParty{
+			Bank:  entry.Body.Account.Participant,
+			Key:   entry.Body.Key,
+			TaxId: entry.Body.Owner.DocumentNumber,
+			Name:  entry.Body.Owner.Name,
+		},
+	})
+}
+
+func (s *MockServerTestSuite) TestPixCashOutMovesBalanceBetweenAccounts() {
+	key, err := s.server.AddPixKey(celcoin.MockPixKey{Account: s.payee.Account})
+	s.Require().NoError(err)
+
+	response, err := s.pixCashOut("cash-out-1", key.Key, 25.5)
+	s.Require().NoError(err)
+	s.assert.Equal("PROCESSING", response.Status)
+
+	s.assert.Equal(74.5, s.balance(s.payer.Account))
+	s.assert.Equal(25.5, s.balance(s.payee.Account))
+
+	status, err := s.client.Pix.GetPixCashoutStatus(s.ctx, "", "", "cash-out-1")
+	s.Require().NoError(err)
+	s.assert.Equal("CONFIRMED", status.Status)
+	s.assert.Equal(response.Body.EndToEndID, status.Body.EndToEndID)
+
+	statement, err := s.client.Statement.GetStatements(s.ctx, &celcoin.StatementRequest{
+		Account:  celcoin.String(s.payer.Account),
+		DateFrom: celcoin.String(time.Now().Format("2006-01-02")),
+		DateTo:   celcoin.String(time.Now().Format("2006-01-02")),
+	})
+	s.Require().NoError(err)
+	s.Require().Len(statement.Body.Movements, 2)
+	s.assert.Equal("PIXPAYMENTOUT", statement.Body.Movements[1].MovementType)
+	s.assert.Equal("DEBIT", statement.Body.Movements[1].BalanceType)
+	s.assert.Equal(25.5, statement.Body.Movements[1].Amount)
+}
+
+func (s *MockServerTestSuite) TestPixCashOutInsufficientBalance() {
+	key, err := s.server.AddPixKey(celcoin.MockPixKey{Account: s.payee.Account})
+	s.Require().NoError(err)
+
+	var meta celcoin.ResponseMeta
+	s.ctx = celcoin.WithResponseMeta(s.ctx, &meta)
+	_, err = s.pixCashOut("cash-out-2", key.Key, 150)
+	s.Require().Error(err)
+	s.Require().NotNil(meta.Error)
+	s.assert.Equal("CBE123", meta.Error.CelcoinCode)
+	s.assert.Equal(100.0, s.balance(s.payer.Account))
+}
+
+func (s *MockServerTestSuite) TestPixKeyLifecycle() {
+	created, err := s.client.Pix.CreatePixKey(s.ctx, celcoin.PixKeyRequest{
+		Account: s.payee.Account,
+		KeyType: "EMAIL",
+		Key:     "joao@example.com",
+	})
+	s.Require().NoError(err)
+	s.assert.Equal(s.payee.DocumentNumber, created.Body.Owner.DocumentNumber)
+
+	_, err = s.client.Pix.CreatePixKey(s.ctx, celcoin.PixKeyRequest{
+		Account: s.payee.Account,
+		KeyType: "EMAIL",
+		Key:     "joao@example.com",
+	})
+	s.assert.Error(err)
+
+	keys, err := s.client.Pix.GetPixKeys(s.ctx, s.payee.Account)
+	s.Require().NoError(err)
+	s.Require().Len(keys.Body.ListKeys, 1)
+
+	s.Require().NoError(s.client.Pix.DeletePixKey(s.ctx, s.payee.Account, "joao@example.com"))
+	keys, err = s.client.Pix.GetPixKeys(s.ctx, s.payee.Account)
+	s.Require().NoError(err)
+	s.assert.Empty(keys.Body.ListKeys)
+}
+
+func (s *MockServerTestSuite) TestStaticQRCodePaidByAnotherInstitution() {
+	key, err := s.server.AddPixKey(celcoin.MockPixKey{Account: s.payee.Account})
+	s.Require().NoError(err)
+
+	static, err := s.client.Pix.PixCashInStatic(s.ctx, celcoin.PixCashInStaticRequest{
+		Key:                       key.Key,
+		Amount:                    12.34,
+		TransactionIdentification: "pedido123",
+		Merchant:                  celcoin.PixMerchant{PostalCode: "01311000", City: "SAO PAULO", Name: "Joao Recebedor"},
+	})
+	s.Require().NoError(err)
+
+	decoded, err := s.client.Pix.DecodeEmvQRCode(s.ctx, static.EMVQRCode)
+	s.Require().NoError(err)
+	s.assert.Equal(key.Key, decoded.MerchantAccountInformation.Key)
+	s.assert.Equal(12.34, decoded.TransactionAmount)
+
+	s.Require().NoError(s.server.PayPixCharge("pedido123"))
+	s.assert.Equal(12.34, s.balance(s.payee.Account))
+	s.assert.Len(s.server.WebhookEvents(celcoin.MockEntityPixPaymentIn), 1)
+}
+
+func (s *MockServerTestSuite) TestExternalTransfer() {
+	response, err := s.client.Transfers.CreateTransfer(s.ctx, "ted-1", celcoin.TransfersRequest{
+		Amount:     40,
+		ClientCode: "ted-1",
+		DebitParty: celcoin.TransfersDebitPartyRequest{AccountNumber: s.payer.Account, BankISPB: celcoin.CelcoinBankISPB},
+		CreditParty: celcoin.TransfersCreditPartyRequest{
+			BankISPB:      "60701190",
+			AccountNumber: "123456",
+			AccountBranch: "0001",
+			Identifier:    "98765432100",
+			AccountName:   "Joao Recebedor",
+			AccountType:   "CC",
+			PersonType:    celcoin.NaturalPersonType,
+		},
+		ClientFinality: celcoin.TransfersBetweenSameOwnershipClientFinality,
+	})
+	s.Require().NoError(err)
+	s.assert.Equal(60.0, s.balance(s.payer.Account))
+
+	found, err := s.client.Transfers.FindTransferByCode(s.ctx, celcoin.String("ted-1"), response.Body.ID, "ted-1", nil)
+	s.Require().NoError(err)
+	s.assert.Equal("CONFIRMED", found.Status)
+}
+
+func (s *MockServerTestSuite) TestBoletoIssuedAndPaid() {
+	charge, err := s.client.Boletos.CreateBoleto(s.ctx, celcoin.CreateBoletoRequest{
+		ExternalID: celcoin.String("fatura-1"),
+		DueDate:    celcoin.String(time.Now().AddDate(0, 0, 10).Format("2006-01-02")),
+		Amount:     func(v float64) *float64 { return &v }(30),
+		Debtor:     &celcoin.Debtor{Name: "Maria Pagadora", Document: s.payer.DocumentNumber},
+		Receiver:   &celcoin.Receiver{Account: s.payee.Account, Document: s.payee.DocumentNumber},
+	})
+	s.Require().NoError(err)
+
+	details, err := s.client.Boletos.GetCharge(s.ctx, &celcoin.ChargeRequest{ExternalID: celcoin.String("fatura-1")})
+	s.Require().NoError(err)
+	s.Require().Equal(charge.TransactionID, details.Body.TransactionID)
+	s.Require().Len(details.Body.Boleto.BankLine, 47)
+
+	authorization, err := s.client.Payment.AuthorizePayment(s.ctx, &celcoin.ValidatePaymentRequest{
+		BarCode: &celcoin.BarcodeData{DigitableLine: celcoin.String(details.Body.Boleto.BankLine)},
+	})
+	s.Require().NoError(err)
+	s.assert.Equal(30.0, *authorization.Value)
+
+	_, err = s.client.Payment.ExecutePayment(s.ctx, &celcoin.ExecPaymentRequest{
+		ClientRequestID:        "boleto-1",
+		Amount:                 *authorization.Value,
+		Account:                s.payer.Account,
+		TransactionIDAuthorize: *authorization.TransactionID,
+		BarCodeInfo:            celcoin.ExecPaymentBarCodeInfo{Digitable: details.Body.Boleto.BankLine},
+	})
+	s.Require().NoError(err)
+
+	s.assert.Equal(70.0, s.balance(s.payer.Account))
+	s.assert.Equal(30.0, s.balance(s.payee.Account))
+
+	paid, err := s.client.Boletos.QueryBoleto(s.ctx, charge.TransactionID)
+	s.Require().NoError(err)
+	s.assert.Equal("CONFIRMED", paid.Status)
+
+	payment, err := s.client.Payment.Get(s.ctx, &celcoin.GetPaymentRequest{ClientRequestID: "boleto-1"})
+	s.Require().NoError(err)
+	s.assert.Equal(30.0, payment.Body.Amount)
+}
+
+func (s *MockServerTestSuite) TestOnboardingCreatesAccount() {
+	onboarding, err := s.client.Customers.CreateAccount(s.ctx, &celcoin.Customer{
+		ClientCode:     "cliente-1",
+		DocumentNumber: "11144477735",
+		FullName:       "Ana Nova",
+		Email:          "ana@example.com",
+	})
+	s.Require().NoError(err)
+
+	proposal, err := s.client.Customers.GetOnboardingProposal(s.ctx, onboarding.Body.ProposalID)
+	s.Require().NoError(err)
+	s.Require().NotEmpty(proposal.Body.Proposals)
+	s.assert.Equal(celcoin.OnboardingStatusApproved, proposal.Body.Proposals[0].Status)
+
+	account, err := s.client.Customers.FindAccounts(s.ctx, celcoin.String("11144477735"), nil)
+	s.Require().NoError(err)
+	s.assert.Equal("ana@example.com", account.Body.Email)
+}
+
+func (s *MockServerTestSuite) TestDdaRejectsDuplicatedUser() {
+	request := celcoin.DdaRegisterUserRequest{Document: s.payer.DocumentNumber, ClientName: s.payer.Name}
+	registered, err := s.client.Dda.CreateRegisterUser(s.ctx, "dda-1", request)
+	s.Require().NoError(err)
+	s.assert.Equal(s.payer.DocumentNumber, registered.Body.Document)
+
+	var meta celcoin.ResponseMeta
+	_, err = s.client.Dda.CreateRegisterUser(celcoin.WithResponseMeta(s.ctx, &meta), "dda-2", request)
+	s.Require().Error(err)
+	s.Require().NotNil(meta.Error)
+	s.assert.Equal("CDDA102", meta.Error.CelcoinCode)
+}
+
+func (s *MockServerTestSuite) TestWebhookDeliveredToSubscription() {
+	var mutex sync.Mutex
+	var received []celcoin.MockWebhookEvent
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, password, ok := r.BasicAuth()
+		if !ok || user != "hook" || password != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		var event celcoin.MockWebhookEvent
+		body, _ := ioutil.ReadAll(r.Body)
+		s.assert.NoError(json.Unmarshal(body, &event))
+		mutex.Lock()
+		received = append(received, event)
+		mutex.Unlock()
+	}))
+	defer receiver.Close()
+
+	_, err := s.client.Webhooks.CreateSubscription(s.ctx, celcoin.WebhookSubscriptionRequest{
+		Entity:     celcoin.MockEntityPixPaymentIn,
+		WebhookURL: receiver.URL,
+		Auth:       celcoin.WebhookAuth{Login: "hook", Password: "secret", Type: "basic"},
+	})
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.server.CashIn(s.payee.Account, 10, "Pix externo"))
+	s.Eventually(func() bool {
+		mutex.Lock()
+		defer mutex.Unlock()
+		return len(received) == 1
+	}, 2*time.Second, 10*time.Millisecond)
+	s.assert.Equal(celcoin.MockEntityPixPaymentIn, received[0].Entity)
+
+	today := time.Now().Format("2006-01-02")
+	s.Eventually(func() bool {
+		count, err := s.client.Webhooks.GetWebhookReplaySendCount(s.ctx, celcoin.MockEntityPixPaymentIn, today, today)
+		return err == nil && count.TotalItems == 1
+	}, 2*time.Second, 10*time.Millisecond)
+}
+
+func (s *MockServerTestSuite) TestRejectsRequestsWithoutToken() {
+	req, err := http.NewRequest(http.MethodGet, s.server.URL+celcoin.BalancePath+"?account="+s.payer.Account, bytes.NewReader(nil))
+	s.Require().NoError(err)
+
+	resp, err := http.DefaultClient.Do(req)
+	s.Require().NoError(err)
+	defer resp.Body.Close()
+	s.assert.Equal(http.StatusUnauthorized, resp.StatusCode)
+}
+
+// admin ... requisição JSON para uma rota administrativa
+func (s *MockServerTestSuite) admin(method, path string, body interface{}, response interface{}) int {
+	data, err := json.Marshal(body)
+	s.Require().NoError(err)
+	req, err := http.NewRequest(method, s.server.URL+celcoin.MockAdminPath+path, bytes.NewReader(data))
+	s.Require().NoError(err)
+
+	resp, err := http.DefaultClient.Do(req)
+	s.Require().NoError(err)
+	defer resp.Body.Close()
+	if response != nil {
+		s.Require().NoError(json.NewDecoder(resp.Body).Decode(response))
+	}
+	return resp.StatusCode
+}
+
+func (s *MockServerTestSuite) TestAdminRoutes() {
+	var account celcoin.MockAccount
+	s.Require().Equal(http.StatusCreated, s.admin(http.MethodPost, "/accounts",
+		celcoin.MockAccount{DocumentNumber: "11122233344", Name: "Ana QA", Balance: 5}, &account))
+	s.assert.Equal(celcoin.MockAccountActive, account.Status)
+
+	s.Require().Equal(http.StatusCreated, s.admin(http.MethodPost, "/cash-in",
+		celcoin.MockCashIn{Account: account.Account, Amount: 20}, nil))
+	s.assert.Equal(25.0, s.balance(account.Account))
+	s.assert.Equal(http.StatusNotFound, s.admin(http.MethodPost, "/cash-in",
+		celcoin.MockCashIn{Account: "999", Amount: 20}, nil))
+
+	var delay celcoin.MockSettlementDelay
+	s.Require().Equal(http.StatusOK, s.admin(http.MethodPut, "/settlement-delay",
+		celcoin.MockSettlementDelay{Delay: "1h"}, &delay))
+	s.assert.Equal("1h0m0s", delay.Delay)
+
+	key, err := s.server.AddPixKey(celcoin.MockPixKey{Account: s.payee.Account})
+	s.Require().NoError(err)
+	_, err = s.pixCashOut("admin-1", key.Key, 10)
+	s.Require().NoError(err)
+	s.assert.Equal(0.0, s.balance(s.payee.Account))
+
+	var settled map[string]int
+	s.Require().Equal(http.StatusOK, s.admin(http.MethodPost, "/settlements", nil, &settled))
+	s.assert.Equal(1, settled["settled"])
+	s.assert.Equal(10.0, s.balance(s.payee.Account))
+
+	var event celcoin.MockWebhookEvent
+	s.Require().Equal(http.StatusCreated, s.admin(http.MethodPost, "/webhooks", celcoin.MockWebhookTrigger{
+		Entity: celcoin.MockEntityChargeIn,
+		Body:   json.RawMessage(`{"transactionId":"123"}`),
+	}, &event))
+	s.assert.Equal("CONFIRMED", event.Status)
+	s.assert.Len(s.server.WebhookEvents(celcoin.MockEntityChargeIn), 1)
+}
+
+func (s *MockServerTestSuite) TestSaveAndLoadState() {
+	key, err := s.server.AddPixKey(celcoin.MockPixKey{Account: s.payee.Account})
+	s.Require().NoError(err)
+	s.server.SetSettlementDelay(time.Hour)
+	_, err = s.pixCashOut("persisted-1", key.Key, 30)
+	s.Require().NoError(err)
+
+	var saved bytes.Buffer
+	s.Require().NoError(s.server.SaveState(&saved))
+
+	restarted := celcoin.NewMockServer()
+	defer restarted.Close()
+	s.Require().NoError(restarted.LoadState(&saved))
+	client, err := celcoin.NewClient(restarted.Config())
+	s.Require().NoError(err)
+
+	balance, err := client.Balance.Balance(s.ctx, s.payer.Account)
+	s.Require().NoError(err)
+	s.assert.Equal(70.0, balance.Body.Amount)
+	s.assert.Equal(1, restarted.PendingSettlements())
+
+	s.assert.Equal(1, restarted.SettlePending())
+	status, err := client.Pix.GetPixCashoutStatus(s.ctx, "", "", "persisted-1")
+	s.Require().NoError(err)
+	s.assert.Equal("CONFIRMED", status.Status)
+	payee, _ := restarted.Account(s.payee.Account)
+	s.assert.Equal(30.0, payee.Balance)
+}
+
+func (s *MockServerTestSuite) TestSeed() {
+	server := celcoin.NewMockServer()
+	defer server.Close()
+
+	err := server.Seed(celcoin.MockSeed{
+		Accounts: []celcoin.MockAccount{{Account: "300000100", DocumentNumber: "12345678909", Name: "Maria", Balance: 50}},
+		PixKeys:  []celcoin.MockPixKey{{Key: "maria@example.com", KeyType: "EMAIL", Account: "300000100"}},
+		Webhooks: []celcoin.WebhookSubscriptionRequest{{Entity: celcoin.MockEntityPixPaymentIn, WebhookURL: "http://localhost:3000/hooks"}},
+	})
+	s.Require().NoError(err)
+	account, found := server.Account("300000100")
+	s.Require().True(found)
+	s.assert.Equal(50.0, account.Balance)
+
+	err = server.Seed(celcoin.MockSeed{PixKeys: []celcoin.MockPixKey{{Key: "orphan", Account: "404"}}})
+	s.assert.ErrorIs(err, celcoin.ErrMockInvalidSeed)
+}
@@ -0,0 +1,369 @@
+package celcoin
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// MockEntityPixPaymentOut ... Pix enviado
+	MockEntityPixPaymentOut = "pix-payment-out"
+	// MockEntityPixPaymentIn ... Pix recebido
+	MockEntityPixPaymentIn = "pix-payment-in"
+	// MockEntityInternalTransfer ... transferência entre contas da Celcoin
+	MockEntityInternalTransfer = "internal-transfer"
+	// MockEntitySpbTransferOut ... TED enviada
+	MockEntitySpbTransferOut = "spb-transfer-out"
+	// MockEntityBillPayment ... pagamento de conta
+	MockEntityBillPayment = "bill-payment"
+	// MockEntityChargeIn ... boleto recebido
+	MockEntityChargeIn = "charge-in"
+	// MockEntityDdaSubscription ... inscrição ou cancelamento no DDA
+	MockEntityDdaSubscription = "dda-subscription"
+)
+
+// mockDelivery ... entrega de um webhook para uma assinatura
+type mockDelivery struct {
+	subscription WebhookSubscription
+	record       *mockWebhookRecord
+}
+
+// registerWebhookRoutes ...
+func (m *MockServer) registerWebhookRoutes() {
+	m.handle(http.MethodPost, WebhookPath+"/subscription", m.handleCreateSubscription)
+	m.handle(http.MethodGet, WebhookPath+"/subscription", m.handleGetSubscriptions)
+	m.handle(http.MethodPut, WebhookPath+"/subscription/{entity}", m.handleUpdateSubscription)
+	m.handle(http.MethodDelete, WebhookPath+"/subscription/{entity}", m.handleDeleteSubscription)
+	m.handle(http.MethodGet, WebhookPath+"/replay/{entity}", m.handleGetReplay)
+	m.handle(http.MethodGet, WebhookPath+"/replay/{entity}/count", m.handleGetReplayCount)
+	m.handle(http.MethodPut, WebhookPath+"/replay/{entity}", m.handleReplay)
+	m.handle(http.MethodPost, WebhookDdaPath+"/register", m.handleRegisterDdaWebhook)
+}
+
+// webhookError ...
+func webhookError(status int, code string) mockResponse {
+	return mockError(status, ErrorDomainWebhook, code)
+}
+
+// validateWebhook ... código de erro quando a URL ou a autenticação do webhook são inválidas
+func validateWebhook(webhookURL string, auth WebhookAuth) string {
+	parsed, err := url.Parse(webhookURL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || len(parsed.Host) == 0 {
+		return "CBE207"
+	}
+	if len(auth.Type) == 0 && len(auth.Login) == 0 && len(auth.Password) == 0 {
+		return ""
+	}
+	switch {
+	case len(auth.Type) == 0:
+		return "CBE216"
+	case auth.Type != "basic":
+		return "CBE209"
+	case len(auth.Login) == 0:
+		return "CBE212"
+	case len(auth.Password) == 0:
+		return "CBE213"
+	}
+	return ""
+}
+
+// handleCreateSubscription ...
+func (m *MockServer) handleCreateSubscription(r *mockRequest) mockResponse {
+	var request WebhookSubscriptionRequest
+	if err := r.decode(&request); err != nil {
+		return webhookError(http.StatusBadRequest, "CBE206")
+	}
+	subscription, code := m.addSubscription(request)
+	if len(code) > 0 {
+		return webhookError(http.StatusBadRequest, code)
+	}
+
+	return mockJSON(http.StatusOK, WebhookSubscriptionResponse{
+		Version: mockVersion,
+		Status:  "SUCCESS",
+		Body:    &WebhookSubscriptionBody{SubscriptionId: subscription.SubscriptionId},
+	})
+}
+
+// addSubscription ... cada entidade aceita uma assinatura ativa; retorna o código de erro quando recusada
+func (m *MockServer) addSubscription(request WebhookSubscriptionRequest) (*WebhookSubscription, string) {
+	if len(request.Entity) == 0 {
+		return nil, "CBE206"
+	}
+	if code := validateWebhook(request.WebhookURL, request.Auth); len(code) > 0 {
+		return nil, code
+	}
+	for _, subscription := range m.state.Webhooks {
+		if subscription.Entity == request.Entity && subscription.Active {
+			return nil, "CBE205"
+		}
+	}
+
+	subscription := &WebhookSubscription{
+		SubscriptionId: uuid.New().String(),
+		Entity:         request.Entity,
+		WebhookURL:     request.WebhookURL,
+		Active:         true,
+		CreateDate:     m.now(),
+		LastUpdateDate: m.now(),
+		Auth:           request.Auth,
+	}
+	m.state.Webhooks[subscription.SubscriptionId] = subscription
+	return subscription, ""
+}
+
+// handleGetSubscriptions ... assinaturas filtradas por entidade e situação
+func (m *MockServer) handleGetSubscriptions(r *mockRequest) mockResponse {
+	entity := r.query("entity")
+	active, filterActive := r.query("active"), len(r.query("active")) > 0
+
+	response := WebhookQueryResponse{Version: mockVersion, Status: "SUCCESS"}
+	response.Body.Subscriptions = []WebhookSubscription{}
+	for _, subscription := range m.sortedSubscriptions() {
+		if len(entity) > 0 && subscription.Entity != entity {
+			continue
+		}
+		if filterActive && strconv.FormatBool(subscription.Active) != active {
+			continue
+		}
+		response.Body.Subscriptions = append(response.Body.Subscriptions, *subscription)
+	}
+	return mockJSON(http.StatusOK, response)
+}
+
+// subscription ... assinatura pelo id ou, quando não informado, a assinatura ativa da entidade
+func (m *MockServer) subscription(entity, subscriptionID string) *WebhookSubscription {
+	if len(subscriptionID) > 0 {
+		subscription, found := m.state.Webhooks[subscriptionID]
+		if !found || subscription.Entity != entity {
+			return nil
+		}
+		return subscription
+	}
+	for _, subscription := range m.sortedSubscriptions() {
+		if subscription.Entity == entity && subscription.Active {
+			return subscription
+		}
+	}
+	return nil
+}
+
+// handleUpdateSubscription ...
+func (m *MockServer) handleUpdateSubscription(r *mockRequest) mockResponse {
+	var request WebhookUpdateRequest
+	if err := r.decode(&request); err != nil {
+		return webhookError(http.StatusBadRequest, "CBE206")
+	}
+	subscription := m.subscription(r.param("entity"), request.SubscriptionID)
+	if subscription == nil {
+		return webhookError(http.StatusNotFound, "CBE206")
+	}
+	if code := validateWebhook(request.WebhookURL, request.Auth); len(code) > 0 {
+		return webhookError(http.StatusBadRequest, code)
+	}
+
+	subscription.WebhookURL = request.WebhookURL
+	subscription.Auth = request.Auth
+	subscription.Active = request.Active
+	subscription.LastUpdateDate = m.now()
+	return mockJSON(http.StatusOK, WebhookUpdateResponse{Version: mockVersion, Status: "SUCCESS"})
+}
+
+// handleDeleteSubscription ...
+func (m *MockServer) handleDeleteSubscription(r *mockRequest) mockResponse {
+	subscription := m.subscription(r.param("entity"), r.query("SubscriptionId"))
+	if subscription == nil {
+		return webhookError(http.StatusNotFound, "CBE206")
+	}
+
+	delete(m.state.Webhooks, subscription.SubscriptionId)
+	return mockJSON(http.StatusOK, WebhookDeleteResponse{Version: mockVersion, Status: "SUCCESS"})
+}
+
+// sortedSubscriptions ... assinaturas em ordem de criação
+func (m *MockServer) sortedSubscriptions() []*WebhookSubscription {
+	subscriptions := make([]*WebhookSubscription, 0, len(m.state.Webhooks))
+	for _, subscription := range m.state.Webhooks {
+		subscriptions = append(subscriptions, subscription)
+	}
+	sort.Slice(subscriptions, func(i, j int) bool {
+		return subscriptions[i].CreateDate.Before(subscriptions[j].CreateDate)
+	})
+	return subscriptions
+}
+
+// webhookRecords ... webhooks da entidade no período; onlyPending considera apenas os não entregues
+func (m *MockServer) webhookRecords(entity, dateFrom, dateTo string, onlyPending bool) []*mockWebhookRecord {
+	var records []*mockWebhookRecord
+	for _, record := range m.state.WebhookEvents {
+		if record.Event.Entity != entity || (onlyPending && record.Delivered) ||
+			!inMockRange(record.Event.CreateTimestamp, dateFrom, dateTo) {
+			continue
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+// replayResponse ...
+func replayResponse(entity, dateFrom, dateTo string, onlyPending bool, total int) WebhookReplayResponse {
+	return WebhookReplayResponse{
+		Body: WebhookReplayResponseBody{
+			OnlyPending: onlyPending,
+			Entity:      entity,
+			DateFrom:    dateFrom,
+			DateTo:      dateTo,
+			TotalItems:  total,
+		},
+		Status:  "SUCCESS",
+		Version: mockVersion,
+	}
+}
+
+// unescapeMockQuery ... o SDK escapa as datas antes de montar a query
+func unescapeMockQuery(value string) string {
+	if unescaped, err := url.QueryUnescape(value); err == nil {
+		return unescaped
+	}
+	return value
+}
+
+// handleGetReplay ... quantidade de webhooks da entidade no período
+func (m *MockServer) handleGetReplay(r *mockRequest) mockResponse {
+	entity := r.param("entity")
+	dateFrom, dateTo := unescapeMockQuery(r.query("DateFrom")), unescapeMockQuery(r.query("DateTo"))
+	onlyPending := r.query("OnlyPending") == "true"
+
+	records := m.webhookRecords(entity, dateFrom, dateTo, onlyPending)
+	return mockJSON(http.StatusOK, replayResponse(entity, dateFrom, dateTo, onlyPending, len(records)))
+}
+
+// handleGetReplayCount ... quantidade de webhooks entregues no período
+func (m *MockServer) handleGetReplayCount(r *mockRequest) mockResponse {
+	total := 0
+	for _, record := range m.webhookRecords(r.param("entity"), r.query("DateFrom"), r.query("DateTo"), false) {
+		if record.Delivered {
+			total++
+		}
+	}
+	return mockJSON(http.StatusOK, WebhookReplayCountResponse{TotalItems: total, Status: "SUCCESS", Version: mockVersion})
+}
+
+// handleReplay ... reenvia os webhooks do período; webhookId restringe a um webhook específico
+func (m *MockServer) handleReplay(r *mockRequest) mockResponse {
+	entity := r.param("entity")
+	dateFrom, dateTo := unescapeMockQuery(r.query("DateFrom")), unescapeMockQuery(r.query("DateTo"))
+	onlyPending := r.query("OnlyPending") == "true"
+	webhookID := r.query("webhookId")
+
+	var request WebhookReplayRequest
+	if len(r.body) > 0 {
+		if err := r.decode(&request); err != nil {
+			return webhookError(http.StatusBadRequest, "CBE206")
+		}
+	}
+
+	total := 0
+	for _, record := range m.webhookRecords(entity, dateFrom, dateTo, onlyPending) {
+		if m.recordMatches(record, webhookID) && m.recordMatches(record, request.Filter.ID) {
+			m.schedule(record)
+			total++
+		}
+	}
+	return mockJSON(http.StatusOK, replayResponse(entity, dateFrom, dateTo, onlyPending, total))
+}
+
+// recordMatches ... o filtro vazio ou com id de uma assinatura aceita todos os webhooks da entidade
+func (m *MockServer) recordMatches(record *mockWebhookRecord, id string) bool {
+	if len(id) == 0 || record.Event.WebhookID == id {
+		return true
+	}
+	subscription, found := m.state.Webhooks[id]
+	return found && subscription.Entity == record.Event.Entity
+}
+
+// handleRegisterDdaWebhook ... um webhook por tipo de evento do DDA
+func (m *MockServer) handleRegisterDdaWebhook(r *mockRequest) mockResponse {
+	var request WebhookSubscriptionDdaRequest
+	if err := r.decode(&request); err != nil {
+		return webhookError(http.StatusBadRequest, "CBE206")
+	}
+	switch request.TypeEventWebhook {
+	case "Subscription", "Deletion", "Invoice":
+	default:
+		return webhookError(http.StatusBadRequest, "CBE206")
+	}
+	if code := validateWebhook(request.URL, WebhookAuth{}); len(code) > 0 {
+		return webhookError(http.StatusBadRequest, code)
+	}
+
+	body := &WebhookSubscriptionDdaBody{
+		TypeEventWebhook:    request.TypeEventWebhook,
+		URL:                 request.URL,
+		BasicAuthentication: request.BasicAuthentication,
+		OAuthTwo:            request.OAuthTwo,
+	}
+	m.state.DdaWebhooks[request.TypeEventWebhook] = body
+	return mockJSON(http.StatusCreated, WebhookSubscriptionDdaResponse{
+		Version: mockVersion,
+		Status:  http.StatusCreated,
+		Body:    body,
+	})
+}
+
+// schedule ... agenda a entrega do webhook para as assinaturas ativas da entidade
+func (m *MockServer) schedule(record *mockWebhookRecord) {
+	for _, subscription := range m.sortedSubscriptions() {
+		if subscription.Entity == record.Event.Entity && subscription.Active {
+			m.outbox = append(m.outbox, mockDelivery{subscription: *subscription, record: record})
+		}
+	}
+}
+
+// deliver ... envia os webhooks em segundo plano; Close aguarda as entregas em andamento
+func (m *MockServer) deliver(outbox []mockDelivery) {
+	for _, delivery := range outbox {
+		m.deliveries.Add(1)
+		go func(delivery mockDelivery) {
+			defer m.deliveries.Done()
+			delivered := m.post(delivery)
+
+			m.mutex.Lock()
+			defer m.mutex.Unlock()
+			delivery.record.Attempts++
+			delivery.record.Delivered = delivery.record.Delivered || delivered
+		}(delivery)
+	}
+}
+
+// post ... POST do evento na URL da assinatura, com basic auth quando configurada
+func (m *MockServer) post(delivery mockDelivery) bool {
+	m.mutex.Lock()
+	data, err := json.Marshal(delivery.record.Event)
+	m.mutex.Unlock()
+	if err != nil {
+		return false
+	}
+
+	req, err := http.NewRequest(http.MethodPost, delivery.subscription.WebhookURL, bytes.NewReader(data))
+	if err != nil {
+		return false
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if auth := delivery.subscription.Auth; len(auth.Login) > 0 {
+		req.SetBasicAuth(auth.Login, auth.Password)
+	}
+
+	resp, err := m.webhooks.Do(req)
+	if err != nil {
+		return false
+	}
+	defer resp.Body.Close()
+	return resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices
+}
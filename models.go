@@ -239,6 +239,8 @@ type Certificate struct {
 	UUID             string `json:"uuid"`
 	ClientID         string `json:"client_id"`
 	ClientSecret     string `json:"client_secret"`
+	PKCS12           string `json:"pkcs12,omitempty"` // bundle PKCS#12 (.p12/.pfx) em base64, alternativa a Certificate/PrivateKey
+	RootCA           string `json:"rootCa,omitempty"` // CA em PEM fixada para verificar o servidor; vazio usa o pool do sistema
 }
 
 // WebhookCredential ...
@@ -0,0 +1,273 @@
+package celcoin
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// tokenExpirationMargin ... antecedência com que um token deixa de ser usado nas requisições
+	tokenExpirationMargin = 1 * time.Minute
+	// tokenRenewalMargin ... antecedência com que o token é renovado em background
+	tokenRenewalMargin = 2 * time.Minute
+	// tokenRequestTimeout ... timeout da chamada ao endpoint de token
+	tokenRequestTimeout = 30 * time.Second
+)
+
+// oauthTransport ... é um transporte customizado que adiciona o token e o renova quando necessário.
+// O mutex protege apenas o estado do token; as requisições à API não são serializadas.
+// Enquanto o transporte é usado, o token é renovado em background antes de expirar; sem requisições durante a
+// validade de um token, a renovação é interrompida e o próximo token é obtido sob demanda.
+// CloseIdleConnections interrompe a renovação imediatamente.
+type oauthTransport struct {
+	underlyingTransport http.RoundTripper
+	session             *Session
+	token               string
+	tokenExpiration     time.Time
+	mutex               *sync.Mutex
+	refreshing          *tokenRefresh
+	renewalTimer        *time.Timer
+	// used ... houve requisições desde a última renovação em background
+	used bool
+	// idle ... a renovação em background foi interrompida por falta de uso
+	idle bool
+}
+
+// tokenRefresh ... representa uma renovação de token em andamento, compartilhada por todos que a aguardam
+type tokenRefresh struct {
+	done  chan struct{}
+	token string
+	err   error
+}
+
+type withoutAuthorizationKey struct{}
+
+// withoutAuthorization ... a requisição segue sem o token OAuth. Usado pela Authentication, que chama o endpoint
+// de token mantendo a trava do TokenStore: aguardar o token do transporte nessa chamada causaria um deadlock.
+func withoutAuthorization(ctx context.Context) context.Context {
+	return context.WithValue(ctx, withoutAuthorizationKey{}, true)
+}
+
+// RoundTrip ... adiciona o cabeçalho Authorization e renova o token se necessário.
+// Se a Celcoin rejeitar o token (401/invalid_token), o token é invalidado e a requisição é repetida uma única vez.
+func (t *oauthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if skip, _ := req.Context().Value(withoutAuthorizationKey{}).(bool); skip {
+		return t.underlyingTransport.RoundTrip(req)
+	}
+
+	req = req.Clone(req.Context())
+	if err := rewindableBody(req); err != nil {
+		return nil, err
+	}
+
+	token, err := t.accessToken(req.Context())
+	if err != nil {
+		return nil, withRequestID(req.Context(), err)
+	}
+
+	resp, err := t.send(req, token)
+	if err != nil || !isInvalidTokenResponse(resp) {
+		return resp, err
+	}
+
+	// Token revogado antes da expiração calculada: descarta, obtém outro e repete a requisição
+	io.Copy(ioutil.Discard, resp.Body)
+	resp.Body.Close()
+	t.invalidate(req.Context(), token)
+
+	if req.GetBody != nil {
+		if req.Body, err = req.GetBody(); err != nil {
+			return nil, err
+		}
+	}
+
+	token, err = t.accessToken(req.Context())
+	if err != nil {
+		return nil, withRequestID(req.Context(), err)
+	}
+	return t.send(req, token)
+}
+
+// send ... envia a requisição com o token informado
+func (t *oauthTransport) send(req *http.Request, token string) (*http.Response, error) {
+	req.Header.Set("Authorization", "Bearer "+token)
+	return t.underlyingTransport.RoundTrip(req)
+}
+
+// invalidate ... descarta o token rejeitado, localmente e no TokenStore, se ainda for o token atual
+func (t *oauthTransport) invalidate(ctx context.Context, token string) {
+	t.mutex.Lock()
+	if t.token == token {
+		t.token = ""
+		t.tokenExpiration = time.Time{}
+	}
+	t.mutex.Unlock()
+
+	store := t.session.tokenStore()
+	key := tokenStoreKey(t.session)
+	if stored, err := store.Get(ctx, key); err == nil && stored != nil && stored.AccessToken == token {
+		store.Delete(ctx, key)
+	}
+}
+
+// rotate ... descarta o token obtido com as credenciais anteriores; o próximo token usa as credenciais rotacionadas
+func (t *oauthTransport) rotate(previous, _ Credentials) {
+	t.mutex.Lock()
+	t.token = ""
+	t.tokenExpiration = time.Time{}
+	if t.renewalTimer != nil {
+		t.renewalTimer.Stop()
+		t.renewalTimer = nil
+	}
+	t.mutex.Unlock()
+
+	t.session.tokenStore().Delete(context.Background(), tokenStoreKeyFor(previous.ClientID))
+}
+
+// isInvalidTokenResponse ... indica se a Celcoin rejeitou o token de acesso
+func isInvalidTokenResponse(resp *http.Response) bool {
+	if resp.StatusCode == http.StatusUnauthorized {
+		return true
+	}
+	return strings.Contains(resp.Header.Get("WWW-Authenticate"), "invalid_token")
+}
+
+// rewindableBody ... garante que o corpo da requisição possa ser reenviado
+func rewindableBody(req *http.Request) error {
+	if req.Body == nil || req.Body == http.NoBody || req.GetBody != nil {
+		return nil
+	}
+
+	body, err := ioutil.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return err
+	}
+
+	req.GetBody = func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewReader(body)), nil
+	}
+	req.Body, _ = req.GetBody()
+	return nil
+}
+
+// CloseIdleConnections ... interrompe a renovação em background e fecha as conexões ociosas do transporte
+func (t *oauthTransport) CloseIdleConnections() {
+	t.mutex.Lock()
+	if t.renewalTimer != nil {
+		t.renewalTimer.Stop()
+		t.renewalTimer = nil
+	}
+	t.mutex.Unlock()
+
+	if closer, ok := t.underlyingTransport.(interface{ CloseIdleConnections() }); ok {
+		closer.CloseIdleConnections()
+	}
+}
+
+// accessToken ... retorna o token em cache ou aguarda a renovação em andamento
+func (t *oauthTransport) accessToken(ctx context.Context) (string, error) {
+	t.mutex.Lock()
+	t.used = true
+	if t.token != "" && time.Now().Before(t.tokenExpiration.Add(-tokenExpirationMargin)) {
+		token := t.token
+		if t.idle {
+			// Retoma a renovação interrompida por inatividade, renovando já se o prazo passou
+			t.idle = false
+			if time.Now().Before(t.tokenExpiration.Add(-tokenRenewalMargin)) {
+				t.scheduleRenewalLocked()
+			} else {
+				t.startRefreshLocked()
+			}
+		}
+		t.mutex.Unlock()
+		return token, nil
+	}
+	refresh := t.startRefreshLocked()
+	t.mutex.Unlock()
+
+	select {
+	case <-refresh.done:
+		return refresh.token, refresh.err
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+}
+
+// startRefreshLocked ... inicia uma renovação de token, ou reaproveita a que já está em andamento.
+// Deve ser chamado com o mutex adquirido.
+func (t *oauthTransport) startRefreshLocked() *tokenRefresh {
+	if t.refreshing != nil {
+		return t.refreshing
+	}
+
+	refresh := &tokenRefresh{done: make(chan struct{})}
+	t.refreshing = refresh
+	go t.refresh(refresh)
+	return refresh
+}
+
+// refresh ... busca um novo token fora do mutex e publica o resultado para todos que aguardam.
+// O token é compartilhado pelo TokenStore da sessão, de modo que apenas uma réplica o renova por vez.
+func (t *oauthTransport) refresh(refresh *tokenRefresh) {
+	ctx, cancel := context.WithTimeout(context.Background(), tokenLockTTL+tokenRequestTimeout)
+	defer cancel()
+
+	client := &http.Client{Transport: t.underlyingTransport, Timeout: tokenRequestTimeout}
+	token, err := obtainToken(ctx, t.session.logger("authentication"), t.session.tokenStore(), tokenStoreKey(t.session), tokenRenewalMargin,
+		func() (string, time.Time, error) {
+			return fetchAccessToken(ctx, client, t.session)
+		})
+
+	t.mutex.Lock()
+	if err == nil {
+		t.token = token.AccessToken
+		t.tokenExpiration = token.ExpiresAt
+		t.scheduleRenewalLocked()
+		refresh.token = token.AccessToken
+	}
+	t.refreshing = nil
+	t.mutex.Unlock()
+
+	refresh.err = err
+	close(refresh.done)
+}
+
+// scheduleRenewalLocked ... agenda a renovação proativa do token antes de tokenExpiration. A renovação só ocorre
+// se o transporte foi usado desde a renovação anterior. Deve ser chamado com o mutex adquirido.
+func (t *oauthTransport) scheduleRenewalLocked() {
+	t.idle = false
+	if t.renewalTimer != nil {
+		t.renewalTimer.Stop()
+		t.renewalTimer = nil
+	}
+
+	delay := time.Until(t.tokenExpiration.Add(-tokenRenewalMargin))
+	if delay <= 0 {
+		return
+	}
+
+	var timer *time.Timer
+	timer = time.AfterFunc(delay, func() {
+		t.mutex.Lock()
+		defer t.mutex.Unlock()
+		if t.renewalTimer != timer {
+			// Interrompido ou substituído enquanto disparava
+			return
+		}
+		t.renewalTimer = nil
+		if !t.used {
+			t.idle = true
+			return
+		}
+		t.used = false
+		t.startRefreshLocked()
+	})
+	t.renewalTimer = timer
+}
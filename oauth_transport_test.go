@@ -0,0 +1,295 @@
+package celcoin_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/contbank/celcoin-sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+// OAuthTransportTestSuite ...
+type OAuthTransportTestSuite struct {
+	suite.Suite
+	assert     *assert.Assertions
+	ctx        context.Context
+	tokenCalls int32
+	expiresIn  int
+	apiHandler http.HandlerFunc
+	server     *httptest.Server
+	client     *http.Client
+}
+
+// TestOAuthTransportTestSuite ...
+func TestOAuthTransportTestSuite(t *testing.T) {
+	suite.Run(t, new(OAuthTransportTestSuite))
+}
+
+// SetupTest ...
+func (s *OAuthTransportTestSuite) SetupTest() {
+	s.assert = assert.New(s.T())
+	s.ctx = context.Background()
+	s.tokenCalls = 0
+	s.expiresIn = 3600
+	s.apiHandler = func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(r.Header.Get("Authorization")))
+	}
+
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, celcoin.LoginPath) {
+			call := atomic.AddInt32(&s.tokenCalls, 1)
+			// Simula um endpoint de token lento para forçar chamadas concorrentes
+			time.Sleep(50 * time.Millisecond)
+			fmt.Fprintf(w, `{"access_token":"token-%d","expires_in":%d}`, call, s.expiresIn)
+			return
+		}
+		s.apiHandler(w, r)
+	}))
+
+	session, err := celcoin.NewSession(celcoin.Config{
+		ClientID:      celcoin.String("test-client-id"),
+		ClientSecret:  celcoin.String("test-client-secret"),
+		Mtls:          celcoin.Bool(false),
+		APIEndpoint:   celcoin.String(s.server.URL),
+		LoginEndpoint: celcoin.String(s.server.URL),
+	})
+	s.Require().NoError(err)
+
+	s.client, err = celcoin.CreateOAuth2HTTPClient(session)
+	s.Require().NoError(err)
+}
+
+// TearDownTest ...
+func (s *OAuthTransportTestSuite) TearDownTest() {
+	s.client.CloseIdleConnections()
+	s.server.Close()
+}
+
+func (s *OAuthTransportTestSuite) get(ctx context.Context) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", s.server.URL+"/api", nil)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	return string(body), err
+}
+
+// TestConcurrentCallsShareSingleRefresh ...
+func (s *OAuthTransportTestSuite) TestConcurrentCallsShareSingleRefresh() {
+	const calls = 500
+
+	var wg sync.WaitGroup
+	var failures int32
+	wg.Add(calls)
+	for i := 0; i < calls; i++ {
+		go func() {
+			defer wg.Done()
+			auth, err := s.get(s.ctx)
+			if err != nil || auth != "Bearer token-1" {
+				atomic.AddInt32(&failures, 1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	s.assert.Equal(int32(0), atomic.LoadInt32(&failures))
+	s.assert.Equal(int32(1), atomic.LoadInt32(&s.tokenCalls))
+}
+
+// TestRequestsAreNotSerialized ...
+func (s *OAuthTransportTestSuite) TestRequestsAreNotSerialized() {
+	const calls = 50
+
+	// Todas as requisições ficam presas no servidor até que as 50 estejam em andamento ao mesmo tempo.
+	var arrived sync.WaitGroup
+	arrived.Add(calls)
+	release := make(chan struct{})
+	go func() {
+		arrived.Wait()
+		close(release)
+	}()
+
+	s.apiHandler = func(w http.ResponseWriter, r *http.Request) {
+		arrived.Done()
+		select {
+		case <-release:
+			w.WriteHeader(http.StatusOK)
+		case <-time.After(5 * time.Second):
+			w.WriteHeader(http.StatusGatewayTimeout)
+		}
+	}
+
+	var wg sync.WaitGroup
+	var timeouts int32
+	wg.Add(calls)
+	for i := 0; i < calls; i++ {
+		go func() {
+			defer wg.Done()
+			req, _ := http.NewRequest("GET", s.server.URL+"/api", nil)
+			resp, err := s.client.Do(req)
+			if err != nil || resp.StatusCode != http.StatusOK {
+				atomic.AddInt32(&timeouts, 1)
+			}
+			if resp != nil {
+				resp.Body.Close()
+			}
+		}()
+	}
+	wg.Wait()
+
+	s.assert.Equal(int32(0), atomic.LoadInt32(&timeouts))
+}
+
+// TestCanceledCallerDoesNotCancelRefresh ...
+func (s *OAuthTransportTestSuite) TestCanceledCallerDoesNotCancelRefresh() {
+	ctx, cancel := context.WithTimeout(s.ctx, 5*time.Millisecond)
+	defer cancel()
+
+	_, err := s.get(ctx)
+	s.assert.Error(err)
+
+	auth, err := s.get(s.ctx)
+	s.assert.NoError(err)
+	s.assert.Equal("Bearer token-1", auth)
+	s.assert.Equal(int32(1), atomic.LoadInt32(&s.tokenCalls))
+}
+
+// TestProactiveRenewal ...
+func (s *OAuthTransportTestSuite) TestProactiveRenewal() {
+	// Com expires_in de 121s a renovação em background ocorre ~1s após a emissão do token.
+	s.expiresIn = 121
+
+	auth, err := s.get(s.ctx)
+	s.Require().NoError(err)
+	s.assert.Equal("Bearer token-1", auth)
+
+	s.assert.Eventually(func() bool {
+		auth, err := s.get(s.ctx)
+		return err == nil && auth == "Bearer token-2"
+	}, 3*time.Second, 50*time.Millisecond)
+	s.assert.Equal(int32(2), atomic.LoadInt32(&s.tokenCalls))
+}
+
+// TestIdleTransportStopsRenewal ...
+func (s *OAuthTransportTestSuite) TestIdleTransportStopsRenewal() {
+	// Com expires_in de 121s cada token é renovado ~1s após a emissão, se o transporte estiver em uso
+	s.expiresIn = 121
+
+	auth, err := s.get(s.ctx)
+	s.Require().NoError(err)
+	s.assert.Equal("Bearer token-1", auth)
+
+	s.Require().Eventually(func() bool {
+		return atomic.LoadInt32(&s.tokenCalls) == 2
+	}, 3*time.Second, 50*time.Millisecond)
+
+	// Sem requisições durante a validade do token-2, ele não é renovado
+	time.Sleep(1500 * time.Millisecond)
+	s.assert.Equal(int32(2), atomic.LoadInt32(&s.tokenCalls))
+
+	// O uso retoma a renovação em background
+	auth, err = s.get(s.ctx)
+	s.Require().NoError(err)
+	s.assert.Equal("Bearer token-2", auth)
+	s.assert.Eventually(func() bool {
+		return atomic.LoadInt32(&s.tokenCalls) == 3
+	}, 3*time.Second, 50*time.Millisecond)
+}
+
+// TestCloseStopsRenewal ...
+func (s *OAuthTransportTestSuite) TestCloseStopsRenewal() {
+	s.expiresIn = 121
+
+	_, err := s.get(s.ctx)
+	s.Require().NoError(err)
+	s.client.CloseIdleConnections()
+
+	time.Sleep(1500 * time.Millisecond)
+	s.assert.Equal(int32(1), atomic.LoadInt32(&s.tokenCalls))
+}
+
+// TestRevokedTokenIsReplacedAndRequestReplayed ...
+func (s *OAuthTransportTestSuite) TestRevokedTokenIsReplacedAndRequestReplayed() {
+	var apiCalls int32
+	s.apiHandler = func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&apiCalls, 1)
+		if r.Header.Get("Authorization") == "Bearer token-1" {
+			w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		fmt.Fprintf(w, "%s|%s", r.Header.Get("Authorization"), body)
+	}
+
+	// Corpo sem GetBody, como o LoggingHTTPClient e outros wrappers podem entregar ao transporte
+	req, err := http.NewRequest("POST", s.server.URL+"/api", io.NopCloser(strings.NewReader(`{"amount":10}`)))
+	s.Require().NoError(err)
+
+	resp, err := s.client.Do(req)
+	s.Require().NoError(err)
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(resp.Body)
+	s.assert.Equal(http.StatusOK, resp.StatusCode)
+	s.assert.Equal(`Bearer token-2|{"amount":10}`, string(body))
+	s.assert.Equal(int32(2), atomic.LoadInt32(&apiCalls))
+	s.assert.Equal(int32(2), atomic.LoadInt32(&s.tokenCalls))
+}
+
+// TestRevokedTokenIsRetriedOnlyOnce ...
+func (s *OAuthTransportTestSuite) TestRevokedTokenIsRetriedOnlyOnce() {
+	var apiCalls int32
+	s.apiHandler = func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&apiCalls, 1)
+		w.WriteHeader(http.StatusUnauthorized)
+	}
+
+	resp, err := s.client.Get(s.server.URL + "/api")
+	s.Require().NoError(err)
+	resp.Body.Close()
+
+	s.assert.Equal(http.StatusUnauthorized, resp.StatusCode)
+	s.assert.Equal(int32(2), atomic.LoadInt32(&apiCalls))
+	s.assert.Equal(int32(2), atomic.LoadInt32(&s.tokenCalls))
+}
+
+// TestRevokedTokenThroughService ...
+func (s *OAuthTransportTestSuite) TestRevokedTokenThroughService() {
+	s.apiHandler = func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") == "Bearer token-1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Write([]byte(`{"status":"SUCCESS","version":"1.0.0","body":{"amount":10}}`))
+	}
+
+	session, err := celcoin.NewSession(celcoin.Config{
+		ClientID:      celcoin.String("test-client-id"),
+		ClientSecret:  celcoin.String("test-client-secret"),
+		Mtls:          celcoin.Bool(false),
+		APIEndpoint:   celcoin.String(s.server.URL),
+		LoginEndpoint: celcoin.String(s.server.URL),
+	})
+	s.Require().NoError(err)
+
+	balance, err := celcoin.NewBalance(s.client, *session).Balance(s.ctx, "123456")
+	s.assert.NoError(err)
+	s.assert.Equal(10.0, balance.Body.Amount)
+}
@@ -0,0 +1,303 @@
+package celcoin
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/contbank/grok"
+)
+
+// ObservationKind ... tipo da observação
+type ObservationKind string
+
+const (
+	// ObservationOperation ... operação de um serviço (ex.: PaymentPixCashOut), incluindo validações e novas tentativas
+	ObservationOperation ObservationKind = "operation"
+	// ObservationHTTP ... cada chamada HTTP feita à Celcoin pelo transporte compartilhado
+	ObservationHTTP ObservationKind = "http"
+)
+
+// Observation ... dados de uma operação ou chamada HTTP entregues ao Observer
+type Observation struct {
+	Kind      ObservationKind
+	Service   string
+	Operation string
+	// Tenant ... tenant do contexto (WithTenant), quando houver
+	Tenant string
+	// Endpoint ... template do path (ex.: PixDictPath), sem identificadores
+	Endpoint string
+	Method   string
+	// StatusCode ... último status HTTP recebido da Celcoin; zero quando não houve resposta
+	StatusCode int
+	// ErrorCode ... chave do erro Contbank (grok.Error.Key) retornado, quando houver
+	ErrorCode string
+	Err       error
+	StartedAt time.Time
+	Duration  time.Duration
+	// Header ... cabeçalhos da requisição nas observações HTTP, para propagação de trace
+	Header http.Header
+}
+
+// Observer ... instrumentação das operações e chamadas HTTP do SDK.
+// Start recebe a observação antes da execução e o contexto retornado é usado na execução e no End.
+type Observer interface {
+	Start(ctx context.Context, observation *Observation) context.Context
+	End(ctx context.Context, observation *Observation)
+}
+
+// multiObserver ...
+type multiObserver []Observer
+
+// NewMultiObserver ... combina vários observers, como métricas e tracing
+func NewMultiObserver(observers ...Observer) Observer {
+	return multiObserver(observers)
+}
+
+// Start ...
+func (m multiObserver) Start(ctx context.Context, observation *Observation) context.Context {
+	for _, observer := range m {
+		ctx = observer.Start(ctx, observation)
+	}
+	return ctx
+}
+
+// End ...
+func (m multiObserver) End(ctx context.Context, observation *Observation) {
+	for i := len(m) - 1; i >= 0; i-- {
+		m[i].End(ctx, observation)
+	}
+}
+
+// nopObserver ...
+type nopObserver struct{}
+
+// Start ...
+func (nopObserver) Start(ctx context.Context, _ *Observation) context.Context { return ctx }
+
+// End ...
+func (nopObserver) End(context.Context, *Observation) {}
+
+// operation ... operação de serviço em andamento
+type operation struct {
+	observer       Observer
+	ctx            context.Context
+	logger         Logger
+	mutex          sync.Mutex
+	observation    Observation
+	sent           bool
+	celcoinCode    string
+	celcoinMessage string
+	// ambiguousRetry ... a requisição foi repetida após uma tentativa que a Celcoin pode ter processado
+	ambiguousRetry bool
+	// wrapErrors ... Session.WrapErrors
+	wrapErrors bool
+	// parent e inner ... operação que chamou esta e detalhes do erro da última operação interna
+	parent *operation
+	inner  *CelcoinAPIError
+}
+
+type operationKey struct{}
+
+// startOperation ... inicia a observação da operação e prepara o Logger com service, operation e request_id.
+// Um request ID é gerado quando o contexto não possui um.
+// O contexto retornado leva a operação ao LoggingHTTPClient e ao transporte.
+func (s Session) startOperation(ctx context.Context, service, name, endpoint string) (context.Context, *operation) {
+	ctx, logger := s.operationLogger(ensureRequestID(ctx), service, name)
+
+	observer := s.Observer
+	if observer == nil {
+		observer = nopObserver{}
+	}
+
+	op := &operation{
+		observer:   observer,
+		logger:     logger,
+		wrapErrors: s.WrapErrors,
+		parent:     operationFrom(ctx),
+		observation: Observation{
+			Kind:      ObservationOperation,
+			Service:   service,
+			Operation: name,
+			Tenant:    TenantFrom(ctx),
+			Endpoint:  endpoint,
+			StartedAt: time.Now(),
+		},
+	}
+	ctx = context.WithValue(ctx, operationKey{}, op)
+	op.ctx = observer.Start(ctx, &op.observation)
+	return op.ctx, op
+}
+
+// operationFrom ... operação em andamento no contexto, se houver
+func operationFrom(ctx context.Context) *operation {
+	op, _ := ctx.Value(operationKey{}).(*operation)
+	return op
+}
+
+// record ... guarda o método, o status e o erro Celcoin da última resposta HTTP da operação.
+// statusCode zero indica que a requisição foi enviada, mas não houve resposta.
+func (op *operation) record(method string, statusCode int, body []byte) {
+	op.mutex.Lock()
+	defer op.mutex.Unlock()
+	op.sent = true
+	op.observation.Method = method
+	op.observation.StatusCode = statusCode
+	op.celcoinCode, op.celcoinMessage = "", ""
+	if statusCode >= http.StatusBadRequest {
+		op.celcoinCode, op.celcoinMessage = celcoinErrorFromBody(body)
+	}
+}
+
+// retrying ... registra que a tentativa será repetida; tentativas sem resposta conclusiva em métodos não seguros
+// tornam ambíguo o erro final, mesmo que a última resposta seja uma recusa (ex.: clientCode duplicado)
+func (op *operation) retrying(method string, resp *http.Response, err error) {
+	statusCode := 0
+	if resp != nil {
+		statusCode = resp.StatusCode
+	}
+	if classifyError(method, statusCode, true, err) != ErrorClassAmbiguous {
+		return
+	}
+	op.mutex.Lock()
+	op.ambiguousRetry = true
+	op.mutex.Unlock()
+}
+
+// end ... conclui a observação com o erro retornado pela operação; usado com defer e retorno nomeado.
+// Os detalhes do erro (*CelcoinAPIError) são entregues no ResponseMeta do contexto; o erro só é substituído
+// por eles quando a sessão usa WrapErrors.
+func (op *operation) end(err *error) {
+	op.mutex.Lock()
+	observation := op.observation
+	op.mutex.Unlock()
+
+	observation.Duration = time.Since(observation.StartedAt)
+	if err != nil && *err != nil {
+		details := op.details(*err)
+		if op.parent != nil {
+			op.parent.mutex.Lock()
+			op.parent.inner = details
+			op.parent.mutex.Unlock()
+		}
+		if meta := responseMetaFrom(op.ctx); meta != nil {
+			meta.Error = details
+		}
+		if op.wrapErrors {
+			*err = details
+		}
+		observation.Err = *err
+		observation.ErrorCode = errorCode(*err)
+	}
+	op.observer.End(op.ctx, &observation)
+}
+
+// details ... dados da chamada que falhou. Erros de operações internas mantêm os detalhes da operação interna,
+// que fez a chamada HTTP.
+func (op *operation) details(err error) *CelcoinAPIError {
+	var apiErr *CelcoinAPIError
+	if errors.As(err, &apiErr) {
+		return apiErr
+	}
+
+	op.mutex.Lock()
+	defer op.mutex.Unlock()
+	if op.inner != nil && errors.Is(err, op.inner.Err) {
+		return op.inner
+	}
+	class := classifyError(op.observation.Method, op.observation.StatusCode, op.sent, err)
+	if op.ambiguousRetry {
+		class = ErrorClassAmbiguous
+	}
+	return &CelcoinAPIError{
+		Service:        op.observation.Service,
+		Operation:      op.observation.Operation,
+		Method:         op.observation.Method,
+		StatusCode:     op.observation.StatusCode,
+		CelcoinCode:    op.celcoinCode,
+		CelcoinMessage: op.celcoinMessage,
+		RequestID:      RequestIDFrom(op.ctx),
+		Class:          class,
+		Err:            err,
+	}
+}
+
+// errorCode ... chave do erro Contbank ou do erro de transporte
+func errorCode(err error) string {
+	var grokErr *grok.Error
+	if errors.As(err, &grokErr) {
+		return grokErr.Key
+	}
+	var transportErr *TransportError
+	if errors.As(err, &transportErr) {
+		return string(transportErr.Step)
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return "TIMEOUT"
+	}
+	if errors.Is(err, context.Canceled) {
+		return "CANCELED"
+	}
+	return "UNKNOWN"
+}
+
+// ObserverTransport ... RoundTripper que observa cada chamada HTTP à Celcoin
+type ObserverTransport struct {
+	transport http.RoundTripper
+	observer  Observer
+}
+
+// NewObserverTransport ...
+func NewObserverTransport(transport http.RoundTripper, observer Observer) *ObserverTransport {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	if observer == nil {
+		observer = nopObserver{}
+	}
+	return &ObserverTransport{transport: transport, observer: observer}
+}
+
+// RoundTrip ...
+func (t *ObserverTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	observation := &Observation{
+		Kind:      ObservationHTTP,
+		Tenant:    TenantFrom(req.Context()),
+		Endpoint:  req.URL.Path,
+		Method:    req.Method,
+		StartedAt: time.Now(),
+	}
+	if op := operationFrom(req.Context()); op != nil {
+		observation.Service = op.observation.Service
+		observation.Operation = op.observation.Operation
+		if len(op.observation.Endpoint) > 0 {
+			observation.Endpoint = op.observation.Endpoint
+		}
+	}
+
+	// O observer pode incluir cabeçalhos de propagação, então a requisição é clonada
+	req = req.Clone(req.Context())
+	observation.Header = req.Header
+	ctx := t.observer.Start(req.Context(), observation)
+
+	resp, err := t.transport.RoundTrip(req.WithContext(ctx))
+
+	observation.Duration = time.Since(observation.StartedAt)
+	if err != nil {
+		observation.Err = err
+		observation.ErrorCode = errorCode(err)
+	} else {
+		observation.StatusCode = resp.StatusCode
+	}
+	t.observer.End(ctx, observation)
+	return resp, err
+}
+
+// CloseIdleConnections ...
+func (t *ObserverTransport) CloseIdleConnections() {
+	if closer, ok := t.transport.(interface{ CloseIdleConnections() }); ok {
+		closer.CloseIdleConnections()
+	}
+}
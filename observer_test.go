@@ -0,0 +1,246 @@
+package celcoin_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/contbank/celcoin-sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+// ObserverTestSuite ...
+type ObserverTestSuite struct {
+	suite.Suite
+	assert *assert.Assertions
+	ctx    context.Context
+	status int
+	header http.Header
+	server *httptest.Server
+}
+
+// TestObserverTestSuite ...
+func TestObserverTestSuite(t *testing.T) {
+	suite.Run(t, new(ObserverTestSuite))
+}
+
+// SetupTest ...
+func (s *ObserverTestSuite) SetupTest() {
+	s.assert = assert.New(s.T())
+	s.ctx = context.WithValue(context.Background(), "Request-Id", "request-123")
+	s.status = http.StatusOK
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.header = r.Header.Clone()
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(s.status)
+		if s.status == http.StatusOK {
+			w.Write([]byte(`{"status":"SUCCESS","version":"1.0.0","body":{"amount":10}}`))
+		}
+	}))
+}
+
+// TearDownTest ...
+func (s *ObserverTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+// balance ...
+func (s *ObserverTestSuite) balance(observer celcoin.Observer) celcoin.BalanceInterface {
+	client := &http.Client{Transport: celcoin.NewObserverTransport(nil, observer)}
+	return celcoin.NewBalance(client, celcoin.Session{
+		APIEndpoint: s.server.URL,
+		Logger:      celcoin.NewNopLogger(),
+		Observer:    observer,
+	})
+}
+
+// TestMetricsCollectorSuccess ...
+func (s *ObserverTestSuite) TestMetricsCollectorSuccess() {
+	collector := celcoin.NewMetricsCollector(nil)
+
+	_, err := s.balance(collector).Balance(s.ctx, "123456")
+	s.Require().NoError(err)
+
+	value, found := collector.Value("celcoin_operations_total", map[string]string{
+		"service":     "balance",
+		"operation":   "Balance",
+		"status_code": "200",
+		"error_code":  "",
+	})
+	s.assert.True(found)
+	s.assert.Equal(1.0, value)
+
+	value, found = collector.Value("celcoin_http_requests_total", map[string]string{
+		"service":     "balance",
+		"operation":   "Balance",
+		"method":      http.MethodGet,
+		"endpoint":    celcoin.BalancePath,
+		"status_code": "200",
+		"error_code":  "",
+	})
+	s.assert.True(found)
+	s.assert.Equal(1.0, value)
+
+	value, found = collector.Value("celcoin_http_request_duration_seconds_count", map[string]string{
+		"method":   http.MethodGet,
+		"endpoint": celcoin.BalancePath,
+	})
+	s.assert.True(found)
+	s.assert.Equal(1.0, value)
+}
+
+// TestMetricsCollectorErrorCode ...
+func (s *ObserverTestSuite) TestMetricsCollectorErrorCode() {
+	s.status = http.StatusNotFound
+	collector := celcoin.NewMetricsCollector(nil)
+
+	_, err := s.balance(collector).Balance(s.ctx, "123456")
+	s.Require().ErrorIs(err, celcoin.ErrEntryNotFound)
+
+	value, found := collector.Value("celcoin_operations_total", map[string]string{
+		"service":     "balance",
+		"operation":   "Balance",
+		"status_code": "404",
+		"error_code":  "NOT_FOUND",
+	})
+	s.assert.True(found)
+	s.assert.Equal(1.0, value)
+}
+
+// TestMetricsCollectorWritePrometheus ...
+func (s *ObserverTestSuite) TestMetricsCollectorWritePrometheus() {
+	collector := celcoin.NewMetricsCollector([]float64{1, 5})
+
+	_, err := s.balance(collector).Balance(s.ctx, "123456")
+	s.Require().NoError(err)
+
+	var buffer bytes.Buffer
+	s.Require().NoError(collector.WritePrometheus(&buffer))
+	output := buffer.String()
+
+	s.assert.Contains(output, "# TYPE celcoin_operations_total counter")
+	s.assert.Contains(output, `celcoin_operations_total{error_code="",operation="Balance",service="balance",status_code="200"} 1`)
+	s.assert.Contains(output, "# TYPE celcoin_operation_duration_seconds histogram")
+	s.assert.Contains(output, `celcoin_operation_duration_seconds_bucket{operation="Balance",service="balance",le="1"} 1`)
+	s.assert.Contains(output, `celcoin_operation_duration_seconds_bucket{operation="Balance",service="balance",le="+Inf"} 1`)
+	s.assert.Contains(output, `celcoin_http_request_duration_seconds_count{endpoint="/baas-walletreports/v1/wallet/balance",method="GET"} 1`)
+
+	recorder := httptest.NewRecorder()
+	collector.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	s.assert.Equal(http.StatusOK, recorder.Code)
+	s.assert.Equal(output, recorder.Body.String())
+}
+
+// TestTracingObserver ...
+func (s *ObserverTestSuite) TestTracingObserver() {
+	tracer := &recordingTracer{}
+	collector := celcoin.NewMetricsCollector(nil)
+	observer := celcoin.NewMultiObserver(collector, celcoin.NewTracingObserver(tracer))
+
+	_, err := s.balance(observer).Balance(s.ctx, "123456")
+	s.Require().NoError(err)
+
+	spans := tracer.finished()
+	s.Require().Len(spans, 2)
+
+	httpSpan, operationSpan := spans[0], spans[1]
+	s.assert.Equal("HTTP GET "+celcoin.BalancePath, httpSpan.name)
+	s.assert.Equal("celcoin.balance.Balance", operationSpan.name)
+	s.assert.Same(operationSpan, httpSpan.parent)
+	s.assert.Equal("request-123", operationSpan.attributes["request_id"])
+	s.assert.Equal(http.StatusOK, httpSpan.attributes["http.status_code"])
+	s.assert.Equal("span-2", s.header.Get("traceparent"))
+
+	_, found := collector.Value("celcoin_operations_total", map[string]string{
+		"service":     "balance",
+		"operation":   "Balance",
+		"status_code": "200",
+		"error_code":  "",
+	})
+	s.assert.True(found)
+}
+
+// TestTracingObserverError ...
+func (s *ObserverTestSuite) TestTracingObserverError() {
+	s.status = http.StatusNotFound
+	tracer := &recordingTracer{}
+
+	_, err := s.balance(celcoin.NewTracingObserver(tracer)).Balance(s.ctx, "123456")
+	s.Require().Error(err)
+
+	spans := tracer.finished()
+	s.Require().Len(spans, 2)
+	s.assert.Equal("NOT_FOUND", spans[1].attributes["celcoin.error_code"])
+	s.assert.ErrorIs(spans[1].err, celcoin.ErrEntryNotFound)
+	s.assert.NoError(spans[0].err)
+}
+
+type recordingSpanKey struct{}
+
+// recordingTracer ...
+type recordingTracer struct {
+	mutex sync.Mutex
+	count int
+	ended []*recordingSpan
+}
+
+// recordingSpan ...
+type recordingSpan struct {
+	tracer     *recordingTracer
+	id         string
+	name       string
+	parent     *recordingSpan
+	attributes map[string]interface{}
+	err        error
+}
+
+// Start ...
+func (t *recordingTracer) Start(ctx context.Context, name string) (context.Context, celcoin.Span) {
+	t.mutex.Lock()
+	t.count++
+	span := &recordingSpan{
+		tracer:     t,
+		id:         "span-" + string(rune('0'+t.count)),
+		name:       name,
+		attributes: make(map[string]interface{}),
+	}
+	t.mutex.Unlock()
+
+	span.parent, _ = ctx.Value(recordingSpanKey{}).(*recordingSpan)
+	return context.WithValue(ctx, recordingSpanKey{}, span), span
+}
+
+// Inject ...
+func (t *recordingTracer) Inject(ctx context.Context, header http.Header) {
+	if span, ok := ctx.Value(recordingSpanKey{}).(*recordingSpan); ok {
+		header.Set("traceparent", span.id)
+	}
+}
+
+// finished ...
+func (t *recordingTracer) finished() []*recordingSpan {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return append([]*recordingSpan{}, t.ended...)
+}
+
+// SetAttribute ...
+func (s *recordingSpan) SetAttribute(key string, value interface{}) {
+	s.attributes[key] = value
+}
+
+// RecordError ...
+func (s *recordingSpan) RecordError(err error) {
+	s.err = err
+}
+
+// End ...
+func (s *recordingSpan) End() {
+	s.tracer.mutex.Lock()
+	defer s.tracer.mutex.Unlock()
+	s.tracer.ended = append(s.tracer.ended, s)
+}
@@ -10,38 +10,47 @@ import (
 	"net/http"
 	"net/url"
 	"path"
-
-	"github.com/contbank/grok"
-	"github.com/sirupsen/logrus"
 )
 
+// PaymentInterface define a interface para o pagamento de contas.
+type PaymentInterface interface {
+	AuthorizePayment(ctx context.Context, request *ValidatePaymentRequest) (*PaymentResponse, error)
+	ExecutePayment(ctx context.Context, request *ExecPaymentRequest) (*ExecPaymentResponse, error)
+	Get(ctx context.Context, request *GetPaymentRequest) (*GetPaymentResponse, error)
+}
+
 // Payment ...
 type Payment struct {
 	session    Session
 	httpClient *LoggingHTTPClient
 }
 
+var _ PaymentInterface = (*Payment)(nil)
+
 // NewPayment ... cria uma nova instância do serviço Payment.
-func NewPayment(httpClient *http.Client, session Session) *Payment {
+func NewPayment(httpClient *http.Client, session Session) PaymentInterface {
 	return &Payment{
 		session:    session,
-		httpClient: NewLoggingHTTPClient(httpClient),
+		httpClient: newSessionLoggingHTTPClient(httpClient, session),
 	}
 }
 
 // AuthorizePayment ... envia uma requisição para validar o pagamento via endpoint billpayment/authorize.
 func (p *Payment) AuthorizePayment(ctx context.Context,
-	request *ValidatePaymentRequest) (*PaymentResponse, error) {
+	request *ValidatePaymentRequest) (_ *PaymentResponse, err error) {
+	ctx, op := p.session.startOperation(ctx, "payment", "AuthorizePayment", BillPaymentAuthorizeBasePath)
+	defer op.end(&err)
+	logger := op.logger
 
-	requestID := grok.GetRequestID(ctx)
-	fields := logrus.Fields{
+	requestID := RequestIDFrom(ctx)
+	fields := Fields{
 		"request_id": requestID,
 		"request":    request,
 	}
 
 	u, err := url.Parse(p.session.APIEndpoint)
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error parsing API endpoint")
 		return nil, err
 	}
@@ -52,14 +61,14 @@ func (p *Payment) AuthorizePayment(ctx context.Context,
 
 	reqBytes, err := json.Marshal(request)
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error encoding model to JSON")
 		return nil, err
 	}
 
 	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewReader(reqBytes))
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error creating request")
 		return nil, err
 	}
@@ -68,7 +77,7 @@ func (p *Payment) AuthorizePayment(ctx context.Context,
 
 	resp, err := p.httpClient.Do(req)
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error performing the request")
 		return nil, err
 	}
@@ -76,7 +85,7 @@ func (p *Payment) AuthorizePayment(ctx context.Context,
 
 	respBody, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error reading response body")
 		return nil, err
 	}
@@ -84,7 +93,7 @@ func (p *Payment) AuthorizePayment(ctx context.Context,
 	if resp.StatusCode == http.StatusOK {
 		var response *PaymentResponse
 		if err := json.Unmarshal(respBody, &response); err != nil {
-			logrus.WithFields(fields).WithError(err).
+			logger.WithFields(fields).WithError(err).
 				Error("error decoding JSON response")
 			return nil, ErrDefaultPayment
 		}
@@ -93,14 +102,14 @@ func (p *Payment) AuthorizePayment(ctx context.Context,
 
 	var bodyErr ErrorDefaultResponse
 	if err := json.Unmarshal(respBody, &bodyErr); err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error decoding JSON error response")
 		return nil, err
 	}
 
 	if bodyErr.Error != nil {
 		err := FindPaymentError(*bodyErr.Error.ErrorCode, &resp.StatusCode)
-		logrus.WithField("celcoin_error", bodyErr.Error).WithFields(fields).WithError(err).
+		logger.WithField("celcoin_error", bodyErr.Error).WithField("celcoin_status", resp.StatusCode).WithFields(fields).WithError(err).
 			Error("celcoin authorize payment error")
 		return nil, err
 	}
@@ -109,16 +118,19 @@ func (p *Payment) AuthorizePayment(ctx context.Context,
 }
 
 // ExecutePayment ... envia uma requisição para confirmar o pagamento via endpoint billpayment/confirm.
-func (p *Payment) ExecutePayment(ctx context.Context, request *ExecPaymentRequest) (*ExecPaymentResponse, error) {
-	requestID := grok.GetRequestID(ctx)
-	fields := logrus.Fields{
+func (p *Payment) ExecutePayment(ctx context.Context, request *ExecPaymentRequest) (_ *ExecPaymentResponse, err error) {
+	ctx, op := p.session.startOperation(ctx, "payment", "ExecutePayment", BillPaymentConfirmBasePath)
+	defer op.end(&err)
+	logger := op.logger
+	requestID := RequestIDFrom(ctx)
+	fields := Fields{
 		"request_id": requestID,
 		"request":    request,
 	}
 
 	u, err := url.Parse(p.session.APIEndpoint)
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error parsing API endpoint")
 		return nil, err
 	}
@@ -129,14 +141,14 @@ func (p *Payment) ExecutePayment(ctx context.Context, request *ExecPaymentReques
 
 	reqBytes, err := json.Marshal(request)
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error encoding model to JSON")
 		return nil, err
 	}
 
 	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewReader(reqBytes))
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error creating request")
 		return nil, err
 	}
@@ -145,7 +157,7 @@ func (p *Payment) ExecutePayment(ctx context.Context, request *ExecPaymentReques
 
 	resp, err := p.httpClient.Do(req)
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error performing the request")
 		return nil, err
 	}
@@ -154,7 +166,7 @@ func (p *Payment) ExecutePayment(ctx context.Context, request *ExecPaymentReques
 
 	respBody, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error reading response body")
 		return nil, err
 	}
@@ -162,7 +174,7 @@ func (p *Payment) ExecutePayment(ctx context.Context, request *ExecPaymentReques
 	if resp.StatusCode == http.StatusOK {
 		var response ExecPaymentResponse
 		if err := json.Unmarshal(respBody, &response); err != nil {
-			logrus.WithFields(fields).WithError(err).
+			logger.WithFields(fields).WithError(err).
 				Error("error decoding JSON response")
 			return nil, ErrDefaultPayment
 		}
@@ -171,14 +183,14 @@ func (p *Payment) ExecutePayment(ctx context.Context, request *ExecPaymentReques
 
 	var bodyErr ErrorDefaultResponse
 	if err := json.Unmarshal(respBody, &bodyErr); err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error decoding JSON error response")
 		return nil, ErrDefaultPayment
 	}
 
 	if bodyErr.Error != nil {
 		err := FindPaymentError(*bodyErr.Error.ErrorCode, &resp.StatusCode)
-		logrus.WithField("celcoin_error", bodyErr.Error).WithFields(fields).WithError(err).
+		logger.WithField("celcoin_error", bodyErr.Error).WithField("celcoin_status", resp.StatusCode).WithFields(fields).WithError(err).
 			Error("celcoin authorize payment error")
 		return nil, err
 	}
@@ -187,16 +199,19 @@ func (p *Payment) ExecutePayment(ctx context.Context, request *ExecPaymentReques
 }
 
 // GetPayment ... envia uma requisição para confirmar o pagamento via endpoint billpayment/confirm.
-func (p *Payment) Get(ctx context.Context, request *GetPaymentRequest) (*GetPaymentResponse, error) {
-	requestID := grok.GetRequestID(ctx)
-	fields := logrus.Fields{
+func (p *Payment) Get(ctx context.Context, request *GetPaymentRequest) (_ *GetPaymentResponse, err error) {
+	ctx, op := p.session.startOperation(ctx, "payment", "Get", BillPaymenStatusBasePath)
+	defer op.end(&err)
+	logger := op.logger
+	requestID := RequestIDFrom(ctx)
+	fields := Fields{
 		"request_id": requestID,
 		"request":    request,
 	}
 
 	u, err := url.Parse(p.session.APIEndpoint)
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error parsing API endpoint")
 		return nil, err
 	}
@@ -218,7 +233,7 @@ func (p *Payment) Get(ctx context.Context, request *GetPaymentRequest) (*GetPaym
 
 	req, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error creating request")
 		return nil, err
 	}
@@ -227,7 +242,7 @@ func (p *Payment) Get(ctx context.Context, request *GetPaymentRequest) (*GetPaym
 
 	resp, err := p.httpClient.Do(req)
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error performing the request")
 		return nil, err
 	}
@@ -236,7 +251,7 @@ func (p *Payment) Get(ctx context.Context, request *GetPaymentRequest) (*GetPaym
 
 	respBody, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error reading response body")
 		return nil, err
 	}
@@ -244,7 +259,7 @@ func (p *Payment) Get(ctx context.Context, request *GetPaymentRequest) (*GetPaym
 	if resp.StatusCode == http.StatusOK {
 		var response GetPaymentResponse
 		if err := json.Unmarshal(respBody, &response); err != nil {
-			logrus.WithFields(fields).WithError(err).
+			logger.WithFields(fields).WithError(err).
 				Error("error decoding JSON response")
 			return nil, ErrDefaultPayment
 		}
@@ -253,14 +268,14 @@ func (p *Payment) Get(ctx context.Context, request *GetPaymentRequest) (*GetPaym
 
 	var bodyErr ErrorDefaultResponse
 	if err := json.Unmarshal(respBody, &bodyErr); err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error decoding JSON error response")
 		return nil, ErrDefaultPayment
 	}
 
 	if bodyErr.Error != nil {
 		err := FindPaymentError(*bodyErr.Error.ErrorCode, &resp.StatusCode)
-		logrus.WithField("celcoin_error", bodyErr.Error).WithFields(fields).WithError(err).
+		logger.WithField("celcoin_error", bodyErr.Error).WithField("celcoin_status", resp.StatusCode).WithFields(fields).WithError(err).
 			Error("celcoin authorize payment error")
 		return nil, err
 	}
@@ -1,18 +1,14 @@
 package celcoin
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
-	"io/ioutil"
 	"net/http"
 	"net/url"
-	"path"
 	"strings"
 
 	"github.com/contbank/grok"
-	"github.com/sirupsen/logrus"
 )
 
 // PixInterface define a interface para operações relacionadas ao serviço de Pix.
@@ -31,7 +27,8 @@ type PixInterface interface {
 	// IMMEDIATE, STATIC
 	GetExternalPixKey(ctx context.Context, account string, key string, ownerTaxId string) (*PixExternalKeyResponse, error)
 	// DUEDATE
-	GetExternalPixKeyDueDate(ctx context.Context, documentNumberReceiver string, key string) (*PixExternalKeyDueDateResponse, error)
+	GetExternalPixKeyDueDate(ctx context.Context, account, documentNumberReceiver, key *string) (*PixExternalKeyDueDateResponse, error)
+	GetExternalPixKeyDueDateDeprecated(ctx context.Context, documentNumberReceiver string, key string) (*PixExternalKeyDueDateResponse, error)
 	DeletePixKey(ctx context.Context, account, key string) error
 
 	// CASH OUT - EFETUANDO PAGAMENTO
@@ -68,507 +65,219 @@ type Pix struct {
 	authentication *Authentication
 }
 
-// NewPixs cria uma nova instância de Pix.
-func NewPix(httpClient *http.Client, session Session) *Pix {
+var _ PixInterface = (*Pix)(nil)
+
+// NewPix cria uma nova instância de Pix.
+func NewPix(httpClient *http.Client, session Session) PixInterface {
 	return &Pix{
 		session:        session,
-		httpClient:     NewLoggingHTTPClient(httpClient),
+		httpClient:     newSessionLoggingHTTPClient(httpClient, session),
 		authentication: NewAuthentication(httpClient, session),
 	}
 }
 
+// Método genérico para construir URLs
+// pixErrors ... respostas de erro das APIs de Pix
+var pixErrors = envelopeErrors{find: ignoreMessage(FindPixError), fallback: ErrDefaultPix}
+
+// pixPaymentErrors ... como pixErrors, mas códigos não mapeados mantêm a mensagem da Celcoin
+var pixPaymentErrors = envelopeErrors{find: FindPixErrorWithMessage, fallback: ErrDefaultPix}
+
+// jsonPatchHeader ... cabeçalhos das consultas de chave externa do DICT
+var jsonPatchHeader = map[string]string{"accept": "application/json", "Content-Type": "application/json-patch+json"}
+
+// jsonAcceptHeader ...
+var jsonAcceptHeader = map[string]string{"Accept": "application/json", "Content-Type": "application/json"}
+
 // Método genérico para construir URLs
 func (s *Pix) BuildEndpoint(basePath string, queryParams map[string]string, pathParams ...string) (*string, error) {
-	u, err := url.Parse(s.session.APIEndpoint)
+	logger := s.session.logger("pix")
+	endpoint, err := buildEndpoint(s.session.APIEndpoint, basePath, queryParams, pathParams...)
 	if err != nil {
-		logrus.WithError(err).Error("Error parsing API endpoint")
+		logger.WithError(err).Error("Error parsing API endpoint")
 		return nil, err
 	}
 
-	// Construção do caminho completo
-	fullPath := path.Join(basePath, path.Join(pathParams...))
-	u.Path = path.Join(u.Path, fullPath)
-
-	// Adição de query parameters
-	if queryParams != nil {
-		q := u.Query()
-		for key, value := range queryParams {
-			if value != "" {
-				q.Set(key, value)
-			}
-		}
-		u.RawQuery = q.Encode()
-	}
-
-	endpoint := u.String()
-	logrus.WithField("endpoint", endpoint).Info("Endpoint built successfully")
+	logger.WithField("endpoint", endpoint).Debug("Endpoint built successfully")
 	return &endpoint, nil
 }
 
 // CreatePixKey cadastra uma nova chave Pix.
-func (s *Pix) CreatePixKey(ctx context.Context, req PixKeyRequest) (*PixKeyResponse, error) {
-	fields := logrus.Fields{"request": req}
-	logrus.WithFields(fields).Info("Create Pix Key")
+func (s *Pix) CreatePixKey(ctx context.Context, req PixKeyRequest) (_ *PixKeyResponse, err error) {
+	ctx, op := s.session.startOperation(ctx, "pix", "CreatePixKey", PixDictPath)
+	defer op.end(&err)
+	logger := op.logger
+	fields := Fields{"request": req}
+	logger.WithFields(fields).Info("Create Pix Key")
 
-	err := grok.Validator.Struct(req)
+	err = grok.Validator.Struct(req)
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error validating model")
+		logger.WithFields(fields).WithError(err).Error("Error validating model")
 		return nil, grok.FromValidationErros(err)
 	}
 
-	endpoint, err := s.BuildEndpoint(PixDictPath, nil)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error building endpoint for CreatePixKey")
-		return nil, err
-	}
-
-	logrus.WithField("endpoint", *endpoint).Info("Calling CreatePixKey")
-
-	payload, err := json.Marshal(req)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error serializing request")
-		return nil, fmt.Errorf("error serializing request: %v", err)
-	}
-
-	httpReq, err := http.NewRequestWithContext(ctx, "POST", *endpoint, bytes.NewReader(payload))
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error creating HTTP request")
-		return nil, fmt.Errorf("error creating HTTP request: %v", err)
-	}
-
-	httpReq.Header.Set("Content-Type", "application/json")
-	resp, err := s.httpClient.Do(httpReq)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error in HTTP client")
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	respBody, _ := ioutil.ReadAll(resp.Body)
-
-	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusAccepted || resp.StatusCode == http.StatusCreated {
-		var response *PixKeyResponse
-
-		if err := json.Unmarshal(respBody, &response); err != nil {
-			logrus.WithFields(fields).WithError(err).
-				Error("error decoding json response")
-			return nil, ErrDefaultPix
-		}
-		return response, nil
-	}
-
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, ErrEntryNotFound
-	}
-
-	var errResponse *ErrorDefaultResponse
-	if err := json.Unmarshal(respBody, &errResponse); err != nil {
-		logrus.WithFields(fields).WithError(err).
-			Error("error decoding json response")
-		return nil, ErrDefaultPix
-	}
-
-	if errResponse.Error != nil {
-		err := FindPixError(*errResponse.Error.ErrorCode, &resp.StatusCode)
-		logrus.WithField("celcoin_error", errResponse.Error).
-			WithFields(fields).WithError(err).
-			Error("celcoin get pix error")
-		return nil, err
-	}
-
-	return nil, ErrDefaultPix
+	return execute[PixKeyResponse](ctx, s.httpClient, s.session, apiRequest{
+		method:   http.MethodPost,
+		path:     PixDictPath,
+		body:     req,
+		header:   jsonHeader,
+		notFound: ErrEntryNotFound,
+		errors:   pixErrors,
+		fields:   fields,
+	})
 }
 
 // GetPixKeys consulta as chaves Pix de uma conta.
-func (s *Pix) GetPixKeys(ctx context.Context, account string) (*PixKeyListResponse, error) {
-	fields := logrus.Fields{"account": account}
-	logrus.WithFields(fields).Info("Get Pix Keys")
-
-	endpoint, err := s.BuildEndpoint(PixDictPath, nil, account)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error building endpoint for GetPixKeys")
-		return nil, err
-	}
-
-	logrus.WithField("endpoint", *endpoint).Info("Calling GetPixKeys")
-
-	httpReq, err := http.NewRequestWithContext(ctx, "GET", *endpoint, nil)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error creating HTTP request")
-		return nil, fmt.Errorf("error creating HTTP request: %v", err)
-	}
-
-	httpReq.Header.Set("Content-Type", "application/json")
-	resp, err := s.httpClient.Do(httpReq)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error in HTTP client")
-		return nil, fmt.Errorf("error making HTTP request: %v", err)
-	}
-	defer resp.Body.Close()
-
-	respBody, _ := ioutil.ReadAll(resp.Body)
-
-	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusAccepted || resp.StatusCode == http.StatusCreated {
-		var response *PixKeyListResponse
-
-		if err := json.Unmarshal(respBody, &response); err != nil {
-			logrus.WithFields(fields).WithError(err).
-				Error("error decoding json response")
-			return nil, ErrDefaultPix
-		}
-		return response, nil
-	}
-
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, ErrEntryNotFound
-	}
-
-	var errResponse *ErrorDefaultResponse
-	if err := json.Unmarshal(respBody, &errResponse); err != nil {
-		logrus.WithFields(fields).WithError(err).
-			Error("error decoding json response")
-		return nil, ErrDefaultPix
-	}
-
-	if errResponse.Error != nil {
-		err := FindPixError(*errResponse.Error.ErrorCode, &resp.StatusCode)
-		logrus.WithField("celcoin_error", errResponse.Error).
-			WithFields(fields).WithError(err).
-			Error("celcoin get pix error")
-		return nil, err
-	}
-
-	return nil, ErrDefaultPix
-
+func (s *Pix) GetPixKeys(ctx context.Context, account string) (_ *PixKeyListResponse, err error) {
+	ctx, op := s.session.startOperation(ctx, "pix", "GetPixKeys", PixDictPath)
+	defer op.end(&err)
+	logger := op.logger
+	fields := Fields{"account": account}
+	logger.WithFields(fields).Info("Get Pix Keys")
+
+	return execute[PixKeyListResponse](ctx, s.httpClient, s.session, apiRequest{
+		method:     http.MethodGet,
+		path:       PixDictPath,
+		pathParams: []string{account},
+		header:     jsonHeader,
+		notFound:   ErrEntryNotFound,
+		errors:     pixErrors,
+		fields:     fields,
+	})
 }
 
 // DeletePixKey exclui uma chave Pix.
-func (s *Pix) DeletePixKey(ctx context.Context, account, key string) error {
-	fields := logrus.Fields{"account": account, "key": key}
-	logrus.WithFields(fields).Info("Delete Pix Key")
+func (s *Pix) DeletePixKey(ctx context.Context, account, key string) (err error) {
+	ctx, op := s.session.startOperation(ctx, "pix", "DeletePixKey", PixDictPath)
+	defer op.end(&err)
+	logger := op.logger
+	fields := Fields{"account": account, "key": key}
+	logger.WithFields(fields).Info("Delete Pix Key")
 
 	if account == "" || key == "" {
 		err := fmt.Errorf("account and key are required")
-		logrus.WithFields(fields).WithError(err).Error("Invalid input parameters")
-		return err
-	}
-
-	endpoint, err := s.BuildEndpoint(PixDictPath, nil, key)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error building endpoint for DeletePixKey")
+		logger.WithFields(fields).WithError(err).Error("Invalid input parameters")
 		return err
 	}
 
-	logrus.WithField("endpoint", *endpoint).Info("Calling DeletePixKey")
-
-	payload := map[string]string{
-		"account": account,
-	}
-
-	payloadBytes, err := json.Marshal(payload)
+	// A Celcoin não retorna 404 para chaves inexistentes; o erro vem no envelope
+	_, err = execute[struct{}](ctx, s.httpClient, s.session, apiRequest{
+		method:          http.MethodDelete,
+		path:            PixDictPath,
+		pathParams:      []string{key},
+		body:            map[string]string{"account": account},
+		header:          jsonHeader,
+		discardResponse: true,
+		errors:          pixErrors,
+		fields:          fields,
+	})
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error serializing payload")
-		return fmt.Errorf("error serializing payload: %v", err)
-	}
-
-	httpReq, err := http.NewRequestWithContext(ctx, "DELETE", *endpoint, bytes.NewReader(payloadBytes))
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error creating HTTP request")
-		return fmt.Errorf("error creating HTTP request: %v", err)
-	}
-
-	httpReq.Header.Set("Content-Type", "application/json")
-	resp, err := s.httpClient.Do(httpReq)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error in HTTP client")
-		return err
-	}
-	defer resp.Body.Close()
-
-	respBody, _ := ioutil.ReadAll(resp.Body)
-
-	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusAccepted || resp.StatusCode == http.StatusCreated {
-		logrus.WithFields(fields).Info("Pix key deleted successfully")
-		return nil
-	}
-
-	var errResponse *ErrorDefaultResponse
-	if err := json.Unmarshal(respBody, &errResponse); err != nil {
-		logrus.WithFields(fields).WithError(err).
-			Error("error decoding json response")
-		return ErrDefaultPix
-	}
-
-	if errResponse.Error != nil {
-		err := FindPixError(*errResponse.Error.ErrorCode, &resp.StatusCode)
-		logrus.WithField("celcoin_error", errResponse.Error).
-			WithFields(fields).WithError(err).
-			Error("celcoin get pix error")
 		return err
 	}
 
-	return ErrDefaultPix
+	logger.WithFields(fields).Info("Pix key deleted successfully")
+	return nil
 }
 
 // GetExternalPixKey consulta uma chave Pix externa (DICT).
-func (s *Pix) GetExternalPixKey(ctx context.Context, account string, key string, ownerTaxId string) (*PixExternalKeyResponse, error) {
-	fields := logrus.Fields{
+func (s *Pix) GetExternalPixKey(ctx context.Context, account string, key string, ownerTaxId string) (_ *PixExternalKeyResponse, err error) {
+	ctx, op := s.session.startOperation(ctx, "pix", "GetExternalPixKey", PixDictExternalEntryV2Path)
+	defer op.end(&err)
+	logger := op.logger
+	fields := Fields{
 		"key":        key,
 		"ownerTaxId": ownerTaxId,
 		"account":    account,
 	}
-	logrus.WithFields(fields).Info("Get External Pix Key")
+	logger.WithFields(fields).Info("Get External Pix Key")
 
 	// BaaS v2: GET {API}/baas/v2/pix/dict/entry/external/{account}?key=&ownerTaxId=
-	u, err := url.Parse(s.session.APIEndpoint)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error parsing API endpoint")
-		return nil, err
-	}
-	u.Path = path.Join(u.Path, PixDictExternalEntryV2Path)
-	u.Path = path.Join(u.Path, account)
-	q := u.Query()
-	if key != "" {
-		q.Set("key", key)
-	}
-	if ownerTaxId != "" {
-		q.Set("ownerTaxId", ownerTaxId)
-	}
-	u.RawQuery = q.Encode()
-	endpoint := u.String()
-
-	logrus.WithField("endpoint", endpoint).Info("Endpoint built successfully")
-
-	httpReq, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error creating HTTP request")
-		return nil, fmt.Errorf("error creating HTTP request: %v", err)
-	}
-
-	httpReq.Header.Set("Content-Type", "application/json")
-	resp, err := s.httpClient.Do(httpReq)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error in HTTP client")
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	respBody, _ := ioutil.ReadAll(resp.Body)
-
-	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusAccepted || resp.StatusCode == http.StatusCreated {
-		var response *PixExternalKeyResponse
-
-		if err := json.Unmarshal(respBody, &response); err != nil {
-			logrus.WithFields(fields).WithError(err).
-				Error("error decoding json response")
-			return nil, ErrDefaultPix
-		}
-
-		return response, nil
-	}
-
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, ErrEntryNotFound
-	}
-
-	var errResponse *ErrorDefaultResponse
-	if err := json.Unmarshal(respBody, &errResponse); err != nil {
-		logrus.WithFields(fields).WithError(err).
-			Error("error decoding json response")
-		return nil, ErrDefaultPix
-	}
-
-	if errResponse.Error != nil {
-		err := FindPixError(*errResponse.Error.ErrorCode, &resp.StatusCode)
-		logrus.WithField("celcoin_error", errResponse.Error).
-			WithFields(fields).WithError(err).
-			Error("celcoin get pix error")
-		return nil, err
-	}
-
-	return nil, ErrDefaultPix
+	return execute[PixExternalKeyResponse](ctx, s.httpClient, s.session, apiRequest{
+		method:     http.MethodGet,
+		path:       PixDictExternalEntryV2Path,
+		pathParams: []string{account},
+		query:      map[string]string{"key": key, "ownerTaxId": ownerTaxId},
+		header:     jsonHeader,
+		notFound:   ErrEntryNotFound,
+		errors:     pixErrors,
+		fields:     fields,
+	})
 }
 
 // GetExternalPixKeyDueDate realiza uma consulta POST para o endpoint Celcoin para obter informações sobre uma chave Pix com vencimento(duedate).
 func (s *Pix) GetExternalPixKeyDueDate(ctx context.Context,
-	account, documentNumberReceiver, key *string) (*PixExternalKeyDueDateResponse, error) {
-	fields := logrus.Fields{
+	account, documentNumberReceiver, key *string) (_ *PixExternalKeyDueDateResponse, err error) {
+	ctx, op := s.session.startOperation(ctx, "pix", "GetExternalPixKeyDueDate", PixDictExternalEntryV2Path)
+	defer op.end(&err)
+	logger := op.logger
+	fields := Fields{
 		"ownerTaxId": documentNumberReceiver,
 		"key":        key,
 		"account":    account,
 	}
 
-	logrus.WithFields(fields).Info("Get External Pix Key")
-
-	u, err := url.Parse(s.session.APIEndpoint)
-	if err != nil {
-		logrus.WithError(err).Error("Error parsing API endpoint")
-		return nil, err
-	}
-
-	u.Path = path.Join(u.Path, PixDictExternalEntryV2Path)
+	logger.WithFields(fields).Info("Get External Pix Key")
 
+	var pathParams []string
 	if account != nil {
-		u.Path = path.Join(u.Path, *account)
+		pathParams = append(pathParams, *account)
 	}
 
-	q := u.Query()
-
+	query := map[string]string{"includeStatistics": "false"}
 	if documentNumberReceiver != nil {
-		q.Set("ownerTaxId", *documentNumberReceiver)
+		query["ownerTaxId"] = *documentNumberReceiver
 	}
 	if key != nil {
-		q.Set("key", *key)
-	}
-
-	q.Set("includeStatistics", "false")
-
-	u.RawQuery = q.Encode()
-
-	endpoint := u.String()
-
-	logrus.WithField("endpoint", endpoint).Info("Endpoint built successfully")
-
-	httpReq, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error creating HTTP request")
-		return nil, fmt.Errorf("error creating HTTP request: %v", err)
-	}
-
-	httpReq.Header.Set("accept", "application/json")
-	httpReq.Header.Set("Content-Type", "application/json-patch+json")
-
-	resp, err := s.httpClient.Do(httpReq)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error in HTTP client")
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	respBody, _ := ioutil.ReadAll(resp.Body)
-
-	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusAccepted || resp.StatusCode == http.StatusCreated {
-		var response *PixExternalKeyDueDateResponse
-
-		if err := json.Unmarshal(respBody, &response); err != nil {
-			logrus.WithFields(fields).WithError(err).
-				Error("error decoding json response")
-			return nil, ErrDefaultPix
-		}
-
-		return response, nil
-	}
-
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, ErrEntryNotFound
-	}
-
-	var errResponse *ErrorDefaultResponse
-	if err := json.Unmarshal(respBody, &errResponse); err != nil {
-		logrus.WithFields(fields).WithError(err).
-			Error("error decoding json response")
-		return nil, ErrDefaultPix
-	}
-
-	if errResponse.Error != nil {
-		err := FindPixError(*errResponse.Error.ErrorCode, &resp.StatusCode)
-		logrus.WithField("celcoin_error", errResponse.Error).
-			WithFields(fields).WithError(err).
-			Error("celcoin get pix error")
-		return nil, err
+		query["key"] = *key
 	}
 
-	return nil, ErrDefaultPix
+	return execute[PixExternalKeyDueDateResponse](ctx, s.httpClient, s.session, apiRequest{
+		method:     http.MethodGet,
+		path:       PixDictExternalEntryV2Path,
+		pathParams: pathParams,
+		query:      query,
+		header:     jsonPatchHeader,
+		notFound:   ErrEntryNotFound,
+		errors:     pixErrors,
+		fields:     fields,
+	})
 }
 
 // Deprecated - Deprecated at 05/05/2025
 // GetExternalPixKeyDueDateDeprecated realiza uma consulta POST para o endpoint Celcoin para obter informações sobre uma chave Pix com vencimento(duedate).
-func (s *Pix) GetExternalPixKeyDueDateDeprecated(ctx context.Context, documentNumberReceiver string, key string) (*PixExternalKeyDueDateResponse, error) {
-	fields := logrus.Fields{
+func (s *Pix) GetExternalPixKeyDueDateDeprecated(ctx context.Context, documentNumberReceiver string, key string) (_ *PixExternalKeyDueDateResponse, err error) {
+	ctx, op := s.session.startOperation(ctx, "pix", "GetExternalPixKeyDueDateDeprecated", PixDictDueDatePath)
+	defer op.end(&err)
+	logger := op.logger
+	fields := Fields{
 		"payerId": documentNumberReceiver,
 		"key":     key,
 	}
-	logrus.WithFields(fields).Info("Get External Pix Key Due Date")
-
-	requestBody := map[string]string{
-		"payerId": documentNumberReceiver,
-		"key":     key,
-	}
-
-	requestBodyBytes, err := json.Marshal(requestBody)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error marshaling request body")
-		return nil, fmt.Errorf("error marshaling request body: %v", err)
-	}
-
-	endpoint, err := s.BuildEndpoint(PixDictDueDatePath, nil)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error building endpoint")
-		return nil, err
-	}
-
-	logrus.WithField("endpoint", *endpoint).Info("Endpoint built successfully")
-
-	httpReq, err := http.NewRequestWithContext(ctx, "POST", *endpoint, bytes.NewBuffer(requestBodyBytes))
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error creating HTTP request")
-		return nil, fmt.Errorf("error creating HTTP request: %v", err)
-	}
-
-	httpReq.Header.Set("accept", "application/json")
-	httpReq.Header.Set("Content-Type", "application/json-patch+json")
-
-	resp, err := s.httpClient.Do(httpReq)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error in HTTP client")
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	respBody, _ := ioutil.ReadAll(resp.Body)
-
-	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusAccepted || resp.StatusCode == http.StatusCreated {
-		var response *PixExternalKeyDueDateResponse
-
-		if err := json.Unmarshal(respBody, &response); err != nil {
-			logrus.WithFields(fields).WithError(err).
-				Error("error decoding json response")
-			return nil, ErrDefaultPix
-		}
-
-		return response, nil
-	}
-
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, ErrEntryNotFound
-	}
-
-	var errResponse *ErrorDefaultResponse
-	if err := json.Unmarshal(respBody, &errResponse); err != nil {
-		logrus.WithFields(fields).WithError(err).
-			Error("error decoding json response")
-		return nil, ErrDefaultPix
-	}
-
-	if errResponse.Error != nil {
-		err := FindPixError(*errResponse.Error.ErrorCode, &resp.StatusCode)
-		logrus.WithField("celcoin_error", errResponse.Error).
-			WithFields(fields).WithError(err).
-			Error("celcoin get pix error")
-		return nil, err
-	}
+	logger.WithFields(fields).Info("Get External Pix Key Due Date")
 
-	return nil, ErrDefaultPix
+	return execute[PixExternalKeyDueDateResponse](ctx, s.httpClient, s.session, apiRequest{
+		method: http.MethodPost,
+		path:   PixDictDueDatePath,
+		body: map[string]string{
+			"payerId": documentNumberReceiver,
+			"key":     key,
+		},
+		header:   jsonPatchHeader,
+		notFound: ErrEntryNotFound,
+		errors:   pixErrors,
+		fields:   fields,
+	})
 }
 
 // logPixPaymentPayloadIfSandbox registra o JSON do POST Pix Out v2 apenas em sandbox/dev (validação de payload).
 func (s *Pix) logPixPaymentPayloadIfSandbox(payload []byte) {
+	logger := s.session.logger("pix")
 	env := strings.ToUpper(strings.TrimSpace(s.session.Environment))
 	ep := strings.ToLower(s.session.APIEndpoint)
 	if env != CelcoinEnvSandbox && !strings.Contains(ep, "sandbox") && !strings.Contains(ep, "openfinance.celcoin.dev") {
 		return
 	}
-	logrus.WithField("celcoin_baas_v2_pix_payment_json", string(payload)).Debug("Pix Out v2 JSON enviado à Celcoin")
+	logger.WithField("celcoin_baas_v2_pix_payment_json", string(payload)).Debug("Pix Out v2 JSON enviado à Celcoin")
 }
 
 // PerformPixCashOut ...
@@ -576,977 +285,361 @@ func (s *Pix) logPixPaymentPayloadIfSandbox(payload []byte) {
 // Realizar um Pix Cash-Out por Agência e Conta
 // Realizar um Pix Cash-out por QR Code Estático
 // Realizar um Pix Cash-out por QR Code Dinâmico
-func (s *Pix) PaymentPixCashOut(ctx context.Context, req PixCashOutRequest) (*PixCashOutResponse, error) {
+func (s *Pix) PaymentPixCashOut(ctx context.Context, req PixCashOutRequest) (_ *PixCashOutResponse, err error) {
+	ctx, op := s.session.startOperation(ctx, "pix", "PaymentPixCashOut", PixPaymentV2Path)
+	defer op.end(&err)
+	logger := op.logger
 
 	// deixando como upper pois contbank usa parametros minusculos mas é obrigatório na celcoin maiusculo
 	req.InitiationType = strings.ToUpper(req.InitiationType)
 	req.TransactionType = strings.ToUpper(req.TransactionType)
 
-	fields := logrus.Fields{"request": req}
-	logrus.WithFields(fields).Info("Create Pix PerformPixCashOut")
+	fields := Fields{"request": req}
+	logger.WithFields(fields).Info("Create Pix PerformPixCashOut")
 
 	if err := validatePixCashOut(req); err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error validating fields")
-		return nil, err
-	}
-
-	endpoint, err := s.BuildEndpoint(PixPaymentV2Path, nil)
-	if err != nil {
+		logger.WithFields(fields).WithError(err).Error("Error validating fields")
 		return nil, err
 	}
 
-	logrus.WithField("endpoint", *endpoint).Info("Endpoint built successfully")
-
 	payload, err := json.Marshal(req)
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error serializing request")
+		logger.WithFields(fields).WithError(err).Error("Error serializing request")
 		return nil, fmt.Errorf("error serializing request: %v", err)
 	}
 	s.logPixPaymentPayloadIfSandbox(payload)
 
-	httpReq, err := http.NewRequestWithContext(ctx, "POST", *endpoint, bytes.NewReader(payload))
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error creating HTTP request")
-		return nil, fmt.Errorf("error creating HTTP request: %v", err)
-	}
-	httpReq.Header.Set("Content-Type", "application/json")
-	resp, err := s.httpClient.Do(httpReq)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error in HTTP client")
-		return nil, err
-	}
-	defer resp.Body.Close()
+	return execute[PixCashOutResponse](ctx, s.httpClient, s.session, apiRequest{
+		method:   http.MethodPost,
+		path:     PixPaymentV2Path,
+		body:     json.RawMessage(payload),
+		header:   jsonHeader,
+		notFound: ErrEntryNotFound,
+		errors:   pixPaymentErrors,
+		fields:   fields,
+	})
+}
 
-	respBody, _ := ioutil.ReadAll(resp.Body)
+// DecodeEmvQRCode... Decofificando o qrcode do pix copia e cola
+func (s *Pix) DecodeEmvQRCode(ctx context.Context, emv string) (_ *QRCodeResponse, err error) {
+	ctx, op := s.session.startOperation(ctx, "pix", "DecodeEmvQRCode", PixEmvPath)
+	defer op.end(&err)
+	logger := op.logger
+	fields := Fields{"emv": emv}
+	logger.WithFields(fields).Info("Decoding QR Code")
+
+	return execute[QRCodeResponse](ctx, s.httpClient, s.session, apiRequest{
+		method:   http.MethodPost,
+		path:     PixEmvPath,
+		body:     map[string]string{"emv": emv},
+		header:   jsonHeader,
+		notFound: ErrEntryNotFound,
+		errors:   pixErrors,
+		fields:   fields,
+	})
+}
 
-	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusAccepted || resp.StatusCode == http.StatusCreated {
-		var response *PixCashOutResponse
-		if err := json.Unmarshal(respBody, &response); err != nil {
-			logrus.WithFields(fields).WithError(err).Error("error decoding json response")
-			return nil, ErrDefaultPix
-		}
-		return response, nil
-	}
+// GetPixCashoutStatus consulta o status de uma transferência Pix-Out.
+func (s *Pix) GetPixCashoutStatus(ctx context.Context, id, endtoendId, clientCode string) (_ *PixCashoutStatusTransactionResponse, err error) {
+	ctx, op := s.session.startOperation(ctx, "pix", "GetPixCashoutStatus", PixPaymentV2Path)
+	defer op.end(&err)
+	logger := op.logger
+	fields := Fields{"id": id, "endtoendId": endtoendId, "clientCode": clientCode}
+	logger.WithFields(fields).Info("Consultando status do Pix Cashout")
 
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, ErrEntryNotFound
+	if id == "" && endtoendId == "" && clientCode == "" {
+		logger.WithFields(fields).Error("é necessário informar pelo menos um dos campos: id, endtoendId, ou clientCode")
+		return nil, fmt.Errorf("é necessário informar pelo menos um dos campos: id, endtoendId, ou clientCode")
 	}
 
-	var errResponse *ErrorDefaultResponse
-	if err := json.Unmarshal(respBody, &errResponse); err != nil {
-		logrus.WithFields(fields).WithError(err).Error("error decoding json response")
-		return nil, ErrDefaultPix
+	return execute[PixCashoutStatusTransactionResponse](ctx, s.httpClient, s.session, apiRequest{
+		method:     http.MethodGet,
+		path:       PixPaymentV2Path,
+		pathParams: []string{"status"},
+		query: map[string]string{
+			"id":         id,
+			"endtoendId": endtoendId,
+			"clientCode": clientCode,
+		},
+		header:   jsonHeader,
+		notFound: ErrEntryNotFound,
+		errors:   pixErrors,
+		fields:   fields,
+	})
+}
+
+// GetPixCashinStatus consulta o status de uma devolução Pix (Pix Cash-In).
+func (s *Pix) GetPixCashinStatus(ctx context.Context, returnIdentification, transactionId, clientCode string) (_ *PixCashinStatusTransactionResponse, err error) {
+	ctx, op := s.session.startOperation(ctx, "pix", "GetPixCashinStatus", PixCashInStatusPath)
+	defer op.end(&err)
+	logger := op.logger
+	fields := Fields{
+		"returnIdentification": returnIdentification,
+		"transactionId":        transactionId,
+		"clientCode":           clientCode,
 	}
+	logger.WithFields(fields).Info("Consultando status do Pix Cash-In")
 
-	if errResponse.Error != nil {
-		err := FindPixErrorWithMessage(*errResponse.Error.ErrorCode, &resp.StatusCode, errResponse.Error.Message)
-		logrus.WithField("celcoin_error", errResponse.Error).
-			WithFields(fields).WithError(err).
-			Error("celcoin get pix error")
-		return nil, err
+	if returnIdentification == "" || transactionId == "" || clientCode == "" {
+		logger.WithFields(fields).Error("é necessário informar pelo menos um dos campos: returnIdentification, transactionId, ou clientCode")
+		return nil, fmt.Errorf("é necessário informar pelo menos um dos campos: returnIdentification, transactionId, ou clientCode")
 	}
 
-	return nil, ErrDefaultPix
+	return execute[PixCashinStatusTransactionResponse](ctx, s.httpClient, s.session, apiRequest{
+		method: http.MethodGet,
+		path:   PixCashInStatusPath,
+		query: map[string]string{
+			"returnIdentification": returnIdentification,
+			"transactionId":        transactionId,
+			"clientCode":           clientCode,
+		},
+		header:   jsonHeader,
+		notFound: ErrEntryNotFound,
+		errors:   pixErrors,
+		fields:   fields,
+	})
 }
 
-// DecodeEmvQRCode... Decofificando o qrcode do pix copia e cola
-func (s *Pix) DecodeEmvQRCode(ctx context.Context, emv string) (*QRCodeResponse, error) {
-	fields := logrus.Fields{"emv": emv}
-	logrus.WithFields(fields).Info("Decoding QR Code")
+// PixCashInStatic realiza um Pix Cash-in por Cobrança Estática.
+func (s *Pix) PixCashInStatic(ctx context.Context, req PixCashInStaticRequest) (_ *PixCashInStaticResponse, err error) {
+	ctx, op := s.session.startOperation(ctx, "pix", "PixCashInStatic", PixStaticPath)
+	defer op.end(&err)
+	logger := op.logger
+	fields := Fields{"request": req}
+	logger.WithFields(fields).Info("Create PixCashInStatic")
 
-	endpoint, err := s.BuildEndpoint(PixEmvPath, nil)
+	err = grok.Validator.Struct(req)
 	if err != nil {
-		return nil, err
+		logger.WithFields(fields).WithError(err).Error("Error validating model")
+		return nil, grok.FromValidationErros(err)
 	}
 
-	logrus.WithField("endpoint", *endpoint).Info("Endpoint built successfully")
+	return execute[PixCashInStaticResponse](ctx, s.httpClient, s.session, apiRequest{
+		method:   http.MethodPost,
+		path:     PixStaticPath,
+		body:     req,
+		header:   jsonHeader,
+		notFound: ErrEntryNotFound,
+		errors:   pixErrors,
+		fields:   fields,
+	})
+}
 
-	payload := map[string]string{
-		"emv": emv,
-	}
-	data, err := json.Marshal(payload)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error serializing request")
-		return nil, fmt.Errorf("error serializing request: %v", err)
-	}
+// PixCashInDueDate realiza um Pix Cash-in por Cobrança com Vencimento.
+func (s *Pix) CreatePixCashInDueDate(ctx context.Context, req PixCashInDueDateRequest) (_ *PixCashInDueDateResponse, err error) {
+	ctx, op := s.session.startOperation(ctx, "pix", "CreatePixCashInDueDate", PixCashInDynamicPath)
+	defer op.end(&err)
+	logger := op.logger
+	fields := Fields{"request": req}
+	logger.WithFields(fields).Info("Create PixCashInDueDate")
 
-	httpReq, err := http.NewRequestWithContext(ctx, "POST", *endpoint, bytes.NewReader(data))
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error creating HTTP request")
-		return nil, fmt.Errorf("error creating HTTP request: %v", err)
-	}
-	httpReq.Header.Set("Content-Type", "application/json")
-	resp, err := s.httpClient.Do(httpReq)
+	err = grok.Validator.Struct(req)
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error in HTTP client")
-		return nil, err
+		logger.WithFields(fields).WithError(err).Error("Error validating model")
+		return nil, grok.FromValidationErros(err)
 	}
-	defer resp.Body.Close()
 
-	respBody, _ := ioutil.ReadAll(resp.Body)
-
-	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusAccepted || resp.StatusCode == http.StatusCreated {
-		var response *QRCodeResponse
+	return execute[PixCashInDueDateResponse](ctx, s.httpClient, s.session, apiRequest{
+		method:     http.MethodPost,
+		path:       PixCashInDynamicPath,
+		pathParams: []string{"duedate"},
+		body:       req,
+		header:     jsonHeader,
+		notFound:   ErrEntryNotFound,
+		errors:     pixErrors,
+		fields:     fields,
+	})
+}
 
-		if err := json.Unmarshal(respBody, &response); err != nil {
-			logrus.WithFields(fields).WithError(err).
-				Error("error decoding json response")
-			return nil, ErrDefaultPix
-		}
+// GetPixCashInDueDate realiza um Pix Cash-in por Cobrança com Vencimento.
+func (s *Pix) GetPixCashInDueDate(ctx context.Context, transactionId *string) (_ *PixCashInDueDateResponse, err error) {
+	ctx, op := s.session.startOperation(ctx, "pix", "GetPixCashInDueDate", PixCashInDynamicPath)
+	defer op.end(&err)
+	logger := op.logger
+	fields := Fields{"transactionId": transactionId}
+	logger.WithFields(fields).Info("Create GetPixCashInDueDate")
 
-		return response, nil
+	if transactionId == nil {
+		logger.WithFields(fields).Error("Error transactionId is required in request")
+		return nil, fmt.Errorf("Error transactionId is required in request")
 	}
 
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, ErrEntryNotFound
-	}
+	return execute[PixCashInDueDateResponse](ctx, s.httpClient, s.session, apiRequest{
+		method:     http.MethodGet,
+		path:       PixCashInDynamicPath,
+		pathParams: []string{"duedate", *transactionId},
+		header:     jsonHeader,
+		notFound:   ErrEntryNotFound,
+		errors:     pixErrors,
+		fields:     fields,
+	})
+}
+
+// PutPixCashInDueDate ...
+func (s *Pix) PutPixCashInDueDate(ctx context.Context, transactionId string, req PixCashInDueDateRequest) (_ *PixCashInDueDateResponse, err error) {
+	ctx, op := s.session.startOperation(ctx, "pix", "PutPixCashInDueDate", PixCashInDynamicPath)
+	defer op.end(&err)
+	logger := op.logger
+	fields := Fields{"transactionId": transactionId, "request": req}
+	logger.WithFields(fields).Info("Create PutPixCashInDueDate")
 
-	var errResponse *ErrorDefaultResponse
-	if err := json.Unmarshal(respBody, &errResponse); err != nil {
-		logrus.WithFields(fields).WithError(err).
-			Error("error decoding json response")
-		return nil, ErrDefaultPix
+	if transactionId == "" {
+		logger.WithFields(fields).Error("Error: transactionId is required in request")
+		return nil, fmt.Errorf("transactionId is required in request")
 	}
 
-	if errResponse.Error != nil {
-		err := FindPixError(*errResponse.Error.ErrorCode, &resp.StatusCode)
-		logrus.WithField("celcoin_error", errResponse.Error).
-			WithFields(fields).WithError(err).
-			Error("celcoin get pix error")
-		return nil, err
+	err = grok.Validator.Struct(req)
+	if err != nil {
+		logger.WithFields(fields).WithError(err).Error("Error validating model")
+		return nil, grok.FromValidationErros(err)
 	}
 
-	return nil, ErrDefaultPix
+	return execute[PixCashInDueDateResponse](ctx, s.httpClient, s.session, apiRequest{
+		method:     http.MethodPut,
+		path:       PixCashInDynamicPath,
+		pathParams: []string{"duedate", transactionId},
+		body:       req,
+		header:     jsonHeader,
+		notFound:   ErrEntryNotFound,
+		errors:     pixErrors,
+		fields:     fields,
+	})
 }
 
-// GetPixCashoutStatus consulta o status de uma transferência Pix-Out.
-func (s *Pix) GetPixCashoutStatus(ctx context.Context, id, endtoendId, clientCode string) (*PixCashoutStatusTransactionResponse, error) {
-	fields := logrus.Fields{"id": id, "endtoendId": endtoendId, "clientCode": clientCode}
-	logrus.WithFields(fields).Info("Consultando status do Pix Cashout")
+// DeletePixCashInDueDate remove um Pix Cash-in por Cobrança com Vencimento.
+func (s *Pix) DeletePixCashInDueDate(ctx context.Context, transactionId *string) (_ *PixDeleteResponse, err error) {
+	ctx, op := s.session.startOperation(ctx, "pix", "DeletePixCashInDueDate", PixCashInDynamicPath)
+	defer op.end(&err)
+	logger := op.logger
+	fields := Fields{"transactionId": transactionId}
+	logger.WithFields(fields).Info("DeletePixCashInDueDate called")
 
-	if id == "" && endtoendId == "" && clientCode == "" {
-		logrus.WithFields(fields).Error("é necessário informar pelo menos um dos campos: id, endtoendId, ou clientCode")
-		return nil, fmt.Errorf("é necessário informar pelo menos um dos campos: id, endtoendId, ou clientCode")
+	if transactionId == nil {
+		logger.WithFields(fields).Error("Error: transactionId is required")
+		return nil, fmt.Errorf("Error: transactionId is required")
 	}
 
-	params := map[string]string{
-		"id":         id,
-		"endtoendId": endtoendId,
-		"clientCode": clientCode,
-	}
+	return execute[PixDeleteResponse](ctx, s.httpClient, s.session, apiRequest{
+		method:     http.MethodDelete,
+		path:       PixCashInDynamicPath,
+		pathParams: []string{"duedate", *transactionId},
+		header:     jsonHeader,
+		success:    []int{http.StatusOK, http.StatusAccepted, http.StatusNoContent},
+		notFound:   ErrEntryNotFound,
+		errors:     pixErrors,
+		fields:     fields,
+	})
+}
+
+// PixCashInImmediate realiza um Pix Cash-in por Cobrança Imediata.
+func (s *Pix) CreatePixCashInImmediate(ctx context.Context, req PixCashInImmediateRequest) (_ *PixCashInImmediateResponse, err error) {
+	ctx, op := s.session.startOperation(ctx, "pix", "CreatePixCashInImmediate", PixCashInDynamicPath)
+	defer op.end(&err)
+	logger := op.logger
+	fields := Fields{"request": req}
+	logger.WithFields(fields).Info("Create PixCashInImmediate")
 
-	endpoint, err := s.BuildEndpoint(PixPaymentV2Path, params, "status")
+	err = grok.Validator.Struct(req)
 	if err != nil {
-		return nil, err
+		logger.WithFields(fields).WithError(err).Error("Error validating model")
+		return nil, grok.FromValidationErros(err)
 	}
 
-	logrus.WithField("endpoint", *endpoint).Info("Endpoint built successfully")
+	return execute[PixCashInImmediateResponse](ctx, s.httpClient, s.session, apiRequest{
+		method:     http.MethodPost,
+		path:       PixCashInDynamicPath,
+		pathParams: []string{"immediate"},
+		body:       req,
+		header:     jsonHeader,
+		notFound:   ErrEntryNotFound,
+		errors:     pixErrors,
+		fields:     fields,
+	})
+}
 
-	httpReq, err := http.NewRequestWithContext(ctx, "GET", *endpoint, nil)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Erro criando a requisição HTTP")
-		return nil, fmt.Errorf("erro criando requisição HTTP: %v", err)
-	}
-	httpReq.Header.Set("Content-Type", "application/json")
-	resp, err := s.httpClient.Do(httpReq)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Erro na requisição HTTP")
-		return nil, err
-	}
-	defer resp.Body.Close()
+// GetPixCashInImmediate realiza um Pix Cash-in por Cobrança imediata.
+func (s *Pix) GetPixCashInImmediate(ctx context.Context, transactionId *string) (_ *PixCashInImmediateResponse, err error) {
+	ctx, op := s.session.startOperation(ctx, "pix", "GetPixCashInImmediate", PixCashInDynamicPath)
+	defer op.end(&err)
+	logger := op.logger
+	fields := Fields{"transactionId": transactionId}
+	logger.WithFields(fields).Info("Create GetPixCashInImmediate")
 
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Erro ao ler o corpo da resposta")
-		return nil, err
+	if transactionId == nil {
+		logger.WithFields(fields).Error("Error transactionId is required in request")
+		return nil, fmt.Errorf("Error transactionId is required in request")
 	}
 
-	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusAccepted || resp.StatusCode == http.StatusCreated {
-		var response *PixCashoutStatusTransactionResponse
-
-		if err := json.Unmarshal(respBody, &response); err != nil {
-			logrus.WithFields(fields).WithError(err).
-				Error("error decoding json response")
-			return nil, ErrDefaultPix
-		}
-
-		return response, nil
-	}
-
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, ErrEntryNotFound
-	}
-
-	var errResponse *ErrorDefaultResponse
-	if err := json.Unmarshal(respBody, &errResponse); err != nil {
-		logrus.WithFields(fields).WithError(err).
-			Error("error decoding json response")
-		return nil, ErrDefaultPix
-	}
-
-	if errResponse.Error != nil {
-		err := FindPixError(*errResponse.Error.ErrorCode, &resp.StatusCode)
-		logrus.WithField("celcoin_error", errResponse.Error).
-			WithFields(fields).WithError(err).
-			Error("celcoin get pix error")
-		return nil, err
-	}
-
-	return nil, ErrDefaultPix
-}
-
-// GetPixCashinStatus consulta o status de uma devolução Pix (Pix Cash-In).
-func (s *Pix) GetPixCashinStatus(ctx context.Context, returnIdentification, transactionId, clientCode string) (*PixCashinStatusTransactionResponse, error) {
-	fields := logrus.Fields{
-		"returnIdentification": returnIdentification,
-		"transactionId":        transactionId,
-		"clientCode":           clientCode,
-	}
-	logrus.WithFields(fields).Info("Consultando status do Pix Cash-In")
-
-	if returnIdentification == "" || transactionId == "" || clientCode == "" {
-		logrus.WithFields(fields).Error("é necessário informar pelo menos um dos campos: returnIdentification, transactionId, ou clientCode")
-		return nil, fmt.Errorf("é necessário informar pelo menos um dos campos: returnIdentification, transactionId, ou clientCode")
-	}
-
-	params := map[string]string{
-		"returnIdentification": returnIdentification,
-		"transactionId":        transactionId,
-		"clientCode":           clientCode,
-	}
-
-	endpoint, err := s.BuildEndpoint(PixCashInStatusPath, params)
-	if err != nil {
-		return nil, err
-	}
-
-	logrus.WithField("endpoint", *endpoint).Info("Endpoint built successfully")
-
-	httpReq, err := http.NewRequestWithContext(ctx, "GET", *endpoint, nil)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Erro criando a requisição HTTP")
-		return nil, fmt.Errorf("erro criando requisição HTTP: %v", err)
-	}
-	httpReq.Header.Set("Content-Type", "application/json")
-	resp, err := s.httpClient.Do(httpReq)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Erro na requisição HTTP")
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Erro ao ler o corpo da resposta")
-		return nil, err
-	}
-
-	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusAccepted || resp.StatusCode == http.StatusCreated {
-		var response *PixCashinStatusTransactionResponse
-
-		if err := json.Unmarshal(respBody, &response); err != nil {
-			logrus.WithFields(fields).WithError(err).
-				Error("erro ao decodificar a resposta JSON")
-			return nil, ErrDefaultPix
-		}
-
-		return response, nil
-	}
-
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, ErrEntryNotFound
-	}
-
-	var errResponse *ErrorDefaultResponse
-	if err := json.Unmarshal(respBody, &errResponse); err != nil {
-		logrus.WithFields(fields).WithError(err).
-			Error("erro ao decodificar a resposta JSON")
-		return nil, ErrDefaultPix
-	}
-
-	if errResponse.Error != nil {
-		err := FindPixError(*errResponse.Error.ErrorCode, &resp.StatusCode)
-		logrus.WithField("celcoin_error", errResponse.Error).
-			WithFields(fields).WithError(err).
-			Error("erro no serviço Pix")
-		return nil, err
-	}
-
-	return nil, ErrDefaultPix
-}
-
-// PixCashInStatic realiza um Pix Cash-in por Cobrança Estática.
-func (s *Pix) PixCashInStatic(ctx context.Context, req PixCashInStaticRequest) (*PixCashInStaticResponse, error) {
-	fields := logrus.Fields{"request": req}
-	logrus.WithFields(fields).Info("Create PixCashInStatic")
-
-	err := grok.Validator.Struct(req)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error validating model")
-		return nil, grok.FromValidationErros(err)
-	}
-
-	endpoint, err := s.BuildEndpoint(PixStaticPath, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	logrus.WithField("endpoint", *endpoint).Info("Endpoint built successfully")
-
-	payload, err := json.Marshal(req)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error serializing request")
-		return nil, fmt.Errorf("error serializing request: %v", err)
-	}
-
-	httpReq, err := http.NewRequestWithContext(ctx, "POST", *endpoint, bytes.NewReader(payload))
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error creating HTTP request")
-		return nil, fmt.Errorf("error creating HTTP request: %v", err)
-	}
-	httpReq.Header.Set("Content-Type", "application/json")
-	resp, err := s.httpClient.Do(httpReq)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error in HTTP client")
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Erro ao ler o corpo da resposta")
-		return nil, err
-	}
-
-	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusAccepted || resp.StatusCode == http.StatusCreated {
-		var response *PixCashInStaticResponse
-
-		if err := json.Unmarshal(respBody, &response); err != nil {
-			logrus.WithFields(fields).WithError(err).
-				Error("erro ao decodificar a resposta JSON")
-			return nil, ErrDefaultPix
-		}
-
-		return response, nil
-	}
-
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, ErrEntryNotFound
-	}
-
-	var errResponse *ErrorDefaultResponse
-	if err := json.Unmarshal(respBody, &errResponse); err != nil {
-		logrus.WithFields(fields).WithError(err).
-			Error("erro ao decodificar a resposta JSON")
-		return nil, ErrDefaultPix
-	}
-
-	if errResponse.Error != nil {
-		err := FindPixError(*errResponse.Error.ErrorCode, &resp.StatusCode)
-		logrus.WithField("celcoin_error", errResponse.Error).
-			WithFields(fields).WithError(err).
-			Error("erro no serviço Pix")
-		return nil, err
-	}
-
-	return nil, ErrDefaultPix
-}
-
-// PixCashInDueDate realiza um Pix Cash-in por Cobrança com Vencimento.
-func (s *Pix) CreatePixCashInDueDate(ctx context.Context, req PixCashInDueDateRequest) (*PixCashInDueDateResponse, error) {
-	fields := logrus.Fields{"request": req}
-	logrus.WithFields(fields).Info("Create PixCashInDueDate")
-
-	err := grok.Validator.Struct(req)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error validating model")
-		return nil, grok.FromValidationErros(err)
-	}
-
-	endpoint, err := s.BuildEndpoint(PixCashInDynamicPath, nil, "duedate")
-	if err != nil {
-		return nil, err
-	}
-
-	logrus.WithField("endpoint", *endpoint).Info("Endpoint built successfully")
-
-	payload, err := json.Marshal(req)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error serializing request")
-		return nil, fmt.Errorf("error serializing request: %v", err)
-	}
-
-	httpReq, err := http.NewRequestWithContext(ctx, "POST", *endpoint, bytes.NewReader(payload))
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error creating HTTP request")
-		return nil, fmt.Errorf("error creating HTTP request: %v", err)
-	}
-
-	httpReq.Header.Set("Content-Type", "application/json")
-	resp, err := s.httpClient.Do(httpReq)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error in HTTP client")
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Erro ao ler o corpo da resposta")
-		return nil, err
-	}
-
-	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusAccepted || resp.StatusCode == http.StatusCreated {
-		var response *PixCashInDueDateResponse
-
-		if err := json.Unmarshal(respBody, &response); err != nil {
-			logrus.WithFields(fields).WithError(err).
-				Error("erro ao decodificar a resposta JSON")
-			return nil, ErrDefaultPix
-		}
-
-		return response, nil
-	}
-
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, ErrEntryNotFound
-	}
-
-	var errResponse *ErrorDefaultResponse
-	if err := json.Unmarshal(respBody, &errResponse); err != nil {
-		logrus.WithFields(fields).WithError(err).
-			Error("erro ao decodificar a resposta JSON")
-		return nil, ErrDefaultPix
-	}
-
-	if errResponse.Error != nil {
-		err := FindPixError(*errResponse.Error.ErrorCode, &resp.StatusCode)
-		logrus.WithField("celcoin_error", errResponse.Error).
-			WithFields(fields).WithError(err).
-			Error("erro no serviço Pix")
-		return nil, err
-	}
-
-	return nil, ErrDefaultPix
-}
-
-// GetPixCashInDueDate realiza um Pix Cash-in por Cobrança com Vencimento.
-func (s *Pix) GetPixCashInDueDate(ctx context.Context, transactionId *string) (*PixCashInDueDateResponse, error) {
-	fields := logrus.Fields{"transactionId": transactionId}
-	logrus.WithFields(fields).Info("Create GetPixCashInDueDate")
-
-	if transactionId == nil {
-		logrus.WithFields(fields).Error("Error transactionId is required in request")
-		return nil, fmt.Errorf("Error transactionId is required in request")
-	}
-
-	endpoint, err := s.BuildEndpoint(PixCashInDynamicPath, nil, "duedate", *transactionId)
-	if err != nil {
-		return nil, err
-	}
-
-	logrus.WithField("endpoint", *endpoint).Info("Endpoint built successfully")
-
-	httpReq, err := http.NewRequestWithContext(ctx, "GET", *endpoint, nil)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error creating HTTP request")
-		return nil, fmt.Errorf("error creating HTTP request: %v", err)
-	}
-
-	httpReq.Header.Set("Content-Type", "application/json")
-	resp, err := s.httpClient.Do(httpReq)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error in HTTP client")
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Erro ao ler o corpo da resposta")
-		return nil, err
-	}
-
-	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusAccepted || resp.StatusCode == http.StatusCreated {
-		var response *PixCashInDueDateResponse
-
-		if err := json.Unmarshal(respBody, &response); err != nil {
-			logrus.WithFields(fields).WithError(err).
-				Error("erro ao decodificar a resposta JSON")
-			return nil, ErrDefaultPix
-		}
-
-		return response, nil
-	}
-
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, ErrEntryNotFound
-	}
-
-	var errResponse *ErrorDefaultResponse
-	if err := json.Unmarshal(respBody, &errResponse); err != nil {
-		logrus.WithFields(fields).WithError(err).
-			Error("erro ao decodificar a resposta JSON")
-		return nil, ErrDefaultPix
-	}
-
-	if errResponse.Error != nil {
-		err := FindPixError(*errResponse.Error.ErrorCode, &resp.StatusCode)
-		logrus.WithField("celcoin_error", errResponse.Error).
-			WithFields(fields).WithError(err).
-			Error("erro no serviço Pix")
-		return nil, err
-	}
-
-	return nil, ErrDefaultPix
-}
-
-// PutPixCashInDueDate ...
-func (s *Pix) PutPixCashInDueDate(ctx context.Context, transactionId string, req PixCashInDueDateRequest) (*PixCashInDueDateResponse, error) {
-	fields := logrus.Fields{"transactionId": transactionId, "request": req}
-	logrus.WithFields(fields).Info("Create PutPixCashInDueDate")
-
-	if transactionId == "" {
-		logrus.WithFields(fields).Error("Error: transactionId is required in request")
-		return nil, fmt.Errorf("transactionId is required in request")
-	}
-
-	err := grok.Validator.Struct(req)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error validating model")
-		return nil, grok.FromValidationErros(err)
-	}
-
-	endpoint, err := s.BuildEndpoint(PixCashInDynamicPath, nil, "duedate", transactionId)
-	if err != nil {
-		return nil, err
-	}
-
-	logrus.WithField("endpoint", *endpoint).Info("Endpoint built successfully")
-
-	payload, err := json.Marshal(req)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error serializing request")
-		return nil, fmt.Errorf("error serializing request: %v", err)
-	}
-
-	httpReq, err := http.NewRequestWithContext(ctx, "PUT", *endpoint, bytes.NewReader(payload))
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error creating HTTP request")
-		return nil, fmt.Errorf("error creating HTTP request: %v", err)
-	}
-
-	httpReq.Header.Set("Content-Type", "application/json")
-	resp, err := s.httpClient.Do(httpReq)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error in HTTP client")
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Erro ao ler o corpo da resposta")
-		return nil, err
-	}
-
-	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusAccepted || resp.StatusCode == http.StatusCreated {
-		var response *PixCashInDueDateResponse
-
-		if err := json.Unmarshal(respBody, &response); err != nil {
-			logrus.WithFields(fields).WithError(err).
-				Error("Erro ao decodificar a resposta JSON")
-			return nil, ErrDefaultPix
-		}
-
-		return response, nil
-	}
-
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, ErrEntryNotFound
-	}
-
-	var errResponse *ErrorDefaultResponse
-	if err := json.Unmarshal(respBody, &errResponse); err != nil {
-		logrus.WithFields(fields).WithError(err).
-			Error("Erro ao decodificar a resposta JSON")
-		return nil, ErrDefaultPix
-	}
-
-	if errResponse.Error != nil {
-		err := FindPixError(*errResponse.Error.ErrorCode, &resp.StatusCode)
-		logrus.WithField("celcoin_error", errResponse.Error).
-			WithFields(fields).WithError(err).
-			Error("Erro no serviço Pix")
-		return nil, err
-	}
-
-	return nil, ErrDefaultPix
-}
-
-// DeletePixCashInDueDate remove um Pix Cash-in por Cobrança com Vencimento.
-func (s *Pix) DeletePixCashInDueDate(ctx context.Context, transactionId *string) (*PixDeleteResponse, error) {
-	fields := logrus.Fields{"transactionId": transactionId}
-	logrus.WithFields(fields).Info("DeletePixCashInDueDate called")
-
-	if transactionId == nil {
-		logrus.WithFields(fields).Error("Error: transactionId is required")
-		return nil, fmt.Errorf("Error: transactionId is required")
-	}
-
-	endpoint, err := s.BuildEndpoint(PixCashInDynamicPath, nil, "duedate", *transactionId)
-	if err != nil {
-		return nil, err
-	}
-
-	logrus.WithField("endpoint", *endpoint).Info("Endpoint built successfully")
-
-	httpReq, err := http.NewRequestWithContext(ctx, "DELETE", *endpoint, nil)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error creating HTTP request")
-		return nil, fmt.Errorf("error creating HTTP request: %v", err)
-	}
-
-	httpReq.Header.Set("Content-Type", "application/json")
-	resp, err := s.httpClient.Do(httpReq)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error in HTTP client")
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error reading response body")
-		return nil, err
-	}
-
-	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusAccepted || resp.StatusCode == http.StatusNoContent {
-		var response *PixDeleteResponse
-		if err := json.Unmarshal(respBody, &response); err != nil {
-			logrus.WithFields(fields).WithError(err).Error("Error decoding JSON response")
-			return nil, ErrDefaultPix
-		}
-		return response, nil
-	}
-
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, ErrEntryNotFound
-	}
-
-	var errResponse *ErrorDefaultResponse
-	if err := json.Unmarshal(respBody, &errResponse); err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error decoding JSON response")
-		return nil, ErrDefaultPix
-	}
-
-	if errResponse.Error != nil {
-		err := FindPixError(*errResponse.Error.ErrorCode, &resp.StatusCode)
-		logrus.WithField("celcoin_error", errResponse.Error).WithFields(fields).WithError(err).Error("Pix service error")
-		return nil, err
-	}
-
-	return nil, ErrDefaultPix
-}
-
-// PixCashInImmediate realiza um Pix Cash-in por Cobrança Imediata.
-func (s *Pix) CreatePixCashInImmediate(ctx context.Context, req PixCashInImmediateRequest) (*PixCashInImmediateResponse, error) {
-	fields := logrus.Fields{"request": req}
-	logrus.WithFields(fields).Info("Create PixCashInImmediate")
-
-	err := grok.Validator.Struct(req)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error validating model")
-		return nil, grok.FromValidationErros(err)
-	}
-
-	endpoint, err := s.BuildEndpoint(PixCashInDynamicPath, nil, "immediate")
-	if err != nil {
-		return nil, err
-	}
-
-	logrus.WithField("endpoint", *endpoint).Info("Endpoint built successfully")
-
-	payload, err := json.Marshal(req)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error serializing request")
-		return nil, fmt.Errorf("error serializing request: %v", err)
-	}
-
-	httpReq, err := http.NewRequestWithContext(ctx, "POST", *endpoint, bytes.NewReader(payload))
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error creating HTTP request")
-		return nil, fmt.Errorf("error creating HTTP request: %v", err)
-	}
-
-	httpReq.Header.Set("Content-Type", "application/json")
-	resp, err := s.httpClient.Do(httpReq)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error in HTTP client")
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Erro ao ler o corpo da resposta")
-		return nil, err
-	}
-
-	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusAccepted || resp.StatusCode == http.StatusCreated {
-		var response *PixCashInImmediateResponse
-
-		if err := json.Unmarshal(respBody, &response); err != nil {
-			logrus.WithFields(fields).WithError(err).
-				Error("erro ao decodificar a resposta JSON")
-			return nil, ErrDefaultPix
-		}
-
-		return response, nil
-	}
-
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, ErrEntryNotFound
-	}
-
-	var errResponse *ErrorDefaultResponse
-	if err := json.Unmarshal(respBody, &errResponse); err != nil {
-		logrus.WithFields(fields).WithError(err).
-			Error("erro ao decodificar a resposta JSON")
-		return nil, ErrDefaultPix
-	}
-
-	if errResponse.Error != nil {
-		err := FindPixError(*errResponse.Error.ErrorCode, &resp.StatusCode)
-		logrus.WithField("celcoin_error", errResponse.Error).
-			WithFields(fields).WithError(err).
-			Error("erro no serviço Pix")
-		return nil, err
-	}
-
-	return nil, ErrDefaultPix
-}
-
-// GetPixCashInImmediate realiza um Pix Cash-in por Cobrança imediata.
-func (s *Pix) GetPixCashInImmediate(ctx context.Context, transactionId *string) (*PixCashInImmediateResponse, error) {
-	fields := logrus.Fields{"transactionId": transactionId}
-	logrus.WithFields(fields).Info("Create GetPixCashInImmediate")
-
-	if transactionId == nil {
-		logrus.WithFields(fields).Error("Error transactionId is required in request")
-		return nil, fmt.Errorf("Error transactionId is required in request")
-	}
-
-	endpoint, err := s.BuildEndpoint(PixCashInDynamicPath, nil, "immediate", *transactionId)
-	if err != nil {
-		return nil, err
-	}
-
-	logrus.WithField("endpoint", *endpoint).Info("Endpoint built successfully")
-
-	httpReq, err := http.NewRequestWithContext(ctx, "GET", *endpoint, nil)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error creating HTTP request")
-		return nil, fmt.Errorf("error creating HTTP request: %v", err)
-	}
-
-	httpReq.Header.Set("Content-Type", "application/json")
-	resp, err := s.httpClient.Do(httpReq)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error in HTTP client")
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Erro ao ler o corpo da resposta")
-		return nil, err
-	}
-
-	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusAccepted || resp.StatusCode == http.StatusCreated {
-		var response *PixCashInImmediateResponse
-
-		if err := json.Unmarshal(respBody, &response); err != nil {
-			logrus.WithFields(fields).WithError(err).
-				Error("erro ao decodificar a resposta JSON")
-			return nil, ErrDefaultPix
-		}
-
-		return response, nil
-	}
-
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, ErrEntryNotFound
-	}
-
-	var errResponse *ErrorDefaultResponse
-	if err := json.Unmarshal(respBody, &errResponse); err != nil {
-		logrus.WithFields(fields).WithError(err).
-			Error("erro ao decodificar a resposta JSON")
-		return nil, ErrDefaultPix
-	}
-
-	if errResponse.Error != nil {
-		err := FindPixError(*errResponse.Error.ErrorCode, &resp.StatusCode)
-		logrus.WithField("celcoin_error", errResponse.Error).
-			WithFields(fields).WithError(err).
-			Error("erro no serviço Pix")
-		return nil, err
-	}
-
-	return nil, ErrDefaultPix
+	return execute[PixCashInImmediateResponse](ctx, s.httpClient, s.session, apiRequest{
+		method:     http.MethodGet,
+		path:       PixCashInDynamicPath,
+		pathParams: []string{"immediate", *transactionId},
+		header:     jsonHeader,
+		notFound:   ErrEntryNotFound,
+		errors:     pixErrors,
+		fields:     fields,
+	})
 }
 
 // PutPixCashInImmediate ...
-func (s *Pix) PutPixCashInImmediate(ctx context.Context, transactionId string, req PixCashInImmediateRequest) (*PixCashInImmediateResponse, error) {
-	fields := logrus.Fields{"transactionId": transactionId, "request": req}
-	logrus.WithFields(fields).Info("Create PutPixCashInImmediate")
-
-	if transactionId == "" {
-		logrus.WithFields(fields).Error("Error: transactionId is required in request")
-		return nil, fmt.Errorf("transactionId is required in request")
-	}
-
-	err := grok.Validator.Struct(req)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error validating model")
-		return nil, grok.FromValidationErros(err)
-	}
-
-	endpoint, err := s.BuildEndpoint(PixCashInDynamicPath, nil, "immediate", transactionId)
-	if err != nil {
-		return nil, err
-	}
-
-	logrus.WithField("endpoint", *endpoint).Info("Endpoint built successfully")
-
-	payload, err := json.Marshal(req)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error serializing request")
-		return nil, fmt.Errorf("error serializing request: %v", err)
-	}
-
-	httpReq, err := http.NewRequestWithContext(ctx, "PUT", *endpoint, bytes.NewReader(payload))
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error creating HTTP request")
-		return nil, fmt.Errorf("error creating HTTP request: %v", err)
-	}
-
-	httpReq.Header.Set("Content-Type", "application/json")
-	resp, err := s.httpClient.Do(httpReq)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error in HTTP client")
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Erro ao ler o corpo da resposta")
-		return nil, err
-	}
-
-	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusAccepted || resp.StatusCode == http.StatusCreated {
-		var response *PixCashInImmediateResponse
-
-		if err := json.Unmarshal(respBody, &response); err != nil {
-			logrus.WithFields(fields).WithError(err).
-				Error("Erro ao decodificar a resposta JSON")
-			return nil, ErrDefaultPix
-		}
-
-		return response, nil
-	}
-
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, ErrEntryNotFound
-	}
+func (s *Pix) PutPixCashInImmediate(ctx context.Context, transactionId string, req PixCashInImmediateRequest) (_ *PixCashInImmediateResponse, err error) {
+	ctx, op := s.session.startOperation(ctx, "pix", "PutPixCashInImmediate", PixCashInDynamicPath)
+	defer op.end(&err)
+	logger := op.logger
+	fields := Fields{"transactionId": transactionId, "request": req}
+	logger.WithFields(fields).Info("Create PutPixCashInImmediate")
 
-	var errResponse *ErrorDefaultResponse
-	if err := json.Unmarshal(respBody, &errResponse); err != nil {
-		logrus.WithFields(fields).WithError(err).
-			Error("Erro ao decodificar a resposta JSON")
-		return nil, ErrDefaultPix
+	if transactionId == "" {
+		logger.WithFields(fields).Error("Error: transactionId is required in request")
+		return nil, fmt.Errorf("transactionId is required in request")
 	}
 
-	if errResponse.Error != nil {
-		err := FindPixError(*errResponse.Error.ErrorCode, &resp.StatusCode)
-		logrus.WithField("celcoin_error", errResponse.Error).
-			WithFields(fields).WithError(err).
-			Error("Erro no serviço Pix")
-		return nil, err
+	err = grok.Validator.Struct(req)
+	if err != nil {
+		logger.WithFields(fields).WithError(err).Error("Error validating model")
+		return nil, grok.FromValidationErros(err)
 	}
 
-	return nil, ErrDefaultPix
+	return execute[PixCashInImmediateResponse](ctx, s.httpClient, s.session, apiRequest{
+		method:     http.MethodPut,
+		path:       PixCashInDynamicPath,
+		pathParams: []string{"immediate", transactionId},
+		body:       req,
+		header:     jsonHeader,
+		notFound:   ErrEntryNotFound,
+		errors:     pixErrors,
+		fields:     fields,
+	})
 }
 
 // DeletePixCashInImmediate remove um Pix Cash-in por Cobrança imediata
-func (s *Pix) DeletePixCashInImmediate(ctx context.Context, transactionId *string) (*PixDeleteResponse, error) {
-	fields := logrus.Fields{"transactionId": transactionId}
-	logrus.WithFields(fields).Info("DeletePixCashInDueDate called")
+func (s *Pix) DeletePixCashInImmediate(ctx context.Context, transactionId *string) (_ *PixDeleteResponse, err error) {
+	ctx, op := s.session.startOperation(ctx, "pix", "DeletePixCashInImmediate", PixCashInDynamicPath)
+	defer op.end(&err)
+	logger := op.logger
+	fields := Fields{"transactionId": transactionId}
+	logger.WithFields(fields).Info("DeletePixCashInDueDate called")
 
 	if transactionId == nil {
-		logrus.WithFields(fields).Error("Error: transactionId is required")
+		logger.WithFields(fields).Error("Error: transactionId is required")
 		return nil, fmt.Errorf("Error: transactionId is required")
 	}
 
-	endpoint, err := s.BuildEndpoint(PixCashInDynamicPath, nil, "immediate", *transactionId)
-	if err != nil {
-		return nil, err
-	}
-
-	logrus.WithField("endpoint", *endpoint).Info("Endpoint built successfully")
-
-	httpReq, err := http.NewRequestWithContext(ctx, "DELETE", *endpoint, nil)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error creating HTTP request")
-		return nil, fmt.Errorf("error creating HTTP request: %v", err)
-	}
-
-	httpReq.Header.Set("Content-Type", "application/json")
-	resp, err := s.httpClient.Do(httpReq)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error in HTTP client")
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error reading response body")
-		return nil, err
-	}
-
-	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusAccepted || resp.StatusCode == http.StatusNoContent {
-		var response *PixDeleteResponse
-		if err := json.Unmarshal(respBody, &response); err != nil {
-			logrus.WithFields(fields).WithError(err).Error("Error decoding JSON response")
-			return nil, ErrDefaultPix
-		}
-		return response, nil
-	}
-
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, ErrEntryNotFound
-	}
-
-	var errResponse *ErrorDefaultResponse
-	if err := json.Unmarshal(respBody, &errResponse); err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error decoding JSON response")
-		return nil, ErrDefaultPix
-	}
-
-	if errResponse.Error != nil {
-		err := FindPixError(*errResponse.Error.ErrorCode, &resp.StatusCode)
-		logrus.WithField("celcoin_error", errResponse.Error).WithFields(fields).WithError(err).Error("Pix service error")
-		return nil, err
-	}
-
-	return nil, ErrDefaultPix
+	return execute[PixDeleteResponse](ctx, s.httpClient, s.session, apiRequest{
+		method:     http.MethodDelete,
+		path:       PixCashInDynamicPath,
+		pathParams: []string{"immediate", *transactionId},
+		header:     jsonHeader,
+		success:    []int{http.StatusOK, http.StatusAccepted, http.StatusNoContent},
+		notFound:   ErrEntryNotFound,
+		errors:     pixErrors,
+		fields:     fields,
+	})
 }
 
-func (s *Pix) GetAddressKey(ctx context.Context, key, currentIdentity, account string, searchDict *bool) (*PixAddressKeyResponse, error) {
+func (s *Pix) GetAddressKey(ctx context.Context, key, currentIdentity, account string, searchDict *bool) (_ *PixAddressKeyResponse, err error) {
+	ctx, op := s.session.startOperation(ctx, "pix", "GetAddressKey", "")
+	defer op.end(&err)
 	var search_dict = false
 	if searchDict == nil {
 		searchDict = &search_dict // valor for null, não devemos buscar no DICT pois pode afetar o balde de fichas
@@ -1596,535 +689,205 @@ func (s *Pix) GetAddressKey(ctx context.Context, key, currentIdentity, account s
 }
 
 // GetEmvQRCodeImmediate decodifica o QR code e faz uma requisição ao endpoint correspondente.
-func (s *Pix) GetEmvQRCodeImmediate(ctx context.Context, merchanturl *string) (*QRCodeImmediateResponse, error) {
-	fields := logrus.Fields{"merchantAccountInformation.url": merchanturl}
-	logrus.WithFields(fields).Info("Processing GetEmvQRCodeImmediate request")
-
-	if merchanturl == nil {
-		err := fmt.Errorf("decoded QR code does not contain a valid URL")
-		logrus.WithFields(fields).WithError(err).Error("Invalid decoded QR code")
-		return nil, err
-	}
-
-	// Transformar a URL no formato esperado (remover https:// e codificar as barras)
-	originalURL := *merchanturl
-	parsedURL, err := url.Parse(originalURL)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error parsing URL")
-		return nil, fmt.Errorf("error parsing URL: %v", err)
-	}
-
-	encodedPath := url.PathEscape(strings.TrimPrefix(parsedURL.Host+parsedURL.Path, "https://"))
-	fields["encoded_url"] = encodedPath
-	logrus.WithFields(fields).Info("Encoded URL created successfully")
+func (s *Pix) GetEmvQRCodeImmediate(ctx context.Context, merchanturl *string) (_ *QRCodeImmediateResponse, err error) {
+	ctx, op := s.session.startOperation(ctx, "pix", "GetEmvQRCodeImmediate", "")
+	defer op.end(&err)
+	logger := op.logger
+	fields := Fields{"merchantAccountInformation.url": merchanturl}
+	logger.WithFields(fields).Info("Processing GetEmvQRCodeImmediate request")
 
-	// Construir o endpoint para a requisição
-	endpoint, err := s.BuildEndpoint(PixEmvUrl, nil, "immediate", "payload", encodedPath)
+	encodedPath, err := s.encodeMerchantURL(logger, fields, merchanturl)
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error building endpoint")
 		return nil, err
 	}
 
-	// Realizar a requisição GET no endpoint
-	httpReq, err := http.NewRequestWithContext(ctx, "GET", *endpoint, nil)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error creating HTTP request")
-		return nil, fmt.Errorf("error creating HTTP request: %v", err)
-	}
+	return execute[QRCodeImmediateResponse](ctx, s.httpClient, s.session, apiRequest{
+		method:     http.MethodGet,
+		path:       PixEmvUrl,
+		pathParams: []string{"immediate", "payload", encodedPath},
+		header:     jsonHeader,
+		notFound:   ErrEntryNotFound,
+		errors:     pixErrors,
+		fields:     fields,
+	})
+}
 
-	httpReq.Header.Set("Content-Type", "application/json")
-	resp, err := s.httpClient.Do(httpReq)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error in HTTP client")
-		return nil, err
-	}
-	defer resp.Body.Close()
+// GetEmvQRCodeDueDate decodifica o QR code e faz uma requisição ao endpoint correspondente para dueDate.
+func (s *Pix) GetEmvQRCodeDueDate(ctx context.Context, merchanturl *string) (_ *QRCodeDueDateResponse, err error) {
+	ctx, op := s.session.startOperation(ctx, "pix", "GetEmvQRCodeDueDate", "")
+	defer op.end(&err)
+	logger := op.logger
+	fields := Fields{"merchantAccountInformation.url": merchanturl}
+	logger.WithFields(fields).Info("Processing GetEmvQRCodeDueDate request")
 
-	respBody, err := ioutil.ReadAll(resp.Body)
+	encodedPath, err := s.encodeMerchantURL(logger, fields, merchanturl)
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Erro ao ler o corpo da resposta")
-		return nil, err
-	}
-
-	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusAccepted || resp.StatusCode == http.StatusCreated {
-		var response *QRCodeImmediateResponse
-
-		if err := json.Unmarshal(respBody, &response); err != nil {
-			logrus.WithFields(fields).WithError(err).
-				Error("erro ao decodificar a resposta JSON")
-			return nil, ErrDefaultPix
-		}
-
-		return response, nil
-	}
-
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, ErrEntryNotFound
-	}
-
-	var errResponse *ErrorDefaultResponse
-	if err := json.Unmarshal(respBody, &errResponse); err != nil {
-		logrus.WithFields(fields).WithError(err).
-			Error("erro ao decodificar a resposta JSON")
-		return nil, ErrDefaultPix
-	}
-
-	if errResponse.Error != nil {
-		err := FindPixError(*errResponse.Error.ErrorCode, &resp.StatusCode)
-		logrus.WithField("celcoin_error", errResponse.Error).
-			WithFields(fields).WithError(err).
-			Error("erro no serviço Pix")
 		return nil, err
 	}
 
-	return nil, ErrDefaultPix
+	return execute[QRCodeDueDateResponse](ctx, s.httpClient, s.session, apiRequest{
+		method:     http.MethodGet,
+		path:       PixEmvUrl,
+		pathParams: []string{"duedate", "payload", encodedPath},
+		header:     jsonHeader,
+		notFound:   ErrEntryNotFound,
+		errors:     pixErrors,
+		fields:     fields,
+	})
 }
 
-// GetEmvQRCodeDueDate decodifica o QR code e faz uma requisição ao endpoint correspondente para dueDate.
-func (s *Pix) GetEmvQRCodeDueDate(ctx context.Context, merchanturl *string) (*QRCodeDueDateResponse, error) {
-	fields := logrus.Fields{"merchantAccountInformation.url": merchanturl}
-	logrus.WithFields(fields).Info("Processing GetEmvQRCodeDueDate request")
-
+// encodeMerchantURL ... transforma a URL no formato esperado (remove https:// e codifica as barras)
+func (s *Pix) encodeMerchantURL(logger Logger, fields Fields, merchanturl *string) (string, error) {
 	if merchanturl == nil {
 		err := fmt.Errorf("decoded QR code does not contain a valid URL")
-		logrus.WithFields(fields).WithError(err).Error("Invalid decoded QR code")
-		return nil, err
+		logger.WithFields(fields).WithError(err).Error("Invalid decoded QR code")
+		return "", err
 	}
 
-	// Transformar a URL no formato esperado (remover https:// e codificar as barras)
-	originalURL := *merchanturl
-	parsedURL, err := url.Parse(originalURL)
+	parsedURL, err := url.Parse(*merchanturl)
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error parsing URL")
-		return nil, fmt.Errorf("error parsing URL: %v", err)
+		logger.WithFields(fields).WithError(err).Error("Error parsing URL")
+		return "", fmt.Errorf("error parsing URL: %v", err)
 	}
 
 	encodedPath := url.PathEscape(strings.TrimPrefix(parsedURL.Host+parsedURL.Path, "https://"))
 	fields["encoded_url"] = encodedPath
-	logrus.WithFields(fields).Info("Encoded URL created successfully")
-
-	// Construir o endpoint para a requisição
-	endpoint, err := s.BuildEndpoint(PixEmvUrl, nil, "duedate", "payload", encodedPath)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error building endpoint")
-		return nil, err
-	}
-
-	// Realizar a requisição GET no endpoint
-	httpReq, err := http.NewRequestWithContext(ctx, "GET", *endpoint, nil)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error creating HTTP request")
-		return nil, fmt.Errorf("error creating HTTP request: %v", err)
-	}
-
-	httpReq.Header.Set("Content-Type", "application/json")
-	resp, err := s.httpClient.Do(httpReq)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error in HTTP client")
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Erro ao ler o corpo da resposta")
-		return nil, err
-	}
-
-	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusAccepted || resp.StatusCode == http.StatusCreated {
-		var response *QRCodeDueDateResponse
-
-		if err := json.Unmarshal(respBody, &response); err != nil {
-			logrus.WithFields(fields).WithError(err).
-				Error("erro ao decodificar a resposta JSON")
-			return nil, ErrDefaultPix
-		}
-
-		return response, nil
-	}
-
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, ErrEntryNotFound
-	}
-
-	var errResponse *ErrorDefaultResponse
-	if err := json.Unmarshal(respBody, &errResponse); err != nil {
-		logrus.WithFields(fields).WithError(err).
-			Error("erro ao decodificar a resposta JSON")
-		return nil, ErrDefaultPix
-	}
-
-	if errResponse.Error != nil {
-		err := FindPixError(*errResponse.Error.ErrorCode, &resp.StatusCode)
-		logrus.WithField("celcoin_error", errResponse.Error).
-			WithFields(fields).WithError(err).
-			Error("erro no serviço Pix")
-		return nil, err
-	}
-
-	return nil, ErrDefaultPix
+	logger.WithFields(fields).Info("Encoded URL created successfully")
+	return encodedPath, nil
 }
 
-func (s *Pix) CreateQrCodeLocation(ctx context.Context, req PixQrCodeLocationRequest) (*PixQrCodeLocationResponse, error) {
-	fields := logrus.Fields{"request": req}
-	logrus.
+func (s *Pix) CreateQrCodeLocation(ctx context.Context, req PixQrCodeLocationRequest) (_ *PixQrCodeLocationResponse, err error) {
+	ctx, op := s.session.startOperation(ctx, "pix", "CreateQrCodeLocation", PixQrCodeLocationPath)
+	defer op.end(&err)
+	logger := op.logger
+	fields := Fields{"request": req}
+	logger.
 		WithFields(fields).
 		Info("Get QRCode Location")
 
-	err := grok.Validator.Struct(req)
+	err = grok.Validator.Struct(req)
 	if err != nil {
-		logrus.
+		logger.
 			WithFields(fields).
 			WithError(err).
 			Error("Error validating model")
 		return nil, grok.FromValidationErros(err)
 	}
 
-	endpoint, err := s.BuildEndpoint(PixQrCodeLocationPath, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	logrus.WithField("endpoint", *endpoint).Info("Endpoint built successfully")
-
-	payload, err := json.Marshal(req)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error serializing request")
-		return nil, fmt.Errorf("error serializing request: %v", err)
-	}
-
-	httpReq, err := http.NewRequestWithContext(ctx, "POST", *endpoint, bytes.NewReader(payload))
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error creating HTTP request")
-		return nil, fmt.Errorf("error creating HTTP request: %v", err)
-	}
-
-	httpReq.Header.Set("Content-Type", "application/json")
-	resp, err := s.httpClient.Do(httpReq)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error in HTTP client")
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error reading response body")
-		return nil, err
-	}
-
-	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusCreated {
-		var response *PixQrCodeLocationResponse
-		if err := json.Unmarshal(respBody, &response); err != nil {
-			logrus.WithFields(fields).WithError(err).Error("Error decoding JSON response")
-			return nil, ErrDefaultPix
-		}
-		return response, nil
-	}
-
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, ErrEntryNotFound
-	}
-
-	var errResponse *ErrorDefaultResponse
-	if err := json.Unmarshal(respBody, &errResponse); err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error decoding JSON error response")
-		return nil, ErrDefaultPix
-	}
-
-	if errResponse.Error != nil {
-		err := FindPixError(*errResponse.Error.ErrorCode, &resp.StatusCode)
-		logrus.WithField("celcoin_error", errResponse.Error).WithFields(fields).WithError(err).Error("Pix service error")
-		return nil, err
-	}
-
-	return nil, ErrDefaultPix
+	return execute[PixQrCodeLocationResponse](ctx, s.httpClient, s.session, apiRequest{
+		method:   http.MethodPost,
+		path:     PixQrCodeLocationPath,
+		body:     req,
+		header:   jsonHeader,
+		success:  []int{http.StatusOK, http.StatusCreated},
+		notFound: ErrEntryNotFound,
+		errors:   pixErrors,
+		fields:   fields,
+	})
 }
 
 // CreatePixClaim cadastra um pedido de portabilidade de chave Pix.
-func (s *Pix) CreatePixClaim(ctx context.Context, req PixClaimRequest) (*PixClaimResponse, error) {
-	fields := logrus.Fields{"request": req}
-	logrus.WithFields(fields).Info("Create Pix Claim")
+func (s *Pix) CreatePixClaim(ctx context.Context, req PixClaimRequest) (_ *PixClaimResponse, err error) {
+	ctx, op := s.session.startOperation(ctx, "pix", "CreatePixClaim", PixClaimPath)
+	defer op.end(&err)
+	logger := op.logger
+	fields := Fields{"request": req}
+	logger.WithFields(fields).Info("Create Pix Claim")
 
-	err := grok.Validator.Struct(req)
+	err = grok.Validator.Struct(req)
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error validating model")
+		logger.WithFields(fields).WithError(err).Error("Error validating model")
 		return nil, grok.FromValidationErros(err)
 	}
 
-	endpoint, err := s.BuildEndpoint(PixClaimPath, nil)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error building endpoint for CreatePixClaim")
-		return nil, err
-	}
-
-	logrus.WithField("endpoint", *endpoint).Info("Calling CreatePixClaim")
-
-	payload, err := json.Marshal(req)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error serializing request")
-		return nil, fmt.Errorf("error serializing request: %v", err)
-	}
-
-	httpReq, err := http.NewRequestWithContext(ctx, "POST", *endpoint, bytes.NewReader(payload))
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error creating HTTP request")
-		return nil, fmt.Errorf("error creating HTTP request: %v", err)
-	}
-
-	httpReq.Header.Set("Content-Type", "application/json")
-	httpReq.Header.Set("Accept", "application/json")
-	resp, err := s.httpClient.Do(httpReq)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error in HTTP client")
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	respBody, _ := ioutil.ReadAll(resp.Body)
-
-	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusAccepted || resp.StatusCode == http.StatusCreated {
-		var response PixClaimResponse
-		if err := json.Unmarshal(respBody, &response); err != nil {
-			logrus.WithFields(fields).WithError(err).Error("Error decoding json response")
-			return nil, ErrDefaultPix
-		}
-		return &response, nil
-	}
-
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, ErrEntryNotFound
-	}
-
-	var errResponse ErrorDefaultResponse
-	if err := json.Unmarshal(respBody, &errResponse); err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error decoding json response")
-		return nil, ErrDefaultPix
-	}
-
-	if errResponse.Error != nil {
-		err := FindPixError(*errResponse.Error.ErrorCode, &resp.StatusCode)
-		logrus.WithField("celcoin_error", errResponse.Error).WithFields(fields).WithError(err).Error("Celcoin get pix error")
-		return nil, err
-	}
-
-	return nil, ErrDefaultPix
+	return execute[PixClaimResponse](ctx, s.httpClient, s.session, apiRequest{
+		method:   http.MethodPost,
+		path:     PixClaimPath,
+		body:     req,
+		header:   jsonAcceptHeader,
+		notFound: ErrEntryNotFound,
+		errors:   pixErrors,
+		fields:   fields,
+	})
 }
 
 // ConfirmPixClaim confirma um pedido de portabilidade de chave Pix.
-func (s *Pix) ConfirmPixClaim(ctx context.Context, req PixClaimActionRequest) (*PixClaimResponse, error) {
-	fields := logrus.Fields{"request": req}
-	logrus.WithFields(fields).Info("Confirm Pix Claim")
+func (s *Pix) ConfirmPixClaim(ctx context.Context, req PixClaimActionRequest) (_ *PixClaimResponse, err error) {
+	ctx, op := s.session.startOperation(ctx, "pix", "ConfirmPixClaim", PixClaimPath)
+	defer op.end(&err)
+	logger := op.logger
+	fields := Fields{"request": req}
+	logger.WithFields(fields).Info("Confirm Pix Claim")
 
-	err := grok.Validator.Struct(req)
+	err = grok.Validator.Struct(req)
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error validating model")
+		logger.WithFields(fields).WithError(err).Error("Error validating model")
 		return nil, grok.FromValidationErros(err)
 	}
 
-	endpoint, err := s.BuildEndpoint(PixClaimPath, nil, "confirm")
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error building endpoint for ConfirmPixClaim")
-		return nil, err
-	}
-
-	logrus.WithField("endpoint", *endpoint).Info("Calling ConfirmPixClaim")
-
-	payload, err := json.Marshal(req)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error serializing request")
-		return nil, fmt.Errorf("error serializing request: %v", err)
-	}
-
-	httpReq, err := http.NewRequestWithContext(ctx, "POST", *endpoint, bytes.NewReader(payload))
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error creating HTTP request")
-		return nil, fmt.Errorf("error creating HTTP request: %v", err)
-	}
-
-	httpReq.Header.Set("Content-Type", "application/json")
-	resp, err := s.httpClient.Do(httpReq)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error in HTTP client")
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	respBody, _ := ioutil.ReadAll(resp.Body)
-
-	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusAccepted || resp.StatusCode == http.StatusCreated {
-		var response *PixClaimResponse
-
-		if err := json.Unmarshal(respBody, &response); err != nil {
-			logrus.WithFields(fields).WithError(err).
-				Error("error decoding json response")
-			return nil, ErrDefaultPix
-		}
-		return response, nil
-	}
-
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, ErrEntryNotFound
-	}
-
-	var errResponse *ErrorDefaultResponse
-	if err := json.Unmarshal(respBody, &errResponse); err != nil {
-		logrus.WithFields(fields).WithError(err).
-			Error("error decoding json response")
-		return nil, ErrDefaultPix
-	}
-
-	if errResponse.Error != nil {
-		err := FindPixError(*errResponse.Error.ErrorCode, &resp.StatusCode)
-		logrus.WithField("celcoin_error", errResponse.Error).
-			WithFields(fields).WithError(err).
-			Error("celcoin get pix error")
-		return nil, err
-	}
-
-	return nil, ErrDefaultPix
+	return execute[PixClaimResponse](ctx, s.httpClient, s.session, apiRequest{
+		method:     http.MethodPost,
+		path:       PixClaimPath,
+		pathParams: []string{"confirm"},
+		body:       req,
+		header:     jsonHeader,
+		notFound:   ErrEntryNotFound,
+		errors:     pixErrors,
+		fields:     fields,
+	})
 }
 
 // CancelPixClaim Cancelar pedido de portabilidade recebido
-func (s *Pix) CancelPixClaim(ctx context.Context, req PixClaimActionRequest) (*PixClaimResponse, error) {
-	fields := logrus.Fields{"request": req}
-	logrus.WithFields(fields).Info("Confirm Pix Claim")
+func (s *Pix) CancelPixClaim(ctx context.Context, req PixClaimActionRequest) (_ *PixClaimResponse, err error) {
+	ctx, op := s.session.startOperation(ctx, "pix", "CancelPixClaim", PixClaimPath)
+	defer op.end(&err)
+	logger := op.logger
+	fields := Fields{"request": req}
+	logger.WithFields(fields).Info("Cancel Pix Claim")
 
-	err := grok.Validator.Struct(req)
+	err = grok.Validator.Struct(req)
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error validating model")
+		logger.WithFields(fields).WithError(err).Error("Error validating model")
 		return nil, grok.FromValidationErros(err)
 	}
 
-	endpoint, err := s.BuildEndpoint(PixClaimPath, nil, "cancel")
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error building endpoint for ConfirmPixClaim")
-		return nil, err
-	}
-
-	logrus.WithField("endpoint", *endpoint).Info("Calling ConfirmPixClaim")
-
-	payload, err := json.Marshal(req)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error serializing request")
-		return nil, fmt.Errorf("error serializing request: %v", err)
-	}
-
-	httpReq, err := http.NewRequestWithContext(ctx, "POST", *endpoint, bytes.NewReader(payload))
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error creating HTTP request")
-		return nil, fmt.Errorf("error creating HTTP request: %v", err)
-	}
-
-	httpReq.Header.Set("Content-Type", "application/json")
-	httpReq.Header.Set("accept", "application/json")
-	resp, err := s.httpClient.Do(httpReq)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error in HTTP client")
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	respBody, _ := ioutil.ReadAll(resp.Body)
-
-	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusAccepted || resp.StatusCode == http.StatusCreated {
-		var response *PixClaimResponse
-
-		if err := json.Unmarshal(respBody, &response); err != nil {
-			logrus.WithFields(fields).WithError(err).
-				Error("error decoding json response")
-			return nil, ErrDefaultPix
-		}
-		return response, nil
-	}
-
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, ErrEntryNotFound
-	}
-
-	var errResponse *ErrorDefaultResponse
-	if err := json.Unmarshal(respBody, &errResponse); err != nil {
-		logrus.WithFields(fields).WithError(err).
-			Error("error decoding json response")
-		return nil, ErrDefaultPix
-	}
-
-	if errResponse.Error != nil {
-		err := FindPixError(*errResponse.Error.ErrorCode, &resp.StatusCode)
-		logrus.WithField("celcoin_error", errResponse.Error).
-			WithFields(fields).WithError(err).
-			Error("celcoin get pix error")
-		return nil, err
-	}
-
-	return nil, ErrDefaultPix
+	return execute[PixClaimResponse](ctx, s.httpClient, s.session, apiRequest{
+		method:     http.MethodPost,
+		path:       PixClaimPath,
+		pathParams: []string{"cancel"},
+		body:       req,
+		header:     jsonAcceptHeader,
+		notFound:   ErrEntryNotFound,
+		errors:     pixErrors,
+		fields:     fields,
+	})
 }
 
 // GetPixClaim consulta um pedido de portabilidade de chave Pix.
-func (s *Pix) GetPixClaim(ctx context.Context, claimID string) (*PixClaimResponse, error) {
-	fields := logrus.Fields{"account": claimID}
-	logrus.WithFields(fields).Info("Get Pix Claim")
-
-	endpoint, err := s.BuildEndpoint(PixClaimPath, nil, claimID)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error building endpoint for GetPixClaim")
-		return nil, err
-	}
-
-	logrus.WithField("endpoint", *endpoint).Info("Calling GetPixClaim")
-
-	httpReq, err := http.NewRequestWithContext(ctx, "GET", *endpoint, nil)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error creating HTTP request")
-		return nil, fmt.Errorf("error creating HTTP request: %v", err)
-	}
-	httpReq.Header.Set("Content-Type", "application/json")
-	resp, err := s.httpClient.Do(httpReq)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error in HTTP client")
-		return nil, fmt.Errorf("error making HTTP request: %v", err)
-	}
-	defer resp.Body.Close()
-
-	respBody, _ := ioutil.ReadAll(resp.Body)
-
-	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusAccepted || resp.StatusCode == http.StatusCreated {
-		var response *PixClaimResponse
-
-		if err := json.Unmarshal(respBody, &response); err != nil {
-			logrus.WithFields(fields).WithError(err).
-				Error("error decoding json response")
-			return nil, ErrDefaultPix
-		}
-		return response, nil
-	}
-
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, ErrEntryNotFound
-	}
-
-	var errResponse *ErrorDefaultResponse
-	if err := json.Unmarshal(respBody, &errResponse); err != nil {
-		logrus.WithFields(fields).WithError(err).
-			Error("error decoding json response")
-		return nil, ErrDefaultPix
-	}
-
-	if errResponse.Error != nil {
-		err := FindPixError(*errResponse.Error.ErrorCode, &resp.StatusCode)
-		logrus.WithField("celcoin_error", errResponse.Error).
-			WithFields(fields).WithError(err).
-			Error("celcoin get pix error")
-		return nil, err
-	}
-
-	return nil, ErrDefaultPix
-
+func (s *Pix) GetPixClaim(ctx context.Context, claimID string) (_ *PixClaimResponse, err error) {
+	ctx, op := s.session.startOperation(ctx, "pix", "GetPixClaim", PixClaimPath)
+	defer op.end(&err)
+	logger := op.logger
+	fields := Fields{"account": claimID}
+	logger.WithFields(fields).Info("Get Pix Claim")
+
+	return execute[PixClaimResponse](ctx, s.httpClient, s.session, apiRequest{
+		method:     http.MethodGet,
+		path:       PixClaimPath,
+		pathParams: []string{claimID},
+		header:     jsonHeader,
+		notFound:   ErrEntryNotFound,
+		errors:     pixErrors,
+		fields:     fields,
+	})
 }
 
 // GetPixClaimList consulta a lista de pedidos de portabilidade de chave Pix.
-func (s *Pix) GetPixClaimList(ctx context.Context, dateFrom, dateTo string, limit, page int, status, claimType string) (*PixClaimListResponse, error) {
-	fields := logrus.Fields{
+func (s *Pix) GetPixClaimList(ctx context.Context, dateFrom, dateTo string, limit, page int, status, claimType string) (_ *PixClaimListResponse, err error) {
+	ctx, op := s.session.startOperation(ctx, "pix", "GetPixClaimList", PixClaimPath)
+	defer op.end(&err)
+	logger := op.logger
+	fields := Fields{
 		"dateFrom":  dateFrom,
 		"dateTo":    dateTo,
 		"limit":     limit,
@@ -2132,64 +895,22 @@ func (s *Pix) GetPixClaimList(ctx context.Context, dateFrom, dateTo string, limi
 		"status":    status,
 		"claimType": claimType,
 	}
-	logrus.WithFields(fields).Info("Get Pix Claim List")
-
-	queryParams := map[string]string{
-		"DateFrom":     dateFrom,
-		"DateTo":       dateTo,
-		"LimitPerPage": fmt.Sprintf("%d", limit),
-		"Page":         fmt.Sprintf("%d", page),
-		"Status":       status,
-		"claimType":    claimType,
-	}
-
-	endpoint, err := s.BuildEndpoint(PixClaimPath, queryParams)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error building endpoint for GetPixClaimList")
-		return nil, err
-	}
-
-	logrus.WithField("endpoint", *endpoint).Info("Calling GetPixClaimList")
-
-	httpReq, err := http.NewRequestWithContext(ctx, "GET", *endpoint, nil)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error creating HTTP request")
-		return nil, fmt.Errorf("error creating HTTP request: %v", err)
-	}
-	httpReq.Header.Set("Content-Type", "application/json")
-	resp, err := s.httpClient.Do(httpReq)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error in HTTP client")
-		return nil, fmt.Errorf("error making HTTP request: %v", err)
-	}
-	defer resp.Body.Close()
-
-	respBody, _ := ioutil.ReadAll(resp.Body)
-
-	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusAccepted || resp.StatusCode == http.StatusCreated {
-		var response *PixClaimListResponse
-		if err := json.Unmarshal(respBody, &response); err != nil {
-			logrus.WithFields(fields).WithError(err).Error("error decoding json response")
-			return nil, ErrDefaultPix
-		}
-		return response, nil
-	}
-
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, ErrEntryNotFound
-	}
-
-	var errResponse *ErrorDefaultResponse
-	if err := json.Unmarshal(respBody, &errResponse); err != nil {
-		logrus.WithFields(fields).WithError(err).Error("error decoding json response")
-		return nil, ErrDefaultPix
-	}
-
-	if errResponse.Error != nil {
-		err := FindPixError(*errResponse.Error.ErrorCode, &resp.StatusCode)
-		logrus.WithField("celcoin_error", errResponse.Error).WithFields(fields).WithError(err).Error("celcoin get pix error")
-		return nil, err
-	}
-
-	return nil, ErrDefaultPix
+	logger.WithFields(fields).Info("Get Pix Claim List")
+
+	return execute[PixClaimListResponse](ctx, s.httpClient, s.session, apiRequest{
+		method: http.MethodGet,
+		path:   PixClaimPath,
+		query: map[string]string{
+			"DateFrom":     dateFrom,
+			"DateTo":       dateTo,
+			"LimitPerPage": fmt.Sprintf("%d", limit),
+			"Page":         fmt.Sprintf("%d", page),
+			"Status":       status,
+			"claimType":    claimType,
+		},
+		header:   jsonHeader,
+		notFound: ErrEntryNotFound,
+		errors:   pixErrors,
+		fields:   fields,
+	})
 }
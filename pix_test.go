@@ -22,7 +22,7 @@ type PixsTestSuite struct {
 	assert        *assert.Assertions
 	ctx           context.Context
 	session       *celcoin.Session
-	pixService    *celcoin.Pix
+	pixService    celcoin.PixInterface
 	mockTransport *MockRoundTripper
 	client        *http.Client
 }
@@ -540,3 +540,241 @@ func (s *PixsTestSuite) TestGetPixCashoutStatus() {
 	s.Assert().Error(err, "Erro esperado para parâmetros inválidos")
 	s.Assert().Nil(response, "A resposta deve ser nula para parâmetros inválidos")
 }
+
+// mockServerScenario cria um MockServer com uma conta pagadora e uma chave Pix de outra conta do servidor.
+func (s *PixsTestSuite) mockServerScenario() (*celcoin.MockServer, *celcoin.Client, celcoin.MockAccount, celcoin.MockPixKey) {
+	server := celcoin.NewMockServer()
+	s.T().Cleanup(server.Close)
+
+	client, err := celcoin.NewClient(server.Config())
+	s.Require().NoError(err)
+
+	payer := server.AddAccount(celcoin.MockAccount{DocumentNumber: "12345678909", Name: "Maria Pagadora", Balance: 100})
+	payee := server.AddAccount(celcoin.MockAccount{DocumentNumber: "98765432100", Name: "Joao Recebedor"})
+	key, err := server.AddPixKey(celcoin.MockPixKey{Account: payee.Account})
+	s.Require().NoError(err)
+	return server, client, payer, key
+}
+
+// mockCashOut monta um Pix por chave da conta pagadora, com o endToEndId da consulta ao DICT.
+func (s *PixsTestSuite) mockCashOut(client *celcoin.Client, clientCode string, payer celcoin.MockAccount,
+	key celcoin.MockPixKey, amount float64) celcoin.PixCashOutRequest {
+	entry, err := client.Pix.GetExternalPixKey(s.ctx, payer.Account, key.Key, payer.DocumentNumber)
+	s.Require().NoError(err)
+
+	return celcoin.PixCashOutRequest{
+		Amount:         amount,
+		ClientCode:     clientCode,
+		EndToEndId:     entry.Body.EndToEndId,
+		InitiationType: "DICT",
+		PaymentType:    "IMMEDIATE",
+		Urgency:        "HIGH",
+		DebitParty:     celcoin.DebitParty{Account: payer.Account},
+		CreditParty: celcoin.CreditParty{
+			Bank:  entry.Body.Account.Participant,
+			Key:   entry.Body.Key,
+			TaxId: entry.Body.Owner.DocumentNumber,
+			Name:  entry.Body.Owner.Name,
+		},
+	}
+}
+
+// TestPaymentPixCashOutTimeoutAfterDebit testa o timeout de um cash-out que a Celcoin já debitou.
+func (s *PixsTestSuite) TestPaymentPixCashOutTimeoutAfterDebit() {
+	server, client, payer, key := s.mockServerScenario()
+	server.Fault(http.MethodPost, celcoin.PixPaymentV2Path, celcoin.MockTimeoutAfterApply()).Times(1)
+
+	ctx, cancel := context.WithTimeout(s.ctx, 300*time.Millisecond)
+	defer cancel()
+	var meta celcoin.ResponseMeta
+	response, err := client.Pix.PaymentPixCashOut(celcoin.WithResponseMeta(ctx, &meta), s.mockCashOut(client, "timeout-1", payer, key, 40))
+	s.Require().Error(err)
+	s.Assert().Nil(response)
+	s.Require().NotNil(meta.Error)
+	s.Assert().Equal(celcoin.ErrorClassAmbiguous, meta.Error.Class)
+	s.Assert().Equal(celcoin.ErrorClassAmbiguous, celcoin.ClassifyError(err))
+
+	// O débito foi aplicado: a consulta de status resolve a ambiguidade
+	status, err := client.Pix.GetPixCashoutStatus(s.ctx, "", "", "timeout-1")
+	s.Require().NoError(err)
+	s.Assert().Equal("CONFIRMED", status.Status)
+	account, _ := server.Account(payer.Account)
+	s.Assert().Equal(60.0, account.Balance)
+
+	// Repetir com o mesmo clientCode não debita novamente
+	_, err = client.Pix.PaymentPixCashOut(celcoin.WithResponseMeta(s.ctx, &meta), s.mockCashOut(client, "timeout-1", payer, key, 40))
+	s.Require().Error(err)
+	s.Assert().Equal("CBE101", meta.Error.CelcoinCode)
+	s.Assert().Len(server.ReceivedRequests(http.MethodPost, celcoin.PixPaymentV2Path), 2)
+}
+
+// TestPaymentPixCashOutRateLimitBurst testa a repetição do cash-out após uma rajada de 429.
+func (s *PixsTestSuite) TestPaymentPixCashOutRateLimitBurst() {
+	server, client, payer, key := s.mockServerScenario()
+	rule := server.Fault(http.MethodPost, celcoin.PixPaymentV2Path, celcoin.MockRateLimited(0)).Times(2)
+
+	ctx := celcoin.WithRetryPolicy(s.ctx, &celcoin.RetryPolicy{
+		MaxAttempts:     3,
+		InitialInterval: 10 * time.Millisecond,
+		MaxInterval:     50 * time.Millisecond,
+		Multiplier:      2,
+		RetryableStatus: []int{http.StatusTooManyRequests},
+	})
+	// O cash-out só é repetido quando o chamador opta pela chave de idempotência
+	ctx = celcoin.WithIdempotencyKey(ctx, "burst-1")
+	response, err := client.Pix.PaymentPixCashOut(ctx, s.mockCashOut(client, "burst-1", payer, key, 10))
+	s.Require().NoError(err)
+	s.Assert().Equal("PROCESSING", response.Status)
+	s.Assert().Equal(2, rule.Hits())
+
+	requests := server.ReceivedRequests(http.MethodPost, celcoin.PixPaymentV2Path)
+	s.Require().Len(requests, 3)
+	s.Assert().Equal(http.StatusTooManyRequests, requests[0].Status)
+	s.Assert().True(requests[1].Fault)
+	s.Assert().Equal(http.StatusOK, requests[2].Status)
+
+	var sent celcoin.PixCashOutRequest
+	s.Require().NoError(requests[2].Decode(&sent))
+	s.Assert().Equal("burst-1", sent.ClientCode)
+}
+
+// TestPaymentPixCashOutGatewayTimeoutIsNotRetried testa que um 504 após o débito não provoca um novo POST.
+func (s *PixsTestSuite) TestPaymentPixCashOutGatewayTimeoutIsNotRetried() {
+	server, client, payer, key := s.mockServerScenario()
+	server.Fault(http.MethodPost, celcoin.PixPaymentV2Path, celcoin.MockFault{Apply: true, Status: http.StatusGatewayTimeout}).Times(1)
+
+	var meta celcoin.ResponseMeta
+	_, err := client.Pix.PaymentPixCashOut(celcoin.WithResponseMeta(s.ctx, &meta), s.mockCashOut(client, "gateway-timeout-1", payer, key, 40))
+	s.Require().Error(err)
+	s.Require().NotNil(meta.Error)
+	s.Assert().Equal(celcoin.ErrorClassAmbiguous, meta.Error.Class)
+	s.Assert().Equal(celcoin.ErrorClassAmbiguous, celcoin.ClassifyError(err))
+	s.Assert().Len(server.ReceivedRequests(http.MethodPost, celcoin.PixPaymentV2Path), 1)
+
+	account, _ := server.Account(payer.Account)
+	s.Assert().Equal(60.0, account.Balance)
+}
+
+// TestPaymentPixCashOutRetryAfterDebitIsAmbiguous testa a recusa do clientCode repetido após um 504 com a
+// repetição habilitada: a operação continua ambígua, e não terminal.
+func (s *PixsTestSuite) TestPaymentPixCashOutRetryAfterDebitIsAmbiguous() {
+	server, client, payer, key := s.mockServerScenario()
+	server.Fault(http.MethodPost, celcoin.PixPaymentV2Path, celcoin.MockFault{Apply: true, Status: http.StatusGatewayTimeout}).Times(1)
+
+	ctx := celcoin.WithRetryPolicy(s.ctx, &celcoin.RetryPolicy{
+		MaxAttempts:     2,
+		InitialInterval: 10 * time.Millisecond,
+		RetryableStatus: []int{http.StatusGatewayTimeout},
+	})
+	ctx = celcoin.WithIdempotencyKey(ctx, "gateway-timeout-2")
+	var meta celcoin.ResponseMeta
+	_, err := client.Pix.PaymentPixCashOut(celcoin.WithResponseMeta(ctx, &meta), s.mockCashOut(client, "gateway-timeout-2", payer, key, 40))
+	s.Require().Error(err)
+	s.Require().NotNil(meta.Error)
+	s.Assert().Equal(celcoin.ErrorClassAmbiguous, meta.Error.Class)
+	s.Assert().Equal(celcoin.ErrorClassAmbiguous, celcoin.ClassifyError(err))
+
+	requests := server.ReceivedRequests(http.MethodPost, celcoin.PixPaymentV2Path)
+	s.Require().Len(requests, 2)
+	s.Assert().Equal(http.StatusBadRequest, requests[1].Status)
+
+	account, _ := server.Account(payer.Account)
+	s.Assert().Equal(60.0, account.Balance)
+}
+
+// TestPaymentPixCashOutEmptyGatewayError testa um 502 sem corpo, que não chega a debitar a conta.
+func (s *PixsTestSuite) TestPaymentPixCashOutEmptyGatewayError() {
+	server, client, payer, key := s.mockServerScenario()
+	server.Fault(http.MethodPost, celcoin.PixPaymentV2Path, celcoin.MockEmptyResponse(http.StatusBadGateway)).Times(1)
+
+	var meta celcoin.ResponseMeta
+	_, err := client.Pix.PaymentPixCashOut(celcoin.WithResponseMeta(celcoin.WithoutRetry(s.ctx), &meta), s.mockCashOut(client, "gateway-1", payer, key, 10))
+	s.Require().Error(err)
+	s.Require().NotNil(meta.Error)
+	s.Assert().Equal(http.StatusBadGateway, meta.Error.StatusCode)
+	s.Assert().Equal(celcoin.ErrorClassAmbiguous, meta.Error.Class)
+	s.Assert().Equal(celcoin.ErrorClassAmbiguous, celcoin.ClassifyError(err))
+
+	account, _ := server.Account(payer.Account)
+	s.Assert().Equal(100.0, account.Balance)
+
+	_, err = client.Pix.PaymentPixCashOut(s.ctx, s.mockCashOut(client, "gateway-1", payer, key, 10))
+	s.Require().NoError(err)
+}
+
+// TestGetPixCashoutStatusMalformedJSON testa uma resposta 200 com JSON truncado.
+func (s *PixsTestSuite) TestGetPixCashoutStatusMalformedJSON() {
+	server, client, payer, key := s.mockServerScenario()
+	_, err := client.Pix.PaymentPixCashOut(s.ctx, s.mockCashOut(client, "malformed-1", payer, key, 10))
+	s.Require().NoError(err)
+
+	server.Fault(http.MethodGet, celcoin.PixPaymentV2Path+"/status", celcoin.MockMalformedJSON(http.StatusOK))
+	response, err := client.Pix.GetPixCashoutStatus(s.ctx, "", "", "malformed-1")
+	s.Require().Error(err)
+	s.Assert().Nil(response)
+
+	server.ClearFaults()
+	response, err = client.Pix.GetPixCashoutStatus(s.ctx, "", "", "malformed-1")
+	s.Require().NoError(err)
+	s.Assert().Equal("CONFIRMED", response.Status)
+}
+
+// TestPaymentPixCashOutCelcoinErrorForPayload testa um erro CBE injetado apenas para um payload.
+func (s *PixsTestSuite) TestPaymentPixCashOutCelcoinErrorForPayload() {
+	server, client, payer, key := s.mockServerScenario()
+	rule := server.Fault(http.MethodPost, celcoin.PixPaymentV2Path,
+		celcoin.MockCelcoinError(http.StatusBadRequest, celcoin.ErrorDomainPix, "CBE159")).
+		WhenBodyContains(`"clientCode":"blocked-1"`)
+
+	var meta celcoin.ResponseMeta
+	_, err := client.Pix.PaymentPixCashOut(celcoin.WithResponseMeta(s.ctx, &meta), s.mockCashOut(client, "blocked-1", payer, key, 10))
+	s.Require().Error(err)
+	s.Require().NotNil(meta.Error)
+	s.Assert().Equal("CBE159", meta.Error.CelcoinCode)
+	s.Assert().Equal(celcoin.ErrorClassTerminal, meta.Error.Class)
+
+	_, err = client.Pix.PaymentPixCashOut(s.ctx, s.mockCashOut(client, "allowed-1", payer, key, 10))
+	s.Require().NoError(err)
+	s.Assert().Equal(1, rule.Hits())
+}
+
+// TestPaymentPixCashOutFaultProbability testa falhas aleatórias reproduzíveis pela semente.
+func (s *PixsTestSuite) TestPaymentPixCashOutFaultProbability() {
+	server, client, payer, _ := s.mockServerScenario()
+	server.SetFaultSeed(42)
+	rule := server.Fault(http.MethodGet, celcoin.BalancePath, celcoin.MockEmptyResponse(http.StatusServiceUnavailable)).
+		WithProbability(0.5)
+
+	failures := 0
+	for i := 0; i < 20; i++ {
+		if _, err := client.Balance.Balance(celcoin.WithoutRetry(s.ctx), payer.Account); err != nil {
+			failures++
+		}
+	}
+	s.Assert().Equal(rule.Hits(), failures)
+	s.Assert().True(failures > 0 && failures < 20)
+	s.Assert().Len(server.ReceivedRequests(http.MethodGet, celcoin.BalancePath), 20)
+}
+
+// TestPaymentPixCashOutDelayedConfirmation testa a transição de PROCESSING para CONFIRMED.
+func (s *PixsTestSuite) TestPaymentPixCashOutDelayedConfirmation() {
+	server, client, payer, key := s.mockServerScenario()
+	server.SetSettlementDelay(time.Hour)
+
+	_, err := client.Pix.PaymentPixCashOut(s.ctx, s.mockCashOut(client, "delayed-1", payer, key, 15))
+	s.Require().NoError(err)
+
+	status, err := client.Pix.GetPixCashoutStatus(s.ctx, "", "", "delayed-1")
+	s.Require().NoError(err)
+	s.Assert().Equal("PROCESSING", status.Status)
+	payee, _ := server.Account(key.Account)
+	s.Assert().Equal(0.0, payee.Balance)
+	s.Assert().Equal(1, server.PendingSettlements())
+
+	s.Assert().Equal(1, server.SettlePending())
+	status, err = client.Pix.GetPixCashoutStatus(s.ctx, "", "", "delayed-1")
+	s.Require().NoError(err)
+	s.Assert().Equal("CONFIRMED", status.Status)
+	payee, _ = server.Account(key.Account)
+	s.Assert().Equal(15.0, payee.Balance)
+}
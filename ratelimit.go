@@ -0,0 +1,307 @@
+package celcoin
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// defaultRateLimitSlowdown ... fator aplicado à taxa a cada 429 quando RateLimitPolicy.Slowdown não é informado
+	defaultRateLimitSlowdown = 0.5
+	// defaultRateLimitRecovery ... intervalo sem 429 após o qual a taxa volta a crescer
+	defaultRateLimitRecovery = 10 * time.Second
+)
+
+// RateLimit ... limite de requisições de uma família de endpoints, identificada pelo prefixo do path.
+// Exemplos de prefixo: PixDictPath, PixDictExternalEntryV2Path, StatementPath, BaasV2ChargePath e "/"+LoginPath.
+type RateLimit struct {
+	PathPrefix string
+	// Rate ... requisições por segundo
+	Rate float64
+	// Burst ... requisições permitidas de uma só vez (mínimo 1)
+	Burst int
+	// MaxConcurrent ... requisições simultâneas permitidas; zero não limita
+	MaxConcurrent int
+}
+
+// RateLimitPolicy ... limites por família de endpoints aplicados pelo RateLimitTransport.
+// Paths sem limite correspondente não são limitados; havendo mais de um prefixo, vale o mais longo.
+type RateLimitPolicy struct {
+	Limits []RateLimit
+	// FailFast ... retorna ErrRateLimited em vez de aguardar a liberação do limite
+	FailFast bool
+	// Slowdown ... fator (0 a 1) aplicado à taxa a cada 429 da Celcoin (padrão 0.5)
+	Slowdown float64
+	// MinRate ... taxa mínima após as reduções por 429 (padrão 10% da taxa configurada)
+	MinRate float64
+	// RecoveryInterval ... intervalo sem 429 para a taxa voltar a crescer (padrão 10s)
+	RecoveryInterval time.Duration
+}
+
+type rateLimitFailFastKey struct{}
+
+// WithRateLimitFailFast ... faz as chamadas com o contexto retornado falharem com ErrRateLimited em vez de aguardar o limite
+func WithRateLimitFailFast(ctx context.Context) context.Context {
+	return context.WithValue(ctx, rateLimitFailFastKey{}, true)
+}
+
+// RateLimitTransport ... RoundTripper que aplica token bucket e limite de concorrência por família de endpoints
+type RateLimitTransport struct {
+	transport http.RoundTripper
+	policy    RateLimitPolicy
+	buckets   []*tokenBucket
+}
+
+// NewRateLimitTransport ...
+func NewRateLimitTransport(transport http.RoundTripper, policy RateLimitPolicy) *RateLimitTransport {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	if policy.Slowdown <= 0 || policy.Slowdown >= 1 {
+		policy.Slowdown = defaultRateLimitSlowdown
+	}
+	if policy.RecoveryInterval <= 0 {
+		policy.RecoveryInterval = defaultRateLimitRecovery
+	}
+
+	buckets := make([]*tokenBucket, 0, len(policy.Limits))
+	for _, limit := range policy.Limits {
+		buckets = append(buckets, newTokenBucket(limit, policy))
+	}
+	return &RateLimitTransport{transport: transport, policy: policy, buckets: buckets}
+}
+
+// RoundTrip ...
+func (t *RateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	bucket := t.bucketFor(req.URL.Path)
+	if bucket == nil {
+		return t.transport.RoundTrip(req)
+	}
+
+	ctx := req.Context()
+	failFast := t.policy.FailFast
+	if override, ok := ctx.Value(rateLimitFailFastKey{}).(bool); ok {
+		failFast = override
+	}
+
+	if err := bucket.wait(ctx, failFast); err != nil {
+		return nil, err
+	}
+	release, err := bucket.acquire(ctx, failFast)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := t.transport.RoundTrip(req)
+	if err != nil {
+		release()
+		return nil, err
+	}
+
+	bucket.observe(resp)
+	resp.Body = &releaseOnClose{ReadCloser: resp.Body, release: release}
+	return resp, nil
+}
+
+// CloseIdleConnections ...
+func (t *RateLimitTransport) CloseIdleConnections() {
+	if closer, ok := t.transport.(interface{ CloseIdleConnections() }); ok {
+		closer.CloseIdleConnections()
+	}
+}
+
+// bucketFor ... bucket do prefixo mais longo que corresponde ao path
+func (t *RateLimitTransport) bucketFor(path string) *tokenBucket {
+	var selected *tokenBucket
+	for _, bucket := range t.buckets {
+		if strings.HasPrefix(path, bucket.prefix) &&
+			(selected == nil || len(bucket.prefix) > len(selected.prefix)) {
+			selected = bucket
+		}
+	}
+	return selected
+}
+
+// tokenBucket ... token bucket de uma família de endpoints, com taxa reduzida adaptativamente após 429
+type tokenBucket struct {
+	prefix    string
+	policy    RateLimitPolicy
+	baseRate  float64
+	minRate   float64
+	burst     float64
+	semaphore chan struct{}
+
+	mutex        sync.Mutex
+	rate         float64
+	tokens       float64
+	last         time.Time
+	pausedUntil  time.Time
+	lastThrottle time.Time
+}
+
+func newTokenBucket(limit RateLimit, policy RateLimitPolicy) *tokenBucket {
+	prefix := limit.PathPrefix
+	if !strings.HasPrefix(prefix, "/") {
+		prefix = "/" + prefix
+	}
+
+	burst := float64(limit.Burst)
+	if burst < 1 {
+		burst = 1
+	}
+
+	minRate := policy.MinRate
+	if minRate <= 0 || minRate > limit.Rate {
+		minRate = limit.Rate / 10
+	}
+
+	bucket := &tokenBucket{
+		prefix:   prefix,
+		policy:   policy,
+		baseRate: limit.Rate,
+		minRate:  minRate,
+		burst:    burst,
+		rate:     limit.Rate,
+		tokens:   burst,
+		last:     time.Now(),
+	}
+	if limit.MaxConcurrent > 0 {
+		bucket.semaphore = make(chan struct{}, limit.MaxConcurrent)
+	}
+	return bucket
+}
+
+// reserve ... consome um token e retorna quanto tempo esperar por ele; com failFast não consome se for preciso esperar
+func (b *tokenBucket) reserve(failFast bool) (time.Duration, bool) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	now := time.Now()
+	b.recoverLocked(now)
+	if b.rate > 0 {
+		b.tokens += now.Sub(b.last).Seconds() * b.rate
+		if b.tokens > b.burst {
+			b.tokens = b.burst
+		}
+	}
+	b.last = now
+
+	var wait time.Duration
+	if b.tokens < 1 && b.rate > 0 {
+		wait = time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+	}
+	if pause := b.pausedUntil.Sub(now); pause > wait {
+		wait = pause
+	}
+
+	if wait > 0 && failFast {
+		return wait, false
+	}
+	b.tokens--
+	return wait, true
+}
+
+// cancel ... devolve o token de uma reserva que não foi usada
+func (b *tokenBucket) cancel() {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.tokens++
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+}
+
+// wait ... aguarda um token respeitando o contexto, ou falha imediatamente com failFast
+func (b *tokenBucket) wait(ctx context.Context, failFast bool) error {
+	wait, ok := b.reserve(failFast)
+	if !ok {
+		return ErrRateLimited
+	}
+	if wait <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		b.cancel()
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// acquire ... ocupa uma vaga de concorrência; a função retornada a libera
+func (b *tokenBucket) acquire(ctx context.Context, failFast bool) (func(), error) {
+	if b.semaphore == nil {
+		return func() {}, nil
+	}
+
+	if failFast {
+		select {
+		case b.semaphore <- struct{}{}:
+		default:
+			return nil, ErrRateLimited
+		}
+	} else {
+		select {
+		case b.semaphore <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	var once sync.Once
+	return func() { once.Do(func() { <-b.semaphore }) }, nil
+}
+
+// observe ... reduz a taxa a cada 429 e pausa o bucket pelo Retry-After informado
+func (b *tokenBucket) observe(resp *http.Response) {
+	if resp.StatusCode != http.StatusTooManyRequests {
+		return
+	}
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	now := time.Now()
+	b.rate *= b.policy.Slowdown
+	if b.rate < b.minRate {
+		b.rate = b.minRate
+	}
+	b.lastThrottle = now
+	if b.tokens > 0 {
+		b.tokens = 0
+	}
+	if retryAfter, ok := parseRetryAfter(resp); ok && now.Add(retryAfter).After(b.pausedUntil) {
+		b.pausedUntil = now.Add(retryAfter)
+	}
+}
+
+// recoverLocked ... dobra a taxa, até a configurada, a cada RecoveryInterval sem 429
+func (b *tokenBucket) recoverLocked(now time.Time) {
+	for b.rate < b.baseRate && now.Sub(b.lastThrottle) >= b.policy.RecoveryInterval {
+		b.rate *= 2
+		if b.rate > b.baseRate {
+			b.rate = b.baseRate
+		}
+		b.lastThrottle = b.lastThrottle.Add(b.policy.RecoveryInterval)
+	}
+}
+
+// releaseOnClose ... libera a vaga de concorrência quando o corpo da resposta é fechado
+type releaseOnClose struct {
+	io.ReadCloser
+	release func()
+}
+
+// Close ...
+func (r *releaseOnClose) Close() error {
+	defer r.release()
+	return r.ReadCloser.Close()
+}
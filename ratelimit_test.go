@@ -0,0 +1,222 @@
+package celcoin_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/contbank/celcoin-sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+// RateLimitTestSuite ...
+type RateLimitTestSuite struct {
+	suite.Suite
+	assert  *assert.Assertions
+	ctx     context.Context
+	handler http.HandlerFunc
+	server  *httptest.Server
+}
+
+// TestRateLimitTestSuite ...
+func TestRateLimitTestSuite(t *testing.T) {
+	suite.Run(t, new(RateLimitTestSuite))
+}
+
+// SetupTest ...
+func (s *RateLimitTestSuite) SetupTest() {
+	s.assert = assert.New(s.T())
+	s.ctx = context.Background()
+	s.handler = func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.handler(w, r)
+	}))
+}
+
+// TearDownTest ...
+func (s *RateLimitTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *RateLimitTestSuite) newClient(policy celcoin.RateLimitPolicy) *http.Client {
+	return &http.Client{Transport: celcoin.NewRateLimitTransport(http.DefaultTransport, policy)}
+}
+
+func (s *RateLimitTestSuite) get(ctx context.Context, client *http.Client, path string) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.server.URL+path, nil)
+	s.Require().NoError(err)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	return resp.StatusCode, nil
+}
+
+// TestBlocksUntilTokenIsAvailable ...
+func (s *RateLimitTestSuite) TestBlocksUntilTokenIsAvailable() {
+	client := s.newClient(celcoin.RateLimitPolicy{
+		Limits: []celcoin.RateLimit{{PathPrefix: celcoin.StatementPath, Rate: 20, Burst: 2}},
+	})
+
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		_, err := s.get(s.ctx, client, celcoin.StatementPath)
+		s.Require().NoError(err)
+	}
+
+	// Duas requisições do burst e outras duas a 20/s
+	s.assert.GreaterOrEqual(time.Since(start), 90*time.Millisecond)
+}
+
+// TestOtherFamiliesAreNotLimited ...
+func (s *RateLimitTestSuite) TestOtherFamiliesAreNotLimited() {
+	client := s.newClient(celcoin.RateLimitPolicy{
+		Limits:   []celcoin.RateLimit{{PathPrefix: celcoin.PixDictPath, Rate: 1, Burst: 1}},
+		FailFast: true,
+	})
+
+	for i := 0; i < 5; i++ {
+		_, err := s.get(s.ctx, client, celcoin.BalancePath)
+		s.Require().NoError(err)
+	}
+}
+
+// TestFailFast ...
+func (s *RateLimitTestSuite) TestFailFast() {
+	client := s.newClient(celcoin.RateLimitPolicy{
+		Limits:   []celcoin.RateLimit{{PathPrefix: celcoin.LoginPath, Rate: 1, Burst: 1}},
+		FailFast: true,
+	})
+
+	_, err := s.get(s.ctx, client, "/"+celcoin.LoginPath)
+	s.Require().NoError(err)
+
+	_, err = s.get(s.ctx, client, "/"+celcoin.LoginPath)
+	s.assert.ErrorIs(err, celcoin.ErrRateLimited)
+}
+
+// TestFailFastFromContext ...
+func (s *RateLimitTestSuite) TestFailFastFromContext() {
+	client := s.newClient(celcoin.RateLimitPolicy{
+		Limits: []celcoin.RateLimit{{PathPrefix: celcoin.BaasV2ChargePath, Rate: 1, Burst: 1}},
+	})
+
+	_, err := s.get(s.ctx, client, celcoin.BaasV2ChargePath)
+	s.Require().NoError(err)
+
+	_, err = s.get(celcoin.WithRateLimitFailFast(s.ctx), client, celcoin.BaasV2ChargePath)
+	s.assert.ErrorIs(err, celcoin.ErrRateLimited)
+}
+
+// TestWaitRespectsContext ...
+func (s *RateLimitTestSuite) TestWaitRespectsContext() {
+	client := s.newClient(celcoin.RateLimitPolicy{
+		Limits: []celcoin.RateLimit{{PathPrefix: celcoin.StatementPath, Rate: 0.1, Burst: 1}},
+	})
+
+	_, err := s.get(s.ctx, client, celcoin.StatementPath)
+	s.Require().NoError(err)
+
+	ctx, cancel := context.WithTimeout(s.ctx, 50*time.Millisecond)
+	defer cancel()
+
+	_, err = s.get(ctx, client, celcoin.StatementPath)
+	s.assert.ErrorIs(err, context.DeadlineExceeded)
+}
+
+// TestMaxConcurrent ...
+func (s *RateLimitTestSuite) TestMaxConcurrent() {
+	var inFlight, maxInFlight int32
+	s.handler = func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt32(&inFlight, 1)
+		for {
+			peak := atomic.LoadInt32(&maxInFlight)
+			if current <= peak || atomic.CompareAndSwapInt32(&maxInFlight, peak, current) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		atomic.AddInt32(&inFlight, -1)
+	}
+
+	client := s.newClient(celcoin.RateLimitPolicy{
+		Limits: []celcoin.RateLimit{{PathPrefix: celcoin.PixDictPath, Rate: 1000, Burst: 100, MaxConcurrent: 2}},
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := s.get(s.ctx, client, celcoin.PixDictPath+"/123")
+			s.assert.NoError(err)
+		}()
+	}
+	wg.Wait()
+
+	s.assert.Equal(int32(2), atomic.LoadInt32(&maxInFlight))
+}
+
+// TestTooManyRequestsPausesBucket ...
+func (s *RateLimitTestSuite) TestTooManyRequestsPausesBucket() {
+	var calls int32
+	s.handler = func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}
+
+	client := s.newClient(celcoin.RateLimitPolicy{
+		Limits: []celcoin.RateLimit{{PathPrefix: celcoin.PixDictExternalEntryV2Path, Rate: 100, Burst: 10}},
+	})
+
+	status, err := s.get(s.ctx, client, celcoin.PixDictExternalEntryV2Path)
+	s.Require().NoError(err)
+	s.assert.Equal(http.StatusTooManyRequests, status)
+
+	start := time.Now()
+	status, err = s.get(s.ctx, client, celcoin.PixDictExternalEntryV2Path)
+	s.Require().NoError(err)
+	s.assert.Equal(http.StatusOK, status)
+	s.assert.GreaterOrEqual(time.Since(start), 900*time.Millisecond)
+}
+
+// TestTooManyRequestsSlowsBucket ...
+func (s *RateLimitTestSuite) TestTooManyRequestsSlowsBucket() {
+	var calls int32
+	s.handler = func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}
+
+	// Após o 429 a taxa cai de 50/s para 5/s
+	client := s.newClient(celcoin.RateLimitPolicy{
+		Limits:   []celcoin.RateLimit{{PathPrefix: celcoin.StatementPath, Rate: 50, Burst: 5}},
+		Slowdown: 0.1,
+	})
+
+	_, err := s.get(s.ctx, client, celcoin.StatementPath)
+	s.Require().NoError(err)
+
+	start := time.Now()
+	for i := 0; i < 2; i++ {
+		_, err = s.get(s.ctx, client, celcoin.StatementPath)
+		s.Require().NoError(err)
+	}
+	s.assert.GreaterOrEqual(time.Since(start), 300*time.Millisecond)
+}
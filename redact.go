@@ -0,0 +1,407 @@
+package celcoin
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"mime"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+const (
+	// RedactedValue ... valor que substitui os dados mascarados nos logs
+	RedactedValue = "RESTRICTED"
+	// DefaultLogMaxBodySize ... tamanho máximo, em bytes, dos corpos registrados nos logs
+	DefaultLogMaxBodySize = 4096
+)
+
+// LogLevel ... nível dos logs de requisição e resposta
+type LogLevel string
+
+const (
+	// LogLevelDebug ...
+	LogLevelDebug LogLevel = "debug"
+	// LogLevelInfo ...
+	LogLevelInfo LogLevel = "info"
+	// LogLevelWarn ...
+	LogLevelWarn LogLevel = "warn"
+	// LogLevelError ...
+	LogLevelError LogLevel = "error"
+)
+
+var (
+	// defaultRedactedHeaders ... cabeçalhos com credenciais
+	defaultRedactedHeaders = []string{
+		"Authorization",
+		"Proxy-Authorization",
+		"Cookie",
+		"Set-Cookie",
+		"X-Api-Key",
+	}
+	// defaultRedactedFormFields ... campos de formulário e query string com credenciais
+	defaultRedactedFormFields = []string{
+		"client_secret",
+		"password",
+		"access_token",
+		"refresh_token",
+	}
+	// defaultRedactedJSONKeys ... chaves JSON com credenciais e dados pessoais (LGPD), mascaradas em qualquer nível
+	defaultRedactedJSONKeys = []string{
+		"accessToken",
+		"refreshToken",
+		"token",
+		"password",
+		"pwd",
+		"clientSecret",
+		"privateKey",
+		"passphrase",
+		"documentNumber",
+		"taxId",
+		"ownerTaxId",
+		"cpf",
+		"cnpj",
+		"name",
+		"fullName",
+		"socialName",
+		"motherName",
+		"birthDate",
+		"email",
+		"businessEmail",
+		"phoneNumber",
+		"businessPhoneNumber",
+		"contactNumber",
+		"address",
+		"businessAddress",
+		"key",
+		"account",
+		"accountNumber",
+		"branch",
+	}
+	// redactedPathTemplates ... caminhos com dados pessoais em segmentos; o segmento {nome} é mascarado quando nome
+	// é uma chave JSON mascarada
+	redactedPathTemplates = []string{
+		PixDictPath + "/{key}",
+		PixDictExternalEntryV2Path + "/{account}",
+	}
+)
+
+// LogPolicy ... regras de mascaramento, nível, tamanho e amostragem dos logs do LoggingHTTPClient e do LoggingRoundTripper.
+// As regras de mascaramento também valem para os campos registrados pelos serviços (payloads, contas, chaves Pix).
+// As listas são somadas às regras padrão, exceto quando DisableDefaultRedaction é verdadeiro.
+type LogPolicy struct {
+	// Level ... nível dos logs de requisição e resposta (padrão LogLevelInfo)
+	Level LogLevel
+	// RedactHeaders ... cabeçalhos mascarados, sem diferenciar maiúsculas
+	RedactHeaders []string
+	// RedactFormFields ... campos de formulário e de query string mascarados
+	RedactFormFields []string
+	// RedactJSONKeys ... chaves JSON mascaradas em qualquer nível; "access_token" e "accessToken" são equivalentes.
+	// Também mascaram os parâmetros de query e os segmentos de caminho (ex.: a conta na consulta ao DICT) de mesmo nome.
+	RedactJSONKeys []string
+	// RedactJSONPaths ... caminhos gjson/sjson mascarados (ex.: "body.debitParty.account")
+	RedactJSONPaths []string
+	// DisableDefaultRedaction ... desativa as regras padrão de cabeçalhos, campos e chaves
+	DisableDefaultRedaction bool
+	// MaxBodySize ... bytes registrados de cada corpo (padrão DefaultLogMaxBodySize); negativo omite os corpos
+	MaxBodySize int
+	// SampleRate ... fração (0 a 1] das chamadas bem-sucedidas registradas; zero registra todas.
+	// Falhas e respostas com status >= 400 são sempre registradas.
+	SampleRate float64
+
+	// keepJSONKeys ... chaves padrão que não são mascaradas (ex.: as contas, nos cassettes)
+	keepJSONKeys []string
+}
+
+// DefaultLogPolicy ... política usada quando Config.LogPolicy não é informado
+func DefaultLogPolicy() *LogPolicy {
+	return &LogPolicy{
+		Level:       LogLevelInfo,
+		MaxBodySize: DefaultLogMaxBodySize,
+		SampleRate:  1,
+	}
+}
+
+// sampled ... decide se uma chamada bem-sucedida deve ser registrada
+func (p *LogPolicy) sampled() bool {
+	return p.SampleRate <= 0 || p.SampleRate >= 1 || rand.Float64() < p.SampleRate
+}
+
+// redactHeaders ... cópia dos cabeçalhos com os valores sensíveis mascarados
+func (p *LogPolicy) redactHeaders(header http.Header) http.Header {
+	if header == nil {
+		return nil
+	}
+	redacted := header.Clone()
+	for _, name := range p.rules(defaultRedactedHeaders, p.RedactHeaders) {
+		key := http.CanonicalHeaderKey(name)
+		if _, found := redacted[key]; found {
+			redacted[key] = []string{RedactedValue}
+		}
+	}
+	return redacted
+}
+
+// redactValues ... cópia dos valores de formulário ou query string com os campos sensíveis mascarados
+func (p *LogPolicy) redactValues(values url.Values) url.Values {
+	if values == nil {
+		return nil
+	}
+	redacted := make(url.Values, len(values))
+	for key, value := range values {
+		redacted[key] = value
+	}
+	for _, field := range p.rules(defaultRedactedFormFields, p.RedactFormFields) {
+		for key := range redacted {
+			if strings.EqualFold(key, field) {
+				redacted[key] = []string{RedactedValue}
+			}
+		}
+	}
+	return redacted
+}
+
+// redactURL ... URL com a query e os segmentos de caminho sensíveis mascarados
+func (p *LogPolicy) redactURL(u *url.URL) string {
+	if u == nil {
+		return ""
+	}
+	return p.redactURLWithKeys(p.jsonKeys(), u)
+}
+
+// redactURLWithKeys ... aplica à query as regras de formulário e de chaves JSON e aos caminhos de
+// redactedPathTemplates as regras de chaves JSON
+func (p *LogPolicy) redactURLWithKeys(keys map[string]bool, u *url.URL) string {
+	redacted := redactPathSegments(u, redactedPathTemplates, func(name string) bool {
+		return keys[normalizeJSONKey(name)]
+	})
+	if len(u.RawQuery) == 0 {
+		return redacted.String()
+	}
+
+	values := p.redactValues(u.Query())
+	for key := range values {
+		if keys[normalizeJSONKey(key)] {
+			values[key] = []string{RedactedValue}
+		}
+	}
+	copied := *redacted
+	copied.RawQuery = values.Encode()
+	return copied.String()
+}
+
+// redactPathSegments ... URL com os segmentos {nome} dos templates mascarados quando redact(nome) é verdadeiro.
+// O template é comparado com o final do caminho, que pode ter o prefixo do APIEndpoint.
+func redactPathSegments(u *url.URL, templates []string, redact func(name string) bool) *url.URL {
+	segments := splitMockPath(u.Path)
+	redacted := false
+	for _, template := range templates {
+		templateSegments := splitMockPath(template)
+		offset := len(segments) - len(templateSegments)
+		if offset < 0 {
+			continue
+		}
+		route := mockRoute{segments: templateSegments}
+		if _, ok := route.match(segments[offset:]); !ok {
+			continue
+		}
+		for i, segment := range templateSegments {
+			if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") && redact(strings.Trim(segment, "{}")) {
+				segments[offset+i] = RedactedValue
+				redacted = true
+			}
+		}
+	}
+	if !redacted {
+		return u
+	}
+
+	copied := *u
+	copied.Path = "/" + strings.Join(segments, "/")
+	copied.RawPath = ""
+	return &copied
+}
+
+// redactBody ... corpo mascarado conforme o Content-Type e limitado a MaxBodySize
+func (p *LogPolicy) redactBody(contentType string, body []byte) string {
+	if p.MaxBodySize < 0 || len(body) == 0 {
+		return ""
+	}
+
+	var redacted string
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	switch {
+	case mediaType == "application/x-www-form-urlencoded":
+		values, err := url.ParseQuery(string(body))
+		if err != nil {
+			redacted = RedactedValue
+			break
+		}
+		redacted = p.redactValues(values).Encode()
+	case json.Valid(body):
+		redacted = p.redactJSON(string(body))
+	default:
+		redacted = string(body)
+	}
+	return p.truncate(redacted)
+}
+
+// redactJSON ... mascara as chaves em qualquer nível e depois os caminhos configurados
+func (p *LogPolicy) redactJSON(str string) string {
+	var v interface{}
+	if err := json.Unmarshal([]byte(str), &v); err != nil {
+		return RedactedValue
+	}
+
+	str = marshal(redactJSONKeys(v, p.jsonKeys()))
+
+	for _, path := range p.RedactJSONPaths {
+		if !gjson.Get(str, path).Exists() {
+			continue
+		}
+		str, _ = sjson.Set(str, path, RedactedValue)
+	}
+	return str
+}
+
+// jsonKeys ... chaves JSON mascaradas, normalizadas
+func (p *LogPolicy) jsonKeys() map[string]bool {
+	keys := make(map[string]bool)
+	for _, key := range p.rules(defaultRedactedJSONKeys, p.RedactJSONKeys) {
+		keys[normalizeJSONKey(key)] = true
+	}
+	for _, key := range p.keepJSONKeys {
+		delete(keys, normalizeJSONKey(key))
+	}
+	return keys
+}
+
+// truncate ... limita o corpo registrado a MaxBodySize, sem cortar caracteres UTF-8 ao meio
+func (p *LogPolicy) truncate(body string) string {
+	limit := p.MaxBodySize
+	if limit == 0 {
+		limit = DefaultLogMaxBodySize
+	}
+	if len(body) <= limit {
+		return body
+	}
+	prefix := truncateUTF8(body, limit)
+	return fmt.Sprintf("%s...(truncated %d bytes)", prefix, len(body)-len(prefix))
+}
+
+// redactPayload ... payload registrado pelos serviços (requisições, respostas e modelos) como JSON mascarado
+func (p *LogPolicy) redactPayload(v interface{}) string {
+	if p.MaxBodySize < 0 {
+		return ""
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return RedactedValue
+	}
+	return p.truncate(p.redactJSON(string(data)))
+}
+
+// redactFields ... cópia dos campos de log com os dados sensíveis mascarados
+func (p *LogPolicy) redactFields(fields Fields) Fields {
+	if fields == nil {
+		return nil
+	}
+	keys := p.jsonKeys()
+	redacted := make(Fields, len(fields))
+	for key, value := range fields {
+		redacted[key] = p.redactField(keys, key, value)
+	}
+	return redacted
+}
+
+// redactField ... campos com nome sensível são mascarados; structs, mapas e slices viram JSON mascarado e
+// URLs têm a query mascarada. Os demais valores (textos, números, erros, cabeçalhos já mascarados) são mantidos.
+func (p *LogPolicy) redactField(keys map[string]bool, key string, value interface{}) interface{} {
+	if value == nil {
+		return nil
+	}
+	normalized := normalizeJSONKey(key)
+	if keys[normalized] {
+		return RedactedValue
+	}
+
+	switch v := value.(type) {
+	case Fields:
+		return p.redactFields(v)
+	case string:
+		if strings.HasSuffix(normalized, "url") || strings.HasSuffix(normalized, "endpoint") {
+			return p.redactLogURL(keys, v)
+		}
+		return v
+	case error, fmt.Stringer, http.Header, json.RawMessage, []byte:
+		return v
+	}
+
+	kind := reflect.TypeOf(value).Kind()
+	if kind == reflect.Ptr {
+		kind = reflect.TypeOf(value).Elem().Kind()
+	}
+	switch kind {
+	case reflect.Struct, reflect.Map, reflect.Slice, reflect.Array, reflect.Interface:
+		return p.redactPayload(value)
+	}
+	return value
+}
+
+// redactLogURL ... URL registrada em um campo de log, mascarada como em redactURL
+func (p *LogPolicy) redactLogURL(keys map[string]bool, raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return raw
+	}
+	return p.redactURLWithKeys(keys, u)
+}
+
+// truncateUTF8 ... prefixo de até limit bytes que termina em um limite de caractere UTF-8
+func truncateUTF8(value string, limit int) string {
+	if len(value) <= limit {
+		return value
+	}
+	for limit > 0 && !utf8.RuneStart(value[limit]) {
+		limit--
+	}
+	return value[:limit]
+}
+
+// rules ... regras padrão somadas às configuradas
+func (p *LogPolicy) rules(defaults, configured []string) []string {
+	if p.DisableDefaultRedaction {
+		return configured
+	}
+	return append(append([]string{}, defaults...), configured...)
+}
+
+// redactJSONKeys ... percorre o JSON decodificado mascarando as chaves sensíveis
+func redactJSONKeys(v interface{}, keys map[string]bool) interface{} {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		for key, item := range value {
+			if keys[normalizeJSONKey(key)] {
+				value[key] = RedactedValue
+				continue
+			}
+			value[key] = redactJSONKeys(item, keys)
+		}
+	case []interface{}:
+		for i, item := range value {
+			value[i] = redactJSONKeys(item, keys)
+		}
+	}
+	return v
+}
+
+// normalizeJSONKey ... ignora maiúsculas e separadores para que "access_token" e "accessToken" sejam iguais
+func normalizeJSONKey(key string) string {
+	return strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(key))
+}
@@ -0,0 +1,97 @@
+package celcoin
+
+import (
+	"context"
+	"net/http"
+)
+
+// CorrelationIDHeader ... cabeçalho de correlação enviado em todas as chamadas à Celcoin
+const CorrelationIDHeader = "x-correlation-id"
+
+// legacyRequestIDKey ... chave usada pelo grok e pelas versões anteriores do SDK
+const legacyRequestIDKey = "Request-Id"
+
+type requestIDContextKey struct{}
+
+// withLegacyRequestID ... associa o request ID ao contexto também nas chaves "Request-Id" lidas pelo grok
+// (grok.GetRequestID) e pelas versões anteriores do SDK
+func withLegacyRequestID(ctx context.Context, requestID string) context.Context {
+	ctx = context.WithValue(ctx, legacyRequestIDKey, requestID)
+	ctx = context.WithValue(ctx, requestIDKey(legacyRequestIDKey), requestID)
+	return WithRequestID(ctx, requestID)
+}
+
+// WithRequestID ... associa o request ID ao contexto. Ele é enviado no CorrelationIDHeader,
+// incluído nos logs (request_id) e nos erros de transporte.
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDContextKey{}, requestID)
+}
+
+// RequestIDFrom ... request ID do contexto; aceita também a chave "Request-Id" usada pelo grok
+func RequestIDFrom(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	if requestID, ok := ctx.Value(requestIDContextKey{}).(string); ok && len(requestID) > 0 {
+		return requestID
+	}
+	if requestID, ok := ctx.Value(requestIDKey(legacyRequestIDKey)).(string); ok && len(requestID) > 0 {
+		return requestID
+	}
+	requestID, _ := ctx.Value(legacyRequestIDKey).(string)
+	return requestID
+}
+
+// ensureRequestID ... garante um request ID no contexto, gerando um novo quando ausente
+func ensureRequestID(ctx context.Context) context.Context {
+	if len(RequestIDFrom(ctx)) > 0 {
+		return ctx
+	}
+	return WithRequestID(ctx, NewRequestID())
+}
+
+// setCorrelationHeader ... envia o request ID do contexto, sem sobrescrever um cabeçalho definido pelo serviço
+func setCorrelationHeader(req *http.Request) {
+	if len(req.Header.Get(CorrelationIDHeader)) > 0 {
+		return
+	}
+	if requestID := RequestIDFrom(req.Context()); len(requestID) > 0 {
+		req.Header.Set(CorrelationIDHeader, requestID)
+	}
+}
+
+// correlationTransport ... envia o CorrelationIDHeader em todas as requisições do cliente autenticado,
+// inclusive as feitas sem o LoggingHTTPClient
+type correlationTransport struct {
+	transport http.RoundTripper
+}
+
+// RoundTrip ...
+func (t *correlationTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if len(req.Header.Get(CorrelationIDHeader)) == 0 && len(RequestIDFrom(req.Context())) > 0 {
+		// O RoundTripper não deve alterar a requisição recebida
+		req = req.Clone(req.Context())
+		setCorrelationHeader(req)
+	}
+	return t.transport.RoundTrip(req)
+}
+
+// CloseIdleConnections ...
+func (t *correlationTransport) CloseIdleConnections() {
+	if closer, ok := t.transport.(interface{ CloseIdleConnections() }); ok {
+		closer.CloseIdleConnections()
+	}
+}
+
+// withRequestID ... inclui o request ID no TransportError. O erro é copiado porque a mesma
+// falha de renovação de token é entregue a todas as chamadas que aguardavam o token.
+func withRequestID(ctx context.Context, err error) error {
+	transportErr, ok := err.(*TransportError)
+	requestID := RequestIDFrom(ctx)
+	if !ok || len(requestID) == 0 {
+		return err
+	}
+	copied := *transportErr
+	copied.RequestID = requestID
+	return &copied
+}
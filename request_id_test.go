@@ -0,0 +1,147 @@
+package celcoin_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/contbank/celcoin-sdk"
+	"github.com/contbank/grok"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+// RequestIDTestSuite ...
+type RequestIDTestSuite struct {
+	suite.Suite
+	assert  *assert.Assertions
+	headers []http.Header
+	server  *httptest.Server
+	session celcoin.Session
+}
+
+// TestRequestIDTestSuite ...
+func TestRequestIDTestSuite(t *testing.T) {
+	suite.Run(t, new(RequestIDTestSuite))
+}
+
+// SetupTest ...
+func (s *RequestIDTestSuite) SetupTest() {
+	s.assert = assert.New(s.T())
+	s.headers = nil
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.headers = append(s.headers, r.Header.Clone())
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":"SUCCESS","version":"1.0.0","body":{}}`))
+	}))
+	s.session = celcoin.Session{
+		APIEndpoint: s.server.URL,
+		Logger:      celcoin.NewNopLogger(),
+	}
+}
+
+// TearDownTest ...
+func (s *RequestIDTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+// TestRequestIDFrom ...
+func (s *RequestIDTestSuite) TestRequestIDFrom() {
+	s.assert.Empty(celcoin.RequestIDFrom(context.Background()))
+
+	ctx := celcoin.WithRequestID(context.Background(), "request-123")
+	s.assert.Equal("request-123", celcoin.RequestIDFrom(ctx))
+	s.assert.Equal("request-123", celcoin.GetRequestID(ctx))
+
+	legacy := context.WithValue(context.Background(), "Request-Id", "legacy-123")
+	s.assert.Equal("legacy-123", celcoin.RequestIDFrom(legacy))
+	s.assert.Equal("request-123", celcoin.RequestIDFrom(celcoin.WithRequestID(legacy, "request-123")))
+
+	s.assert.NotEmpty(celcoin.RequestIDFrom(celcoin.NewContextRequestID(context.Background())))
+	s.assert.NotEmpty(celcoin.RequestIDFrom(celcoin.GenerateNewRequestID(context.Background())))
+}
+
+// TestGeneratedRequestIDKeepsLegacyKey ...
+func (s *RequestIDTestSuite) TestGeneratedRequestIDKeepsLegacyKey() {
+	for _, ctx := range []context.Context{
+		celcoin.NewContextRequestID(context.Background()),
+		celcoin.GenerateNewRequestID(context.Background()),
+	} {
+		requestID := celcoin.RequestIDFrom(ctx)
+		s.Require().NotEmpty(requestID)
+		s.assert.Equal(requestID, grok.GetRequestID(ctx))
+		s.assert.Equal(requestID, ctx.Value("Request-Id"))
+	}
+}
+
+// TestCorrelationHeaderWithoutLoggingClient ...
+func (s *RequestIDTestSuite) TestCorrelationHeaderWithoutLoggingClient() {
+	s.server.Close()
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.headers = append(s.headers, r.Header.Clone())
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token":"token","expires_in":3600}`))
+	}))
+
+	session, err := celcoin.NewSession(celcoin.Config{
+		ClientID:      celcoin.String("client-id"),
+		ClientSecret:  celcoin.String("client-secret"),
+		APIEndpoint:   celcoin.String(s.server.URL),
+		LoginEndpoint: celcoin.String(s.server.URL),
+		Logger:        celcoin.NewNopLogger(),
+	})
+	s.Require().NoError(err)
+	client, err := celcoin.CreateOAuth2HTTPClient(session)
+	s.Require().NoError(err)
+
+	ctx := celcoin.WithRequestID(context.Background(), "request-123")
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.server.URL+celcoin.BalancePath, nil)
+	s.Require().NoError(err)
+	resp, err := client.Do(req)
+	s.Require().NoError(err)
+	resp.Body.Close()
+
+	// O primeiro cabeçalho é o da chamada de token
+	s.assert.Empty(req.Header.Get(celcoin.CorrelationIDHeader))
+	s.Require().Len(s.headers, 2)
+	s.assert.Equal("request-123", s.headers[1].Get(celcoin.CorrelationIDHeader))
+}
+
+// TestCorrelationHeader ...
+func (s *RequestIDTestSuite) TestCorrelationHeader() {
+	ctx := celcoin.WithRequestID(context.Background(), "request-123")
+
+	_, err := celcoin.NewStatement(http.DefaultClient, s.session).GetStatements(ctx, &celcoin.StatementRequest{})
+	s.Require().NoError(err)
+	_, err = celcoin.NewBalance(http.DefaultClient, s.session).Balance(ctx, "123456")
+	s.Require().NoError(err)
+
+	s.Require().Len(s.headers, 2)
+	for _, header := range s.headers {
+		s.assert.Equal("request-123", header.Get(celcoin.CorrelationIDHeader))
+	}
+}
+
+// TestCorrelationHeaderGenerated ...
+func (s *RequestIDTestSuite) TestCorrelationHeaderGenerated() {
+	_, err := celcoin.NewBalance(http.DefaultClient, s.session).Balance(context.Background(), "123456")
+	s.Require().NoError(err)
+
+	s.Require().Len(s.headers, 1)
+	s.assert.NotEmpty(s.headers[0].Get(celcoin.CorrelationIDHeader))
+}
+
+// TestContextCancellation ...
+func (s *RequestIDTestSuite) TestContextCancellation() {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := celcoin.NewStatement(http.DefaultClient, s.session).GetStatements(ctx, &celcoin.StatementRequest{})
+	s.assert.ErrorIs(err, context.Canceled)
+
+	_, err = celcoin.NewBoletos(http.DefaultClient, s.session).GetCharge(ctx, &celcoin.ChargeRequest{})
+	s.assert.ErrorIs(err, context.Canceled)
+
+	s.assert.Empty(s.headers)
+}
@@ -0,0 +1,63 @@
+package celcoin
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/tidwall/gjson"
+)
+
+// ResponseMeta ... metadados da última resposta HTTP recebida da Celcoin durante uma operação.
+// Preserva o payload exato, útil para abrir chamados com a Celcoin. Use um ResponseMeta por chamada.
+type ResponseMeta struct {
+	Method     string
+	URL        string
+	StatusCode int
+	Header     http.Header
+	// Body ... corpo bruto da resposta, sem redação
+	Body []byte
+	// Version e Status ... campos "version" e "status" do envelope JSON da Celcoin, quando presentes
+	Version   string
+	Status    string
+	Latency   time.Duration
+	RequestID string
+	// Error ... detalhes do erro da operação (código e mensagem originais da Celcoin e ErrorClass); nil quando a
+	// operação não falhou. Preenchido também quando não houve resposta HTTP (ex.: erro de rede).
+	Error *CelcoinAPIError
+}
+
+type responseMetaKey struct{}
+
+// WithResponseMeta ... pede que as chamadas feitas com o contexto preencham meta com a resposta recebida
+func WithResponseMeta(ctx context.Context, meta *ResponseMeta) context.Context {
+	return context.WithValue(ctx, responseMetaKey{}, meta)
+}
+
+// responseMetaFrom ...
+func responseMetaFrom(ctx context.Context) *ResponseMeta {
+	meta, _ := ctx.Value(responseMetaKey{}).(*ResponseMeta)
+	return meta
+}
+
+// captureResponseMeta ... preenche o ResponseMeta do contexto, se houver
+func captureResponseMeta(req *http.Request, resp *http.Response, body []byte, latency time.Duration) {
+	meta := responseMetaFrom(req.Context())
+	if meta == nil {
+		return
+	}
+
+	*meta = ResponseMeta{
+		Method:     req.Method,
+		URL:        req.URL.String(),
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header.Clone(),
+		Body:       append([]byte{}, body...),
+		Latency:    latency,
+		RequestID:  RequestIDFrom(req.Context()),
+	}
+	if gjson.ValidBytes(body) {
+		meta.Version = gjson.GetBytes(body, "version").String()
+		meta.Status = gjson.GetBytes(body, "status").String()
+	}
+}
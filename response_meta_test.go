@@ -0,0 +1,102 @@
+package celcoin_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/contbank/celcoin-sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+// ResponseMetaTestSuite ...
+type ResponseMetaTestSuite struct {
+	suite.Suite
+	assert  *assert.Assertions
+	status  int
+	body    string
+	server  *httptest.Server
+	session celcoin.Session
+}
+
+// TestResponseMetaTestSuite ...
+func TestResponseMetaTestSuite(t *testing.T) {
+	suite.Run(t, new(ResponseMetaTestSuite))
+}
+
+// SetupTest ...
+func (s *ResponseMetaTestSuite) SetupTest() {
+	s.assert = assert.New(s.T())
+	s.status = http.StatusOK
+	s.body = `{"status":"SUCCESS","version":"1.0.0","body":{"amount":10}}`
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Celcoin-Trace", "trace-123")
+		w.WriteHeader(s.status)
+		w.Write([]byte(s.body))
+	}))
+	s.session = celcoin.Session{
+		APIEndpoint: s.server.URL,
+		Logger:      celcoin.NewNopLogger(),
+	}
+}
+
+// TearDownTest ...
+func (s *ResponseMetaTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+// TestResponseMeta ...
+func (s *ResponseMetaTestSuite) TestResponseMeta() {
+	var meta celcoin.ResponseMeta
+	ctx := celcoin.WithResponseMeta(celcoin.WithRequestID(context.Background(), "request-123"), &meta)
+
+	_, err := celcoin.NewBalance(http.DefaultClient, s.session).Balance(ctx, "123456")
+	s.Require().NoError(err)
+
+	s.assert.Equal(http.MethodGet, meta.Method)
+	s.assert.Contains(meta.URL, celcoin.BalancePath)
+	s.assert.Equal(http.StatusOK, meta.StatusCode)
+	s.assert.Equal("trace-123", meta.Header.Get("X-Celcoin-Trace"))
+	s.assert.JSONEq(s.body, string(meta.Body))
+	s.assert.Equal("1.0.0", meta.Version)
+	s.assert.Equal("SUCCESS", meta.Status)
+	s.assert.Equal("request-123", meta.RequestID)
+	s.assert.Greater(int64(meta.Latency), int64(0))
+}
+
+// TestResponseMetaOnError ...
+func (s *ResponseMetaTestSuite) TestResponseMetaOnError() {
+	s.status = http.StatusInternalServerError
+	s.body = `{"status":"ERROR","version":"1.0.0","error":{"errorCode":"CBE999","message":"unexpected"}}`
+
+	var meta celcoin.ResponseMeta
+	ctx := celcoin.WithResponseMeta(context.Background(), &meta)
+
+	_, err := celcoin.NewBoletos(http.DefaultClient, s.session).QueryBoleto(ctx, "transaction-123")
+	s.Require().Error(err)
+
+	s.assert.Equal(http.StatusInternalServerError, meta.StatusCode)
+	s.assert.Equal(s.body, string(meta.Body))
+	s.assert.Equal("ERROR", meta.Status)
+	s.assert.NotEmpty(meta.RequestID)
+}
+
+// TestResponseMetaNonJSON ...
+func (s *ResponseMetaTestSuite) TestResponseMetaNonJSON() {
+	s.status = http.StatusBadGateway
+	s.body = "<html>bad gateway</html>"
+
+	var meta celcoin.ResponseMeta
+	ctx := celcoin.WithResponseMeta(context.Background(), &meta)
+
+	_, err := celcoin.NewBalance(http.DefaultClient, s.session).Balance(ctx, "123456")
+	s.Require().Error(err)
+
+	s.assert.Equal(http.StatusBadGateway, meta.StatusCode)
+	s.assert.Equal(s.body, string(meta.Body))
+	s.assert.Empty(meta.Version)
+	s.assert.Empty(meta.Status)
+}
@@ -0,0 +1,256 @@
+package celcoin
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy ... política de novas tentativas do RetryTransport.
+// Métodos seguros (GET, HEAD, OPTIONS) são repetidos por padrão; os demais, como o cash-out Pix e as
+// transferências, só quando o chamador informa uma chave de idempotência (WithIdempotencyKey).
+type RetryPolicy struct {
+	// MaxAttempts ... total de tentativas, incluindo a primeira; 1 desativa as novas tentativas
+	MaxAttempts int
+	// InitialInterval ... espera antes da segunda tentativa
+	InitialInterval time.Duration
+	// MaxInterval ... limite da espera calculada entre tentativas
+	MaxInterval time.Duration
+	// Multiplier ... fator de crescimento exponencial da espera
+	Multiplier float64
+	// Jitter ... fração aleatória (0 a 1) aplicada à espera para evitar rajadas sincronizadas
+	Jitter float64
+	// MaxElapsedTime ... tempo máximo somando todas as tentativas e esperas; zero não limita
+	MaxElapsedTime time.Duration
+	// RetryableStatus ... status HTTP que provocam nova tentativa
+	RetryableStatus []int
+}
+
+// DefaultRetryPolicy ... política usada pelo NewSession quando Config.RetryPolicy não é informado
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:     3,
+		InitialInterval: 200 * time.Millisecond,
+		MaxInterval:     2 * time.Second,
+		Multiplier:      2,
+		Jitter:          0.2,
+		MaxElapsedTime:  15 * time.Second,
+		RetryableStatus: []int{
+			http.StatusRequestTimeout,
+			http.StatusTooManyRequests,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+	}
+}
+
+// NoRetryPolicy ... política que desativa as novas tentativas
+func NoRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{MaxAttempts: 1}
+}
+
+// backoff ... espera antes da próxima tentativa, com crescimento exponencial e jitter
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	multiplier := p.Multiplier
+	if multiplier < 1 {
+		multiplier = 1
+	}
+
+	interval := float64(p.InitialInterval) * math.Pow(multiplier, float64(attempt-1))
+	if p.MaxInterval > 0 && interval > float64(p.MaxInterval) {
+		interval = float64(p.MaxInterval)
+	}
+	if p.Jitter > 0 {
+		interval += interval * p.Jitter * (2*rand.Float64() - 1)
+	}
+	return time.Duration(interval)
+}
+
+// retryableStatus ...
+func (p *RetryPolicy) retryableStatus(status int) bool {
+	for _, s := range p.RetryableStatus {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+type retryPolicyKey struct{}
+
+type idempotencyKey struct{}
+
+// WithRetryPolicy ... substitui a política de novas tentativas apenas para as chamadas feitas com o contexto retornado
+func WithRetryPolicy(ctx context.Context, policy *RetryPolicy) context.Context {
+	return context.WithValue(ctx, retryPolicyKey{}, policy)
+}
+
+// WithoutRetry ... desativa as novas tentativas para as chamadas feitas com o contexto retornado
+func WithoutRetry(ctx context.Context) context.Context {
+	return WithRetryPolicy(ctx, NoRetryPolicy())
+}
+
+// WithIdempotencyKey ... marca a chamada como idempotente, permitindo repetir métodos como POST em falhas
+// transitórias. Use apenas quando a repetição não duplica a operação. Se uma tentativa anterior pode ter sido
+// processada, o erro final da operação é classificado como ErrorClassAmbiguous (ex.: a Celcoin recusa o clientCode
+// repetido) e o status deve ser consultado antes de nova tentativa.
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	if len(key) == 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, idempotencyKey{}, key)
+}
+
+// IdempotencyKeyFrom ... retorna a chave de idempotência do contexto, se houver
+func IdempotencyKeyFrom(ctx context.Context) (string, bool) {
+	key, ok := ctx.Value(idempotencyKey{}).(string)
+	return key, ok && len(key) > 0
+}
+
+// RetryTransport ... RoundTripper que repete requisições com falhas transitórias conforme a RetryPolicy
+type RetryTransport struct {
+	transport http.RoundTripper
+	policy    *RetryPolicy
+	logger    Logger
+}
+
+// NewRetryTransport ...
+func NewRetryTransport(transport http.RoundTripper, policy *RetryPolicy) *RetryTransport {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	if policy == nil {
+		policy = DefaultRetryPolicy()
+	}
+	return &RetryTransport{transport: transport, policy: policy, logger: NewLogrusLogger(nil)}
+}
+
+// RoundTrip ...
+func (t *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	policy := t.policy
+	if override, ok := ctx.Value(retryPolicyKey{}).(*RetryPolicy); ok && override != nil {
+		policy = override
+	}
+
+	if policy.MaxAttempts <= 1 || !retryableRequest(req) {
+		return t.transport.RoundTrip(req)
+	}
+
+	req = req.Clone(ctx)
+	if err := rewindableBody(req); err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	for attempt := 1; ; attempt++ {
+		if attempt > 1 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+
+		resp, err := t.transport.RoundTrip(req)
+		if attempt >= policy.MaxAttempts || !shouldRetry(ctx, policy, resp, err) {
+			return resp, err
+		}
+		if op := operationFrom(ctx); op != nil {
+			op.retrying(req.Method, resp, err)
+		}
+
+		delay := policy.backoff(attempt)
+		if retryAfter, ok := parseRetryAfter(resp); ok && retryAfter > delay {
+			delay = retryAfter
+		}
+		if policy.MaxElapsedTime > 0 && time.Since(start)+delay > policy.MaxElapsedTime {
+			return resp, err
+		}
+
+		fields := Fields{
+			"method":  req.Method,
+			"path":    req.URL.Path,
+			"attempt": attempt,
+			"delay":   delay.String(),
+		}
+		if resp != nil {
+			fields["celcoin_status"] = resp.StatusCode
+			io.Copy(ioutil.Discard, resp.Body)
+			resp.Body.Close()
+		}
+		logger := loggerFrom(ctx, t.logger).WithFields(fields)
+		if err != nil {
+			logger = logger.WithError(err)
+		}
+		logger.Warn("retrying celcoin request")
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// CloseIdleConnections ...
+func (t *RetryTransport) CloseIdleConnections() {
+	if closer, ok := t.transport.(interface{ CloseIdleConnections() }); ok {
+		closer.CloseIdleConnections()
+	}
+}
+
+// retryableRequest ... métodos seguros sempre; os demais apenas com chave de idempotência
+func retryableRequest(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+	_, ok := IdempotencyKeyFrom(req.Context())
+	return ok
+}
+
+// shouldRetry ... erros de rede e status transitórios; falhas de autenticação, limites locais, circuito aberto, cassette sem interação e cancelamentos não são repetidos
+func shouldRetry(ctx context.Context, policy *RetryPolicy, resp *http.Response, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	if err != nil {
+		var transportErr *TransportError
+		return !errors.As(err, &transportErr) && !errors.Is(err, ErrRateLimited) &&
+			!errors.Is(err, ErrCircuitOpen) && !errors.Is(err, ErrCassetteMismatch) &&
+			!errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+	}
+	return policy.retryableStatus(resp.StatusCode)
+}
+
+// parseRetryAfter ... interpreta o cabeçalho Retry-After em segundos ou como data HTTP
+func parseRetryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+	value := resp.Header.Get("Retry-After")
+	if len(value) == 0 {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		delay := time.Until(date)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+	return 0, false
+}
@@ -0,0 +1,208 @@
+package celcoin_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/contbank/celcoin-sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+// RetryTestSuite ...
+type RetryTestSuite struct {
+	suite.Suite
+	assert   *assert.Assertions
+	ctx      context.Context
+	calls    int32
+	failures int32
+	status   int
+	header   http.Header
+	bodies   []string
+	server   *httptest.Server
+	client   *http.Client
+}
+
+// TestRetryTestSuite ...
+func TestRetryTestSuite(t *testing.T) {
+	suite.Run(t, new(RetryTestSuite))
+}
+
+// SetupTest ...
+func (s *RetryTestSuite) SetupTest() {
+	s.assert = assert.New(s.T())
+	s.ctx = context.Background()
+	s.calls = 0
+	s.failures = 2
+	s.status = http.StatusBadGateway
+	s.header = http.Header{}
+	s.bodies = nil
+
+	// Falha as primeiras s.failures chamadas com s.status e depois responde 200
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		call := atomic.AddInt32(&s.calls, 1)
+		body, _ := io.ReadAll(r.Body)
+		s.bodies = append(s.bodies, string(body))
+		if call <= s.failures {
+			for key, values := range s.header {
+				w.Header()[key] = values
+			}
+			w.WriteHeader(s.status)
+			return
+		}
+		w.Write([]byte(`{"status":"SUCCESS","version":"1.0.0","body":{"amount":10}}`))
+	}))
+
+	s.client = &http.Client{Transport: celcoin.NewRetryTransport(http.DefaultTransport, &celcoin.RetryPolicy{
+		MaxAttempts:     3,
+		InitialInterval: time.Millisecond,
+		MaxInterval:     10 * time.Millisecond,
+		Multiplier:      2,
+		Jitter:          0.2,
+		MaxElapsedTime:  5 * time.Second,
+		RetryableStatus: []int{http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusTooManyRequests},
+	})}
+}
+
+// TearDownTest ...
+func (s *RetryTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *RetryTestSuite) do(ctx context.Context, method, body string) int {
+	req, err := http.NewRequestWithContext(ctx, method, s.server.URL+"/api", strings.NewReader(body))
+	s.Require().NoError(err)
+
+	resp, err := s.client.Do(req)
+	s.Require().NoError(err)
+	defer resp.Body.Close()
+	return resp.StatusCode
+}
+
+// TestGetIsRetried ...
+func (s *RetryTestSuite) TestGetIsRetried() {
+	s.assert.Equal(http.StatusOK, s.do(s.ctx, http.MethodGet, ""))
+	s.assert.Equal(int32(3), atomic.LoadInt32(&s.calls))
+}
+
+// TestMaxAttempts ...
+func (s *RetryTestSuite) TestMaxAttempts() {
+	s.failures = 10
+
+	s.assert.Equal(http.StatusBadGateway, s.do(s.ctx, http.MethodGet, ""))
+	s.assert.Equal(int32(3), atomic.LoadInt32(&s.calls))
+}
+
+// TestNonRetryableStatus ...
+func (s *RetryTestSuite) TestNonRetryableStatus() {
+	s.status = http.StatusBadRequest
+
+	s.assert.Equal(http.StatusBadRequest, s.do(s.ctx, http.MethodGet, ""))
+	s.assert.Equal(int32(1), atomic.LoadInt32(&s.calls))
+}
+
+// TestPostWithoutIdempotencyKeyIsNotRetried ...
+func (s *RetryTestSuite) TestPostWithoutIdempotencyKeyIsNotRetried() {
+	s.assert.Equal(http.StatusBadGateway, s.do(s.ctx, http.MethodPost, `{"amount":10}`))
+	s.assert.Equal(int32(1), atomic.LoadInt32(&s.calls))
+}
+
+// TestPostWithIdempotencyKeyIsRetried ...
+func (s *RetryTestSuite) TestPostWithIdempotencyKeyIsRetried() {
+	ctx := celcoin.WithIdempotencyKey(s.ctx, "client-code-1")
+
+	s.assert.Equal(http.StatusOK, s.do(ctx, http.MethodPost, `{"amount":10}`))
+	s.assert.Equal(int32(3), atomic.LoadInt32(&s.calls))
+	s.assert.Equal([]string{`{"amount":10}`, `{"amount":10}`, `{"amount":10}`}, s.bodies)
+}
+
+// TestRetryAfter ...
+func (s *RetryTestSuite) TestRetryAfter() {
+	s.failures = 1
+	s.status = http.StatusTooManyRequests
+	s.header.Set("Retry-After", "1")
+
+	start := time.Now()
+	s.assert.Equal(http.StatusOK, s.do(s.ctx, http.MethodGet, ""))
+	s.assert.GreaterOrEqual(time.Since(start), time.Second)
+	s.assert.Equal(int32(2), atomic.LoadInt32(&s.calls))
+}
+
+// TestMaxElapsedTime ...
+func (s *RetryTestSuite) TestMaxElapsedTime() {
+	s.status = http.StatusServiceUnavailable
+	s.header.Set("Retry-After", "10")
+
+	// A espera pedida pelo servidor ultrapassa o tempo máximo, então a resposta é devolvida sem esperar
+	start := time.Now()
+	s.assert.Equal(http.StatusServiceUnavailable, s.do(s.ctx, http.MethodGet, ""))
+	s.assert.Less(time.Since(start), time.Second)
+	s.assert.Equal(int32(1), atomic.LoadInt32(&s.calls))
+}
+
+// TestContextOverrides ...
+func (s *RetryTestSuite) TestContextOverrides() {
+	s.assert.Equal(http.StatusBadGateway, s.do(celcoin.WithoutRetry(s.ctx), http.MethodGet, ""))
+	s.assert.Equal(int32(1), atomic.LoadInt32(&s.calls))
+
+	s.calls = 0
+	s.failures = 4
+	ctx := celcoin.WithRetryPolicy(s.ctx, &celcoin.RetryPolicy{
+		MaxAttempts:     5,
+		InitialInterval: time.Millisecond,
+		RetryableStatus: []int{http.StatusBadGateway},
+	})
+	s.assert.Equal(http.StatusOK, s.do(ctx, http.MethodGet, ""))
+	s.assert.Equal(int32(5), atomic.LoadInt32(&s.calls))
+}
+
+// TestCanceledContextStopsRetrying ...
+func (s *RetryTestSuite) TestCanceledContextStopsRetrying() {
+	s.failures = 10
+	s.header.Set("Retry-After", "2")
+
+	ctx, cancel := context.WithTimeout(s.ctx, 100*time.Millisecond)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.server.URL+"/api", nil)
+	s.Require().NoError(err)
+
+	_, err = s.client.Do(req)
+	s.assert.ErrorIs(err, context.DeadlineExceeded)
+	s.assert.Equal(int32(1), atomic.LoadInt32(&s.calls))
+}
+
+// TestBalanceThroughNewClient ...
+func (s *RetryTestSuite) TestBalanceThroughNewClient() {
+	s.server.Close()
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, celcoin.LoginPath) {
+			w.Write([]byte(`{"access_token":"token","expires_in":3600}`))
+			return
+		}
+		if atomic.AddInt32(&s.calls, 1) == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Write([]byte(`{"status":"SUCCESS","version":"1.0.0","body":{"amount":10}}`))
+	}))
+
+	client, err := celcoin.NewClient(celcoin.Config{
+		ClientID:      celcoin.String("test-client-id"),
+		ClientSecret:  celcoin.String("test-client-secret"),
+		APIEndpoint:   celcoin.String(s.server.URL),
+		LoginEndpoint: celcoin.String(s.server.URL),
+	})
+	s.Require().NoError(err)
+
+	balance, err := client.Balance.Balance(s.ctx, "123456")
+	s.Require().NoError(err)
+	s.assert.Equal(10.0, balance.Body.Amount)
+	s.assert.Equal(int32(2), atomic.LoadInt32(&s.calls))
+}
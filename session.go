@@ -2,15 +2,16 @@ package celcoin
 
 import (
 	"bytes"
-	"crypto/tls"
-	"crypto/x509"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"os"
 	"path"
+	"strings"
 	"sync"
 	"time"
 
@@ -39,38 +40,83 @@ type Config struct {
 	CompanyKey    *string
 	Certificate   *Certificate
 	Environment   *string
+	TokenStore    TokenStore
+	// CertificateLoader ... quando informado, substitui Certificate e permite recarregar o certificado mTLS
+	CertificateLoader CertificateLoader
+	// CertificateReloadInterval ... intervalo de releitura do CertificateLoader (padrão DefaultCertificateReloadInterval)
+	CertificateReloadInterval *time.Duration
+	// RetryPolicy ... política de novas tentativas dos clientes HTTP da sessão (padrão DefaultRetryPolicy)
+	RetryPolicy *RetryPolicy
+	// RateLimitPolicy ... limites por família de endpoints; nil não limita
+	RateLimitPolicy *RateLimitPolicy
+	// CircuitBreakerPolicy ... circuit breaker por família de endpoints; nil desativa
+	CircuitBreakerPolicy *CircuitBreakerPolicy
+	// LogPolicy ... mascaramento, nível, tamanho e amostragem dos logs HTTP (padrão DefaultLogPolicy)
+	LogPolicy *LogPolicy
+	// Logger ... destino dos logs do SDK (padrão NewLogrusLogger(nil)); NewNopLogger silencia o SDK
+	Logger Logger
+	// Observer ... métricas e tracing das operações e chamadas HTTP (ex.: NewMetricsCollector, NewTracingObserver)
+	Observer Observer
+	// CredentialResolver ... credenciais por tenant (WithTenant); quando informado, ClientID, ClientSecret e Certificate são opcionais
+	CredentialResolver CredentialResolver
+	// CredentialsProvider ... fonte de ClientID, ClientSecret e Certificate, relida periodicamente; preenche os campos não informados
+	CredentialsProvider CredentialsProvider
+	// CredentialsRefreshInterval ... intervalo de releitura do CredentialsProvider (padrão DefaultCredentialsRefreshInterval)
+	CredentialsRefreshInterval *time.Duration
+	// Cassette ... grava ou reproduz as chamadas HTTP (inclusive as de token) para testes determinísticos
+	Cassette *Cassette
+	// WrapErrors ... retorna os erros das operações como *CelcoinAPIError em vez do *grok.Error mapeado.
+	// Desativado por padrão porque o grok.ResolveError exige o tipo *grok.Error; sem ele, os detalhes do erro
+	// ficam em ResponseMeta.Error (WithResponseMeta).
+	WrapErrors *bool
 }
 
 // Session ...
 type Session struct {
-	LoginEndpoint string
-	APIEndpoint   string
-	ClientID      string
-	ClientSecret  string
-	APIVersion    string
-	Cache         cache.Cache
-	Scopes        string
-	Mtls          bool
-	Environment   string
-}
-
-// oauthTransport ... é um transporte customizado que adiciona o token e o renova quando necessário
-type oauthTransport struct {
-	underlyingTransport http.RoundTripper
-	session             *Session
-	token               string
-	tokenExpiration     time.Time
-	mutex               *sync.Mutex
-}
-
-// NewSession ...
+	LoginEndpoint        string
+	APIEndpoint          string
+	ClientID             string
+	ClientSecret         string
+	APIVersion           string
+	Cache                cache.Cache
+	Scopes               string
+	Mtls                 bool
+	Environment          string
+	TokenStore           TokenStore
+	RetryPolicy          *RetryPolicy
+	RateLimitPolicy      *RateLimitPolicy
+	CircuitBreakerPolicy *CircuitBreakerPolicy
+	LogPolicy            *LogPolicy
+	Logger               Logger
+	Observer             Observer
+	CredentialResolver   CredentialResolver
+	Cassette             *Cassette
+	WrapErrors           bool
+	// Credentials ... quando informado, substitui ClientID e ClientSecret e renova o token quando as credenciais rotacionam
+	Credentials *RefreshingCredentials
+	// certificateLoader ... CertificateLoader do Config ou, quando ausente, o derivado do CredentialsProvider
+	certificateLoader CertificateLoader
+}
+
+// NewSession ... aplica os padrões do Config e valida credenciais, URLs e certificado.
+// Environment (CelcoinEnvSandbox por padrão) define as URLs base quando APIEndpoint e LoginEndpoint não são informados.
 func NewSession(config Config) (*Session, error) {
+	if config.Environment == nil {
+		config.Environment = String(CelcoinEnvSandbox)
+	}
+
+	endpoints, err := EndpointsForEnvironment(*config.Environment)
+	if err != nil {
+		return nil, err
+	}
+	config.Environment = String(normalizeEnvironment(*config.Environment))
+
 	if config.APIEndpoint == nil {
-		config.APIEndpoint = String(ApiEndpoint)
+		config.APIEndpoint = String(endpoints.APIEndpoint)
 	}
 
 	if config.LoginEndpoint == nil {
-		config.LoginEndpoint = String(LoginEndpoint)
+		config.LoginEndpoint = String(endpoints.LoginEndpoint)
 	}
 
 	if config.APIVersion == nil {
@@ -82,7 +128,7 @@ func NewSession(config Config) (*Session, error) {
 	}
 
 	if config.ClientSecret == nil {
-		config.ClientID = String(os.Getenv("CELCOIN_CLIENT_SECRET"))
+		config.ClientSecret = String(os.Getenv("CELCOIN_CLIENT_SECRET"))
 	}
 
 	if config.Cache == nil {
@@ -93,120 +139,303 @@ func NewSession(config Config) (*Session, error) {
 		config.Scopes = String("")
 	}
 
-	/*if config.Mtls != nil {
-		if *config.Mtls {
-			config.ClientID = &config.Certificate.ClientID
+	if config.Mtls == nil {
+		config.Mtls = Bool(false)
+	}
+
+	if config.TokenStore == nil {
+		config.TokenStore = NewMemoryTokenStore(config.Cache)
+	}
+
+	if config.RetryPolicy == nil {
+		config.RetryPolicy = DefaultRetryPolicy()
+	}
+
+	if config.LogPolicy == nil {
+		config.LogPolicy = DefaultLogPolicy()
+	}
+
+	if config.Logger == nil {
+		config.Logger = NewLogrusLogger(nil)
+	}
+
+	if config.WrapErrors == nil {
+		config.WrapErrors = Bool(false)
+	}
+
+	var credentials *RefreshingCredentials
+	if config.CredentialsProvider != nil {
+		if credentials, err = newConfigCredentials(&config); err != nil {
+			return nil, err
 		}
-	}*/
+	}
 
-	if config.Environment == nil {
-		config.Environment = String(CelcoinEnvSandbox)
+	if err := validateConfig(config); err != nil {
+		if credentials != nil {
+			credentials.Stop()
+		}
+		return nil, err
 	}
 
 	var session = &Session{
-		LoginEndpoint: *config.LoginEndpoint,
-		APIEndpoint:   *config.APIEndpoint,
-		ClientID:      *config.ClientID,
-		ClientSecret:  *config.ClientSecret,
-		APIVersion:    *config.APIVersion,
-		Cache:         *config.Cache,
-		Scopes:        *config.Scopes,
-		Mtls:          *config.Mtls,
-		Environment:   *config.Environment,
+		LoginEndpoint:        *config.LoginEndpoint,
+		APIEndpoint:          *config.APIEndpoint,
+		ClientID:             *config.ClientID,
+		ClientSecret:         *config.ClientSecret,
+		APIVersion:           *config.APIVersion,
+		Cache:                *config.Cache,
+		Scopes:               *config.Scopes,
+		Mtls:                 *config.Mtls,
+		Environment:          *config.Environment,
+		TokenStore:           config.TokenStore,
+		RetryPolicy:          config.RetryPolicy,
+		RateLimitPolicy:      config.RateLimitPolicy,
+		CircuitBreakerPolicy: config.CircuitBreakerPolicy,
+		LogPolicy:            config.LogPolicy,
+		Logger:               config.Logger,
+		Observer:             config.Observer,
+		CredentialResolver:   config.CredentialResolver,
+		Cassette:             config.Cassette,
+		WrapErrors:           *config.WrapErrors,
+		Credentials:          credentials,
+		certificateLoader:    config.CertificateLoader,
 	}
 
 	return session, nil
 }
 
-// CreateMtlsHTTPClient ...
-func CreateMtlsHTTPClient(cert *Certificate, session *Session) *http.Client {
-	// Carregar o certificado e a chave privada
-	tlsCert, err := tls.X509KeyPair([]byte(cert.Certificate), []byte(cert.PrivateKey))
+// newConfigCredentials ... lê o CredentialsProvider e preenche ClientID, ClientSecret e o certificado não informados no Config
+func newConfigCredentials(config *Config) (*RefreshingCredentials, error) {
+	interval := DefaultCredentialsRefreshInterval
+	if config.CredentialsRefreshInterval != nil {
+		interval = *config.CredentialsRefreshInterval
+	}
+
+	credentials, err := NewRefreshingCredentials(context.Background(), config.CredentialsProvider, interval)
 	if err != nil {
-		panic("Erro ao carregar o certificado e a chave privada")
+		return nil, &ConfigError{Field: "CredentialsProvider", Err: ErrInvalidConfigValue, Detail: err.Error()}
 	}
+	credentials.logger = config.Logger.WithField("service", "credentials")
 
-	// Criar um pool de certificados confiáveis (opcional, para validar o servidor)
-	caCertPool := x509.NewCertPool()
-	if !caCertPool.AppendCertsFromPEM([]byte(cert.Certificate)) {
-		panic("Erro ao adicionar o certificado ao pool de CA")
+	current := credentials.Credentials()
+	if config.ClientID == nil || len(*config.ClientID) == 0 {
+		config.ClientID = String(current.ClientID)
+	}
+	if config.ClientSecret == nil || len(*config.ClientSecret) == 0 {
+		config.ClientSecret = String(current.ClientSecret)
 	}
+	if current.Certificate != nil && config.Certificate == nil && config.CertificateLoader == nil {
+		config.CertificateLoader = credentials.CertificateLoader()
+	}
+	return credentials, nil
+}
 
-	mtlsTransport := &http.Transport{
-		TLSClientConfig: &tls.Config{
-			RootCAs:            caCertPool,
-			Certificates:       []tls.Certificate{tlsCert},
-			InsecureSkipVerify: true,
-		},
+// clientCredentials ... ClientID e ClientSecret atuais, das Credentials quando configuradas
+func (s *Session) clientCredentials() (string, string) {
+	if s.Credentials != nil {
+		current := s.Credentials.Credentials()
+		return current.ClientID, current.ClientSecret
 	}
+	return s.ClientID, s.ClientSecret
+}
 
-	// Obter o token inicial usando o transporte mTLS
-	token, expiration, err := fetchAccessToken(&http.Client{Transport: mtlsTransport}, session)
-	if err != nil {
-		panic(fmt.Sprintf("Erro ao obter token de acesso: %v", err))
+// validateConfig ... valida o Config já com os padrões aplicados
+func validateConfig(config Config) error {
+	// Com CredentialResolver, credenciais e certificado são informados por tenant
+	multiTenant := config.CredentialResolver != nil
+	if !multiTenant && len(strings.TrimSpace(*config.ClientID)) == 0 {
+		return &ConfigError{Field: "ClientID", Err: ErrMissingClientID, Detail: "set Config.ClientID or CELCOIN_CLIENT_ID"}
+	}
+	// No mTLS o login é autenticado pelo certificado e não envia o ClientSecret
+	if !multiTenant && !*config.Mtls && len(strings.TrimSpace(*config.ClientSecret)) == 0 {
+		return &ConfigError{Field: "ClientSecret", Err: ErrMissingClientSecret, Detail: "set Config.ClientSecret or CELCOIN_CLIENT_SECRET"}
+	}
+	if err := validateEndpoint("APIEndpoint", *config.APIEndpoint); err != nil {
+		return err
+	}
+	if err := validateEndpoint("LoginEndpoint", *config.LoginEndpoint); err != nil {
+		return err
 	}
+	if !multiTenant && *config.Mtls && config.Certificate == nil && config.CertificateLoader == nil {
+		return &ConfigError{Field: "Certificate", Err: ErrMissingCertificate, Detail: "set Config.Certificate or Config.CertificateLoader"}
+	}
+	return nil
+}
+
+// TransportStep ... identifica a etapa de configuração do transporte ou de obtenção do token que falhou
+type TransportStep string
 
-	// Configura o transporte com renovação automática de token
-	httpClient := &http.Client{
-		Timeout: 30 * time.Second,
-		Transport: &oauthTransport{
-			underlyingTransport: mtlsTransport,
-			session:             session,
-			token:               token,
-			tokenExpiration:     expiration,
-			mutex:               &sync.Mutex{},
-		},
+const (
+	// TransportStepSession ...
+	TransportStepSession TransportStep = "SESSION"
+	// TransportStepResolveCredentials ...
+	TransportStepResolveCredentials TransportStep = "RESOLVE_CREDENTIALS"
+	// TransportStepLoadCertificate ...
+	TransportStepLoadCertificate TransportStep = "LOAD_CERTIFICATE"
+	// TransportStepLoadCertificateChain ...
+	TransportStepLoadCertificateChain TransportStep = "LOAD_CERTIFICATE_CHAIN"
+	// TransportStepLoadPrivateKey ...
+	TransportStepLoadPrivateKey TransportStep = "LOAD_PRIVATE_KEY"
+	// TransportStepLoadPKCS12 ...
+	TransportStepLoadPKCS12 TransportStep = "LOAD_PKCS12"
+	// TransportStepLoadRootCA ...
+	TransportStepLoadRootCA TransportStep = "LOAD_ROOT_CA"
+	// TransportStepKeyPair ...
+	TransportStepKeyPair TransportStep = "KEY_PAIR"
+	// TransportStepBuildTokenRequest ...
+	TransportStepBuildTokenRequest TransportStep = "BUILD_TOKEN_REQUEST"
+	// TransportStepRequestToken ...
+	TransportStepRequestToken TransportStep = "REQUEST_TOKEN"
+	// TransportStepTokenRejected ...
+	TransportStepTokenRejected TransportStep = "TOKEN_REJECTED"
+	// TransportStepDecodeToken ...
+	TransportStepDecodeToken TransportStep = "DECODE_TOKEN"
+)
+
+// TransportError ... erro tipado retornado pelos construtores de cliente HTTP e pela obtenção do token
+type TransportError struct {
+	Step       TransportStep
+	StatusCode int
+	// RequestID ... request ID da chamada que falhou, quando houver
+	RequestID string
+	Err       error
+}
+
+// Error ...
+func (e *TransportError) Error() string {
+	if e.StatusCode > 0 {
+		return fmt.Sprintf("celcoin transport %s (status %d): %v", e.Step, e.StatusCode, e.Err)
 	}
-	return httpClient
+	return fmt.Sprintf("celcoin transport %s: %v", e.Step, e.Err)
+}
+
+// Unwrap ...
+func (e *TransportError) Unwrap() error {
+	return e.Err
 }
 
-// CreateOAuth2HTTPClient ... cria um cliente HTTP autenticado via OAuth2 com renovação automática de token
-func CreateOAuth2HTTPClient(session *Session) *http.Client {
-	httpClient := &http.Client{}
-	httpClient.Timeout = 30 * time.Second
+func newTransportError(step TransportStep, err error) *TransportError {
+	return &TransportError{Step: step, Err: err}
+}
+
+// CreateMtlsHTTPClient ... cria um cliente HTTP mTLS com renovação automática de token.
+// O certificado é validado na criação; o primeiro token só é obtido na primeira requisição.
+// O servidor é verificado pelo pool do sistema ou pela CA fixada em Certificate.RootCA.
+func CreateMtlsHTTPClient(cert *Certificate, session *Session) (*http.Client, error) {
+	if err := validateSession(session); err != nil {
+		return nil, err
+	}
 
-	// Obter token inicial
-	token, expiration, err := fetchAccessToken(httpClient, session)
+	reloader, err := NewCertificateReloader(StaticCertificateLoader(cert), 0)
 	if err != nil {
-		panic(fmt.Sprintf("Erro ao obter token de acesso: %v", err))
+		return nil, err
+	}
+	return CreateReloadableMtlsHTTPClient(reloader, session)
+}
+
+// CreateReloadableMtlsHTTPClient ... cria um cliente HTTP mTLS cujo certificado é fornecido pelo CertificateReloader,
+// permitindo rotacionar o certificado sem recriar o cliente
+func CreateReloadableMtlsHTTPClient(reloader *CertificateReloader, session *Session) (*http.Client, error) {
+	if err := validateSession(session); err != nil {
+		return nil, err
+	}
+	if reloader == nil {
+		return nil, newTransportError(TransportStepLoadCertificate, errors.New("certificate reloader is required"))
+	}
+
+	return newAuthenticatedHTTPClient(session, newMtlsTransport(reloader)), nil
+}
+
+// CreateOAuth2HTTPClient ... cria um cliente HTTP autenticado via OAuth2 com renovação automática de token.
+// O primeiro token só é obtido na primeira requisição.
+func CreateOAuth2HTTPClient(session *Session) (*http.Client, error) {
+	if err := validateSession(session); err != nil {
+		return nil, err
 	}
 
-	// Configurar transporte com renovação automática do token
-	httpClient.Transport = &oauthTransport{
-		underlyingTransport: http.DefaultTransport,
+	return newAuthenticatedHTTPClient(session, http.DefaultTransport), nil
+}
+
+// newAuthenticatedHTTPClient ... monta a cadeia de transportes da sessão:
+// correlação -> novas tentativas -> OAuth -> observer -> circuit breaker -> limite de requisições -> transporte base.
+// O timeout do cliente vale para a chamada completa, incluindo as novas tentativas.
+func newAuthenticatedHTTPClient(session *Session, base http.RoundTripper) *http.Client {
+	// O cassette substitui a rede, abaixo de todas as políticas
+	if session.Cassette != nil {
+		base = session.Cassette.Transport(base)
+	}
+	// O limite fica abaixo do OAuth para valer também para as chamadas de token
+	if session.RateLimitPolicy != nil {
+		base = NewRateLimitTransport(base, *session.RateLimitPolicy)
+	}
+	if session.CircuitBreakerPolicy != nil {
+		base = NewCircuitBreakerTransport(base, *session.CircuitBreakerPolicy)
+	}
+	// Abaixo das novas tentativas, cada tentativa é observada separadamente
+	if session.Observer != nil {
+		base = NewObserverTransport(base, session.Observer)
+	}
+
+	oauth := &oauthTransport{
+		underlyingTransport: base,
 		session:             session,
-		token:               token,
-		tokenExpiration:     expiration,
 		mutex:               &sync.Mutex{},
 	}
+	if session.Credentials != nil {
+		session.Credentials.OnRotate(oauth.rotate)
+	}
+
+	var transport http.RoundTripper = oauth
+	if session.RetryPolicy != nil {
+		retry := NewRetryTransport(transport, session.RetryPolicy)
+		retry.logger = session.baseLogger()
+		transport = retry
+	}
 
-	return httpClient
+	return &http.Client{
+		Timeout:   30 * time.Second,
+		Transport: &correlationTransport{transport: transport},
+	}
 }
 
-func fetchAccessToken(client *http.Client, session *Session) (string, time.Time, error) {
+// validateSession ... garante que a sessão possui o mínimo necessário para obter tokens
+func validateSession(session *Session) error {
+	if session == nil {
+		return newTransportError(TransportStepSession, errors.New("session is required"))
+	}
+	if _, err := url.ParseRequestURI(session.LoginEndpoint); err != nil {
+		return newTransportError(TransportStepSession, fmt.Errorf("invalid login endpoint: %w", err))
+	}
+	return nil
+}
+
+func fetchAccessToken(ctx context.Context, client *http.Client, session *Session) (string, time.Time, error) {
 	var data []byte
+	clientID, clientSecret := session.clientCredentials()
 
 	if session.Mtls {
-		mtlsData := fmt.Sprintf("client_id=%s&client_secret=%s&grant_type=client_credentials",
-			session.ClientID, session.ClientSecret)
-		data = []byte(mtlsData)
+		form := url.Values{"client_id": {clientID}, "grant_type": {"client_credentials"}}
+		if len(clientSecret) > 0 {
+			form.Set("client_secret", clientSecret)
+		}
+		data = []byte(form.Encode())
 	} else {
 		oauth2Data := fmt.Sprintf("client_id=%s&client_secret=%s&grant_type=client_credentials",
-			session.ClientID, session.ClientSecret)
+			clientID, clientSecret)
 		data = []byte(oauth2Data)
 	}
 
 	url, err := url.Parse(session.LoginEndpoint)
 	if err != nil {
-		return "", time.Time{}, err
+		return "", time.Time{}, newTransportError(TransportStepBuildTokenRequest, err)
 	}
 
 	url.Path = path.Join(url.Path, LoginPath)
 	endpoint := url.String()
 
-	req, err := http.NewRequest("POST", endpoint, bytes.NewBuffer(data))
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewBuffer(data))
 	if err != nil {
-		return "", time.Time{}, fmt.Errorf("erro ao criar a requisição de token: %w", err)
+		return "", time.Time{}, newTransportError(TransportStepBuildTokenRequest, err)
 	}
 
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
@@ -214,40 +443,25 @@ func fetchAccessToken(client *http.Client, session *Session) (string, time.Time,
 
 	resp, err := client.Do(req)
 	if err != nil {
-		return "", time.Time{}, fmt.Errorf("erro ao fazer a requisição de token: %w", err)
+		return "", time.Time{}, newTransportError(TransportStepRequestToken, err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
 		body, _ := ioutil.ReadAll(resp.Body)
-		return "", time.Time{}, fmt.Errorf("falha ao obter token: %s", body)
+		return "", time.Time{}, &TransportError{
+			Step:       TransportStepTokenRejected,
+			StatusCode: resp.StatusCode,
+			Err:        fmt.Errorf("falha ao obter token: %s", body),
+		}
 	}
 
 	var tokenResponse AuthenticationResponse
 	if err := json.NewDecoder(resp.Body).Decode(&tokenResponse); err != nil {
-		return "", time.Time{}, fmt.Errorf("erro ao decodificar a resposta do token: %w", err)
+		return "", time.Time{}, newTransportError(TransportStepDecodeToken, err)
 	}
 
 	// Calcula a hora de expiração com base no tempo atual e no tempo de expiração do token
 	expiration := time.Now().Add(time.Duration(tokenResponse.ExpiresIn) * time.Second)
 	return tokenResponse.AccessToken, expiration, nil
 }
-
-// RoundTrip ... adiciona o cabeçalho Authorization e renova o token se necessário
-func (t *oauthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
-	t.mutex.Lock()
-	defer t.mutex.Unlock()
-
-	if time.Now().After(t.tokenExpiration.Add(-1 * time.Minute)) { // Renova o token antes de expirar
-		client := &http.Client{Transport: t.underlyingTransport}
-		newToken, newExpiration, err := fetchAccessToken(client, t.session)
-		if err != nil {
-			return nil, fmt.Errorf("falha ao renovar o token: %w", err)
-		}
-		t.token = newToken
-		t.tokenExpiration = newExpiration
-	}
-
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", t.token))
-	return t.underlyingTransport.RoundTrip(req)
-}
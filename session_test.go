@@ -0,0 +1,310 @@
+package celcoin_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/contbank/celcoin-sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+// SessionTestSuite ...
+type SessionTestSuite struct {
+	suite.Suite
+	assert *assert.Assertions
+	ctx    context.Context
+}
+
+// TestSessionTestSuite ...
+func TestSessionTestSuite(t *testing.T) {
+	suite.Run(t, new(SessionTestSuite))
+}
+
+// SetupTest ...
+func (s *SessionTestSuite) SetupTest() {
+	s.assert = assert.New(s.T())
+	s.ctx = context.Background()
+}
+
+func (s *SessionTestSuite) newSession(endpoint string) *celcoin.Session {
+	session, err := celcoin.NewSession(celcoin.Config{
+		ClientID:      celcoin.String("test-client-id"),
+		ClientSecret:  celcoin.String("test-client-secret"),
+		Mtls:          celcoin.Bool(false),
+		APIEndpoint:   celcoin.String(endpoint),
+		LoginEndpoint: celcoin.String(endpoint),
+	})
+	s.Require().NoError(err)
+	return session
+}
+
+// TestCreateOAuth2HTTPClientIsLazy ...
+func (s *SessionTestSuite) TestCreateOAuth2HTTPClientIsLazy() {
+	var tokenCalls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, celcoin.LoginPath) {
+			atomic.AddInt32(&tokenCalls, 1)
+			w.Write([]byte(`{"access_token":"lazy-token","expires_in":3600}`))
+			return
+		}
+		w.Write([]byte(r.Header.Get("Authorization")))
+	}))
+	defer server.Close()
+
+	client, err := celcoin.CreateOAuth2HTTPClient(s.newSession(server.URL))
+	s.assert.NoError(err)
+	s.assert.Equal(int32(0), atomic.LoadInt32(&tokenCalls))
+
+	resp, err := client.Get(server.URL + "/any")
+	s.Require().NoError(err)
+	defer resp.Body.Close()
+
+	s.assert.Equal(int32(1), atomic.LoadInt32(&tokenCalls))
+}
+
+// TestCreateOAuth2HTTPClientLoginDown ...
+func (s *SessionTestSuite) TestCreateOAuth2HTTPClientLoginDown() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"error":"unavailable"}`, http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client, err := celcoin.CreateOAuth2HTTPClient(s.newSession(server.URL))
+	s.Require().NoError(err)
+
+	_, err = client.Get(server.URL + "/any")
+	s.Require().Error(err)
+
+	var transportErr *celcoin.TransportError
+	s.Require().True(errors.As(err, &transportErr))
+	s.assert.Equal(celcoin.TransportStepTokenRejected, transportErr.Step)
+	s.assert.Equal(http.StatusServiceUnavailable, transportErr.StatusCode)
+}
+
+// TestCreateOAuth2HTTPClientInvalidSession ...
+func (s *SessionTestSuite) TestCreateOAuth2HTTPClientInvalidSession() {
+	client, err := celcoin.CreateOAuth2HTTPClient(nil)
+	s.assert.Nil(client)
+
+	var transportErr *celcoin.TransportError
+	s.Require().True(errors.As(err, &transportErr))
+	s.assert.Equal(celcoin.TransportStepSession, transportErr.Step)
+}
+
+// TestCreateMtlsHTTPClientInvalidCertificate ...
+func (s *SessionTestSuite) TestCreateMtlsHTTPClientInvalidCertificate() {
+	certPEM, keyPEM := generateTestCertificate(s.T())
+
+	tests := []struct {
+		name string
+		cert *celcoin.Certificate
+		step celcoin.TransportStep
+	}{
+		{"nil certificate", nil, celcoin.TransportStepLoadCertificate},
+		{"invalid certificate", &celcoin.Certificate{Certificate: "invalid", PrivateKey: keyPEM}, celcoin.TransportStepLoadCertificate},
+		{"invalid private key", &celcoin.Certificate{Certificate: certPEM, PrivateKey: "invalid"}, celcoin.TransportStepLoadPrivateKey},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			client, err := celcoin.CreateMtlsHTTPClient(tt.cert, s.newSession("https://localhost"))
+			s.assert.Nil(client)
+
+			var transportErr *celcoin.TransportError
+			s.Require().True(errors.As(err, &transportErr))
+			s.assert.Equal(tt.step, transportErr.Step)
+		})
+	}
+}
+
+// TestCreateMtlsHTTPClient ...
+func (s *SessionTestSuite) TestCreateMtlsHTTPClient() {
+	certPEM, keyPEM := generateTestCertificate(s.T())
+
+	client, err := celcoin.CreateMtlsHTTPClient(&celcoin.Certificate{
+		Certificate: certPEM,
+		PrivateKey:  keyPEM,
+	}, s.newSession("https://localhost"))
+
+	s.assert.NoError(err)
+	s.assert.NotNil(client)
+}
+
+// TestNewSessionEnvironmentPresets ...
+func (s *SessionTestSuite) TestNewSessionEnvironmentPresets() {
+	tests := []struct {
+		environment *string
+		api         string
+		login       string
+	}{
+		{nil, celcoin.ApiEndpoint, celcoin.LoginEndpoint},
+		{celcoin.String(celcoin.CelcoinEnvSandbox), celcoin.ApiEndpoint, celcoin.LoginEndpoint},
+		{celcoin.String(celcoin.CelcoinEnvProd), celcoin.ProductionApiEndpoint, celcoin.ProductionLoginEndpoint},
+		{celcoin.String("production"), celcoin.ProductionApiEndpoint, celcoin.ProductionLoginEndpoint},
+	}
+
+	for _, tt := range tests {
+		session, err := celcoin.NewSession(celcoin.Config{
+			ClientID:     celcoin.String("test-client-id"),
+			ClientSecret: celcoin.String("test-client-secret"),
+			Environment:  tt.environment,
+		})
+		s.Require().NoError(err)
+		s.assert.Equal(tt.api, session.APIEndpoint)
+		s.assert.Equal(tt.login, session.LoginEndpoint)
+		s.assert.False(session.Mtls)
+	}
+
+	// URLs explícitas têm precedência sobre o preset do ambiente
+	session, err := celcoin.NewSession(celcoin.Config{
+		ClientID:     celcoin.String("test-client-id"),
+		ClientSecret: celcoin.String("test-client-secret"),
+		Environment:  celcoin.String(celcoin.CelcoinEnvProd),
+		APIEndpoint:  celcoin.String("https://api.example.com"),
+	})
+	s.Require().NoError(err)
+	s.assert.Equal("https://api.example.com", session.APIEndpoint)
+	s.assert.Equal(celcoin.ProductionLoginEndpoint, session.LoginEndpoint)
+}
+
+// TestNewSessionCredentialsFromEnv ...
+func (s *SessionTestSuite) TestNewSessionCredentialsFromEnv() {
+	s.T().Setenv("CELCOIN_CLIENT_ID", "env-client-id")
+	s.T().Setenv("CELCOIN_CLIENT_SECRET", "env-client-secret")
+
+	session, err := celcoin.NewSession(celcoin.Config{})
+	s.Require().NoError(err)
+	s.assert.Equal("env-client-id", session.ClientID)
+	s.assert.Equal("env-client-secret", session.ClientSecret)
+}
+
+// TestNewSessionValidation ...
+func (s *SessionTestSuite) TestNewSessionValidation() {
+	s.T().Setenv("CELCOIN_CLIENT_ID", "")
+	s.T().Setenv("CELCOIN_CLIENT_SECRET", "")
+
+	valid := func() celcoin.Config {
+		return celcoin.Config{
+			ClientID:     celcoin.String("test-client-id"),
+			ClientSecret: celcoin.String("test-client-secret"),
+		}
+	}
+
+	tests := []struct {
+		name   string
+		config func() celcoin.Config
+		field  string
+		err    error
+	}{
+		{"missing client id", func() celcoin.Config { c := valid(); c.ClientID = nil; return c }, "ClientID", celcoin.ErrMissingClientID},
+		{"missing client secret", func() celcoin.Config { c := valid(); c.ClientSecret = celcoin.String(" "); return c }, "ClientSecret", celcoin.ErrMissingClientSecret},
+		{"invalid environment", func() celcoin.Config { c := valid(); c.Environment = celcoin.String("STAGING"); return c }, "Environment", celcoin.ErrInvalidEnvironment},
+		{"relative api endpoint", func() celcoin.Config { c := valid(); c.APIEndpoint = celcoin.String("/api"); return c }, "APIEndpoint", celcoin.ErrInvalidEndpoint},
+		{"malformed login endpoint", func() celcoin.Config { c := valid(); c.LoginEndpoint = celcoin.String("https://%zz"); return c }, "LoginEndpoint", celcoin.ErrInvalidEndpoint},
+		{"mtls without certificate", func() celcoin.Config { c := valid(); c.Mtls = celcoin.Bool(true); return c }, "Certificate", celcoin.ErrMissingCertificate},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			session, err := celcoin.NewSession(tt.config())
+			s.assert.Nil(session)
+			s.assert.ErrorIs(err, tt.err)
+
+			var configErr *celcoin.ConfigError
+			s.Require().True(errors.As(err, &configErr))
+			s.assert.Equal(tt.field, configErr.Field)
+		})
+	}
+}
+
+// TestNewSessionMtlsWithoutClientSecret ...
+func (s *SessionTestSuite) TestNewSessionMtlsWithoutClientSecret() {
+	s.T().Setenv("CELCOIN_CLIENT_SECRET", "")
+	certPEM, keyPEM := generateTestCertificate(s.T())
+
+	session, err := celcoin.NewSession(celcoin.Config{
+		ClientID:    celcoin.String("test-client-id"),
+		Mtls:        celcoin.Bool(true),
+		Certificate: &celcoin.Certificate{Certificate: certPEM, PrivateKey: keyPEM},
+	})
+	s.Require().NoError(err)
+	s.assert.Empty(session.ClientSecret)
+}
+
+// TestLoadConfigFromEnv ...
+func (s *SessionTestSuite) TestLoadConfigFromEnv() {
+	certPEM, keyPEM := generateTestCertificate(s.T())
+
+	s.T().Setenv("CELCOIN_ENVIRONMENT", "PRODUCTION")
+	s.T().Setenv("CELCOIN_CLIENT_ID", "env-client-id")
+	s.T().Setenv("CELCOIN_CLIENT_SECRET", "env-client-secret")
+	s.T().Setenv("CELCOIN_API_VERSION", "2.0")
+	s.T().Setenv("CELCOIN_MTLS", "true")
+	s.T().Setenv("CELCOIN_CERTIFICATE", certPEM)
+	s.T().Setenv("CELCOIN_PRIVATE_KEY", keyPEM)
+
+	config, err := celcoin.LoadConfigFromEnv()
+	s.Require().NoError(err)
+	s.assert.Nil(config.APIEndpoint)
+	s.assert.Nil(config.CertificateLoader)
+	s.Require().NotNil(config.Certificate)
+	s.assert.Equal(certPEM, config.Certificate.Certificate)
+
+	session, err := celcoin.NewSession(config)
+	s.Require().NoError(err)
+	s.assert.Equal(celcoin.CelcoinEnvProd, session.Environment)
+	s.assert.Equal(celcoin.ProductionApiEndpoint, session.APIEndpoint)
+	s.assert.Equal("env-client-id", session.ClientID)
+	s.assert.Equal("env-client-secret", session.ClientSecret)
+	s.assert.Equal("2.0", session.APIVersion)
+	s.assert.True(session.Mtls)
+
+	s.T().Setenv("CELCOIN_MTLS", "maybe")
+	_, err = celcoin.LoadConfigFromEnv()
+	s.assert.ErrorIs(err, celcoin.ErrInvalidConfigValue)
+}
+
+// generateTestCertificate ... gera um certificado autoassinado em PEM para os testes
+func generateTestCertificate(t *testing.T) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "celcoin-sdk-test"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	return string(certPEM), string(keyPEM)
+}
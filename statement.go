@@ -9,32 +9,39 @@ import (
 	"net/url"
 	"path"
 	"strconv"
-
-	"github.com/sirupsen/logrus"
 )
 
+// StatementInterface define a interface para o extrato da conta.
+type StatementInterface interface {
+	GetStatements(ctx context.Context, request *StatementRequest) (*StatementResponse, error)
+}
+
 // StatementService ... implementa a interface para o extrato da conta.
 type Statement struct {
 	session    Session
 	httpClient *LoggingHTTPClient
 }
 
+var _ StatementInterface = (*Statement)(nil)
+
 // NewStatement ... cria uma nova instância de StatementService.
-func NewStatement(httpClient *http.Client, session Session) *Statement {
+func NewStatement(httpClient *http.Client, session Session) StatementInterface {
 	return &Statement{
 		session:    session,
-		httpClient: NewLoggingHTTPClient(httpClient),
+		httpClient: newSessionLoggingHTTPClient(httpClient, session),
 	}
 }
 
 // GetStatements ... realiza a requisição para obter os movimentos da carteira.
 func (s *Statement) GetStatements(ctx context.Context,
-	request *StatementRequest) (*StatementResponse, error) {
+	request *StatementRequest) (_ *StatementResponse, err error) {
+	ctx, op := s.session.startOperation(ctx, "statement", "GetStatements", StatementPath)
+	defer op.end(&err)
+	logger := op.logger
 
-	requestID, _ := ctx.Value("Request-Id").(string)
-	fields := logrus.Fields{
+	requestID := RequestIDFrom(ctx)
+	fields := Fields{
 		"request_id": requestID,
-		"service":    "statement",
 		"interface":  "GetStatements",
 	}
 
@@ -42,7 +49,7 @@ func (s *Statement) GetStatements(ctx context.Context,
 
 	url, err := url.Parse(baseURL)
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error parsing base url")
 		return nil, err
 	}
@@ -74,12 +81,12 @@ func (s *Statement) GetStatements(ctx context.Context,
 		url.RawQuery = q.Encode()
 	}
 
-	logrus.WithFields(fields).WithField("celcoin_endpoint", url.String()).WithField("request_body", request).
+	logger.WithFields(fields).WithField("celcoin_endpoint", url.String()).WithField("request_body", request).
 		Info("celcoin statement request")
 
-	req, err := http.NewRequest("GET", url.String(), nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", url.String(), nil)
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error creating request")
 		return nil, err
 	}
@@ -88,7 +95,7 @@ func (s *Statement) GetStatements(ctx context.Context,
 
 	resp, err := s.httpClient.Do(req)
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error making request")
 		return nil, err
 	}
@@ -96,7 +103,7 @@ func (s *Statement) GetStatements(ctx context.Context,
 
 	respBody, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error reading response body")
 		return nil, err
 	}
@@ -104,14 +111,14 @@ func (s *Statement) GetStatements(ctx context.Context,
 	if resp.StatusCode != http.StatusOK {
 		var errResponse *ErrorDefaultResponse
 		if err := json.Unmarshal(respBody, &errResponse); err != nil {
-			logrus.WithFields(fields).WithError(err).
+			logger.WithFields(fields).WithError(err).
 				Error("error unmarshalling error response")
 			return nil, err
 		}
 
 		if errResponse != nil && errResponse.Error != nil && len(*errResponse.Error.ErrorCode) > 0 {
 			err := FindStatementError(*errResponse.Error.ErrorCode, &resp.StatusCode)
-			logrus.WithFields(fields).WithError(err).
+			logger.WithFields(fields).WithError(err).
 				Error("error getting celcoin statements")
 			return nil, err
 		}
@@ -119,12 +126,12 @@ func (s *Statement) GetStatements(ctx context.Context,
 
 	var walletMovementResponse StatementResponse
 	if err := json.NewDecoder(bytes.NewReader(respBody)).Decode(&walletMovementResponse); err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error decoding response body")
 		return nil, err
 	}
 
-	logrus.WithFields(fields).WithField("celcoin_response", walletMovementResponse).
+	logger.WithFields(fields).WithField("celcoin_response", walletMovementResponse).
 		Info("celcoin wallet movement response")
 
 	return &walletMovementResponse, nil
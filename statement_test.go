@@ -21,7 +21,7 @@ type StatementTestSuite struct {
 	assert     *assert.Assertions
 	ctx        context.Context
 	session    *Session
-	statement  *Statement
+	statement  StatementInterface
 	mockClient *MockHTTPClient
 }
 
@@ -131,12 +131,19 @@ func (s *StatementTestSuite) TestGetStatementsInvalidInputError() {
 	s.mockClient.On("Do", mock.Anything).Return(mockResponse, nil)
 
 	// Chama o método GetStatements
-	_, err = s.statement.GetStatements(context.Background(), &StatementRequest{})
+	var meta ResponseMeta
+	_, err = s.statement.GetStatements(WithResponseMeta(context.Background(), &meta), &StatementRequest{})
 
 	// Verifica se o erro é do tipo *grok.Error
 	grokErr, ok := err.(*grok.Error)
 	s.assert.True(ok, "erro deve ser do tipo *grok.Error")
 
+	// Verifica se o código original da Celcoin foi preservado no ResponseMeta
+	s.Require().NotNil(meta.Error)
+	s.assert.Equal("CBE073", meta.Error.CelcoinCode)
+	s.assert.Equal(http.StatusBadRequest, meta.Error.StatusCode)
+	s.assert.True(meta.Error.Terminal())
+
 	// Verifica se o erro retornado é o esperado
 	s.assert.Equal(400, grokErr.Code)
 	s.assert.Equal("INVALID_INPUT", grokErr.Key)
@@ -166,12 +173,19 @@ func (s *StatementTestSuite) TestGetStatementsUnknownError() {
 	s.mockClient.On("Do", mock.Anything).Return(mockResponse, nil)
 
 	// Chama o método GetStatements
-	_, err = s.statement.GetStatements(context.Background(), &StatementRequest{})
+	var meta ResponseMeta
+	_, err = s.statement.GetStatements(WithResponseMeta(context.Background(), &meta), &StatementRequest{})
 
 	// Verifica se o erro é do tipo *grok.Error
 	grokErr, ok := err.(*grok.Error)
 	s.assert.True(ok, "erro deve ser do tipo *grok.Error")
 
+	// Verifica se o código original da Celcoin foi preservado no ResponseMeta
+	s.Require().NotNil(meta.Error)
+	s.assert.Equal("CBE999", meta.Error.CelcoinCode)
+	s.assert.Equal("Unknown server error", meta.Error.CelcoinMessage)
+	s.assert.True(meta.Error.Retryable())
+
 	// Verifica se o erro retornado é o esperado
 	s.assert.Equal(http.StatusInternalServerError, grokErr.Code)
 	s.assert.Equal("UNKNOWN_ERROR", grokErr.Key)
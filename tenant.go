@@ -0,0 +1,201 @@
+package celcoin
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+type tenantContextKey struct{}
+
+// WithTenant ... associa o tenant ao contexto. Em sessões com CredentialResolver, a chamada usa as
+// credenciais, o token e o certificado do tenant; logs e métricas recebem o campo tenant.
+func WithTenant(ctx context.Context, tenant string) context.Context {
+	return context.WithValue(ctx, tenantContextKey{}, tenant)
+}
+
+// TenantFrom ... tenant do contexto, ou vazio quando não informado
+func TenantFrom(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	tenant, _ := ctx.Value(tenantContextKey{}).(string)
+	return tenant
+}
+
+// TenantCredentials ... credenciais Celcoin de um tenant. O mTLS é usado quando Certificate ou CertificateLoader é informado.
+type TenantCredentials struct {
+	ClientID     string
+	ClientSecret string
+	// Scopes ... quando vazio, usa os Scopes da sessão
+	Scopes      string
+	Certificate *Certificate
+	// CertificateLoader ... quando informado, substitui Certificate e permite recarregar o certificado do tenant
+	CertificateLoader CertificateLoader
+	// CertificateReloadInterval ... intervalo de releitura do CertificateLoader (padrão DefaultCertificateReloadInterval)
+	CertificateReloadInterval *time.Duration
+}
+
+// mtls ...
+func (c TenantCredentials) mtls() bool {
+	return c.Certificate != nil || c.CertificateLoader != nil
+}
+
+// CredentialResolver ... obtém as credenciais do tenant informado em WithTenant.
+// É chamado uma vez por tenant; o resultado fica em cache junto com o token e o transporte mTLS do tenant.
+type CredentialResolver interface {
+	Resolve(ctx context.Context, tenant string) (*TenantCredentials, error)
+}
+
+// StaticCredentialResolver ... CredentialResolver com as credenciais de cada tenant fixadas em memória
+type StaticCredentialResolver map[string]TenantCredentials
+
+// Resolve ...
+func (r StaticCredentialResolver) Resolve(_ context.Context, tenant string) (*TenantCredentials, error) {
+	credentials, found := r[tenant]
+	if !found {
+		return nil, ErrUnknownTenant
+	}
+	return &credentials, nil
+}
+
+// tenantSession ... cópia da sessão com as credenciais do tenant
+func (s Session) tenantSession(tenant string, credentials TenantCredentials) *Session {
+	session := s
+	session.ClientID = credentials.ClientID
+	session.ClientSecret = credentials.ClientSecret
+	session.Credentials = nil
+	session.Mtls = credentials.mtls()
+	if len(credentials.Scopes) > 0 {
+		session.Scopes = credentials.Scopes
+	}
+	session.Logger = s.baseLogger().WithField("tenant", tenant)
+	return &session
+}
+
+// resolveTenant ... credenciais do tenant do contexto pelo CredentialResolver da sessão
+func (s Session) resolveTenant(ctx context.Context) (string, *TenantCredentials, error) {
+	tenant := TenantFrom(ctx)
+	if len(tenant) == 0 {
+		return "", nil, newTransportError(TransportStepResolveCredentials, ErrMissingTenant)
+	}
+
+	credentials, err := s.CredentialResolver.Resolve(ctx, tenant)
+	if err != nil {
+		return tenant, nil, newTransportError(TransportStepResolveCredentials, err)
+	}
+	if credentials == nil || len(credentials.ClientID) == 0 {
+		return tenant, nil, newTransportError(TransportStepResolveCredentials, ErrMissingClientID)
+	}
+	return tenant, credentials, nil
+}
+
+// CreateMultiTenantHTTPClient ... cria um cliente HTTP que atende vários tenants com uma única instância dos serviços.
+// Cada tenant, informado com WithTenant, tem suas credenciais obtidas pelo CredentialResolver da sessão,
+// seu próprio token e, quando configurado, seu próprio transporte mTLS.
+func CreateMultiTenantHTTPClient(session *Session) (*http.Client, error) {
+	if err := validateSession(session); err != nil {
+		return nil, err
+	}
+	if session.CredentialResolver == nil {
+		return nil, newTransportError(TransportStepSession, errors.New("credential resolver is required"))
+	}
+
+	return &http.Client{
+		Timeout: 30 * time.Second,
+		Transport: &tenantTransport{
+			session: session,
+			tenants: make(map[string]http.RoundTripper),
+		},
+	}, nil
+}
+
+// tenantTransport ... escolhe, pelo tenant do contexto, o transporte autenticado do tenant, criado na primeira chamada
+type tenantTransport struct {
+	session *Session
+	mutex   sync.Mutex
+	tenants map[string]http.RoundTripper
+}
+
+// RoundTrip ...
+func (t *tenantTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	transport, err := t.transport(req.Context())
+	if err != nil {
+		return nil, withRequestID(req.Context(), err)
+	}
+	return transport.RoundTrip(req)
+}
+
+// transport ... transporte do tenant; o CredentialResolver é chamado fora do mutex
+func (t *tenantTransport) transport(ctx context.Context) (http.RoundTripper, error) {
+	tenant := TenantFrom(ctx)
+
+	t.mutex.Lock()
+	transport, found := t.tenants[tenant]
+	t.mutex.Unlock()
+	if found {
+		return transport, nil
+	}
+
+	tenant, credentials, err := t.session.resolveTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	transport, err = t.newTransport(tenant, *credentials)
+	if err != nil {
+		return nil, err
+	}
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	// Outra chamada pode ter criado o transporte enquanto as credenciais eram resolvidas
+	if existing, found := t.tenants[tenant]; found {
+		return existing, nil
+	}
+	t.tenants[tenant] = transport
+	return transport, nil
+}
+
+// newTransport ... cadeia de transportes da sessão com as credenciais do tenant
+func (t *tenantTransport) newTransport(tenant string, credentials TenantCredentials) (http.RoundTripper, error) {
+	session := t.session.tenantSession(tenant, credentials)
+	if !session.Mtls {
+		return newAuthenticatedHTTPClient(session, http.DefaultTransport).Transport, nil
+	}
+
+	loader := credentials.CertificateLoader
+	interval := time.Duration(0)
+	if loader == nil {
+		loader = StaticCertificateLoader(credentials.Certificate)
+	} else {
+		interval = DefaultCertificateReloadInterval
+		if credentials.CertificateReloadInterval != nil {
+			interval = *credentials.CertificateReloadInterval
+		}
+	}
+
+	reloader, err := NewCertificateReloader(loader, interval)
+	if err != nil {
+		return nil, err
+	}
+	reloader.logger = session.logger("certificate")
+	return newAuthenticatedHTTPClient(session, newMtlsTransport(reloader)).Transport, nil
+}
+
+// CloseIdleConnections ... repassa a todos os transportes de tenant já criados
+func (t *tenantTransport) CloseIdleConnections() {
+	t.mutex.Lock()
+	transports := make([]http.RoundTripper, 0, len(t.tenants))
+	for _, transport := range t.tenants {
+		transports = append(transports, transport)
+	}
+	t.mutex.Unlock()
+
+	for _, transport := range transports {
+		if closer, ok := transport.(interface{ CloseIdleConnections() }); ok {
+			closer.CloseIdleConnections()
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package celcoin_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/contbank/celcoin-sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+// TenantTestSuite ...
+type TenantTestSuite struct {
+	suite.Suite
+	assert     *assert.Assertions
+	server     *httptest.Server
+	mutex      sync.Mutex
+	tokenCalls map[string]int
+	authHeader string
+	collector  *celcoin.MetricsCollector
+	client     *celcoin.Client
+}
+
+// TestTenantTestSuite ...
+func TestTenantTestSuite(t *testing.T) {
+	suite.Run(t, new(TenantTestSuite))
+}
+
+// SetupTest ...
+func (s *TenantTestSuite) SetupTest() {
+	s.assert = assert.New(s.T())
+	s.tokenCalls = make(map[string]int)
+
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/"+celcoin.LoginPath):
+			r.ParseForm()
+			clientID := r.PostForm.Get("client_id")
+			s.tokenCalls[clientID]++
+			json.NewEncoder(w).Encode(celcoin.AuthenticationResponse{
+				AccessToken: "token-" + clientID,
+				ExpiresIn:   3600,
+			})
+		case r.URL.Path == celcoin.BalancePath:
+			s.authHeader = r.Header.Get("Authorization")
+			w.Write([]byte(`{"status":"SUCCESS","version":"1.0.0","body":{"amount":10}}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+
+	s.collector = celcoin.NewMetricsCollector(nil)
+	client, err := celcoin.NewClient(celcoin.Config{
+		APIEndpoint:   celcoin.String(s.server.URL),
+		LoginEndpoint: celcoin.String(s.server.URL),
+		Logger:        celcoin.NewNopLogger(),
+		Observer:      s.collector,
+		CredentialResolver: celcoin.StaticCredentialResolver{
+			"brand-a": {ClientID: "client-a", ClientSecret: "secret-a"},
+			"brand-b": {ClientID: "client-b", ClientSecret: "secret-b"},
+		},
+	})
+	s.Require().NoError(err)
+	s.client = client
+}
+
+// TearDownTest ...
+func (s *TenantTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+// lastAuthHeader ...
+func (s *TenantTestSuite) lastAuthHeader() string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.authHeader
+}
+
+// TestTenantsUseOwnCredentials ...
+func (s *TenantTestSuite) TestTenantsUseOwnCredentials() {
+	ctxA := celcoin.WithTenant(context.Background(), "brand-a")
+	ctxB := celcoin.WithTenant(context.Background(), "brand-b")
+
+	for i := 0; i < 2; i++ {
+		_, err := s.client.Balance.Balance(ctxA, "123456")
+		s.Require().NoError(err)
+		s.assert.Equal("Bearer token-client-a", s.lastAuthHeader())
+
+		_, err = s.client.Balance.Balance(ctxB, "123456")
+		s.Require().NoError(err)
+		s.assert.Equal("Bearer token-client-b", s.lastAuthHeader())
+	}
+
+	s.assert.Equal(map[string]int{"client-a": 1, "client-b": 1}, s.tokenCalls)
+
+	token, err := s.client.Authentication.Token(ctxB)
+	s.Require().NoError(err)
+	s.assert.Equal("Bearer token-client-b", token)
+}
+
+// TestMetricsTaggedWithTenant ...
+func (s *TenantTestSuite) TestMetricsTaggedWithTenant() {
+	_, err := s.client.Balance.Balance(celcoin.WithTenant(context.Background(), "brand-a"), "123456")
+	s.Require().NoError(err)
+
+	value, found := s.collector.Value("celcoin_operations_total", map[string]string{
+		"service":     "balance",
+		"operation":   "Balance",
+		"status_code": "200",
+		"error_code":  "",
+		"tenant":      "brand-a",
+	})
+	s.assert.True(found)
+	s.assert.Equal(1.0, value)
+}
+
+// TestMissingAndUnknownTenant ...
+func (s *TenantTestSuite) TestMissingAndUnknownTenant() {
+	_, err := s.client.Balance.Balance(context.Background(), "123456")
+	s.assert.ErrorIs(err, celcoin.ErrMissingTenant)
+
+	_, err = s.client.Balance.Balance(celcoin.WithTenant(context.Background(), "brand-c"), "123456")
+	s.assert.ErrorIs(err, celcoin.ErrUnknownTenant)
+
+	var transportErr *celcoin.TransportError
+	s.Require().True(errors.As(err, &transportErr))
+	s.assert.Equal(celcoin.TransportStepResolveCredentials, transportErr.Step)
+	s.assert.Equal(celcoin.ErrorClassTerminal, celcoin.ClassifyError(err))
+	s.assert.Empty(s.tokenCalls)
+}
+
+// TestNewClientWithoutDefaultCredentials ...
+func (s *TenantTestSuite) TestNewClientWithoutDefaultCredentials() {
+	_, err := celcoin.NewClient(celcoin.Config{
+		APIEndpoint:   celcoin.String(s.server.URL),
+		LoginEndpoint: celcoin.String(s.server.URL),
+		ClientID:      celcoin.String(""),
+	})
+	s.assert.ErrorIs(err, celcoin.ErrMissingClientID)
+}
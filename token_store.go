@@ -0,0 +1,318 @@
+package celcoin
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/patrickmn/go-cache"
+)
+
+const (
+	// tokenLockTTL ... tempo máximo que um detentor pode manter a trava de renovação
+	tokenLockTTL = tokenRequestTimeout
+	// tokenLockPollInterval ... intervalo de espera enquanto outra réplica renova o token
+	tokenLockPollInterval = 100 * time.Millisecond
+)
+
+// StoredToken ... token OAuth compartilhado através de um TokenStore
+type StoredToken struct {
+	AccessToken string    `json:"access_token"`
+	ExpiresAt   time.Time `json:"expires_at"`
+}
+
+// validFor ... indica se o token continua válido considerando a antecedência informada
+func (t *StoredToken) validFor(margin time.Duration) bool {
+	return t != nil && len(t.AccessToken) > 0 && time.Now().Before(t.ExpiresAt.Add(-margin))
+}
+
+// TokenStore ... armazena o token OAuth para que várias réplicas compartilhem o mesmo token.
+//
+// Contrato que adaptadores (Redis, arquivo, ...) devem respeitar:
+//   - Get retorna (nil, nil) quando não existe token para a chave.
+//   - Set grava o token e pode descartá-lo após ExpiresAt.
+//   - Delete remove o token; remover uma chave inexistente não é erro.
+//   - Lock tenta adquirir, sem bloquear, a trava exclusiva de renovação da chave por até ttl.
+//     Retorna acquired=false quando outro detentor já possui a trava. A trava expira sozinha após ttl,
+//     e a função unlock a libera antecipadamente.
+//
+// VerifyTokenStore pode ser usado nos testes de um adaptador para validar esse contrato.
+type TokenStore interface {
+	Get(ctx context.Context, key string) (*StoredToken, error)
+	Set(ctx context.Context, key string, token StoredToken) error
+	Delete(ctx context.Context, key string) error
+	Lock(ctx context.Context, key string, ttl time.Duration) (unlock func(), acquired bool, err error)
+}
+
+// MemoryTokenStore ... TokenStore padrão, em memória, baseado no go-cache da sessão
+type MemoryTokenStore struct {
+	cache *cache.Cache
+}
+
+// NewMemoryTokenStore ...
+func NewMemoryTokenStore(c *cache.Cache) *MemoryTokenStore {
+	if c == nil {
+		c = cache.New(10*time.Minute, 1*time.Second)
+	}
+	return &MemoryTokenStore{cache: c}
+}
+
+// Get ...
+func (s *MemoryTokenStore) Get(ctx context.Context, key string) (*StoredToken, error) {
+	value, found := s.cache.Get(key)
+	if !found {
+		return nil, nil
+	}
+	token, ok := value.(StoredToken)
+	if !ok {
+		return nil, nil
+	}
+	return &token, nil
+}
+
+// Set ...
+func (s *MemoryTokenStore) Set(ctx context.Context, key string, token StoredToken) error {
+	ttl := time.Until(token.ExpiresAt)
+	if ttl <= 0 {
+		s.cache.Delete(key)
+		return nil
+	}
+	s.cache.Set(key, token, ttl)
+	return nil
+}
+
+// Delete ...
+func (s *MemoryTokenStore) Delete(ctx context.Context, key string) error {
+	s.cache.Delete(key)
+	return nil
+}
+
+// Lock ...
+func (s *MemoryTokenStore) Lock(ctx context.Context, key string, ttl time.Duration) (func(), bool, error) {
+	lockKey := key + ":lock"
+	if err := s.cache.Add(lockKey, true, ttl); err != nil {
+		return nil, false, nil
+	}
+	return func() { s.cache.Delete(lockKey) }, true, nil
+}
+
+// FileTokenStore ... TokenStore em disco, útil quando as réplicas compartilham um volume
+type FileTokenStore struct {
+	dir string
+}
+
+// NewFileTokenStore ...
+func NewFileTokenStore(dir string) (*FileTokenStore, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	return &FileTokenStore{dir: dir}, nil
+}
+
+// path ... nome de arquivo seguro para a chave
+func (s *FileTokenStore) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(s.dir, hex.EncodeToString(sum[:])+".json")
+}
+
+// Get ...
+func (s *FileTokenStore) Get(ctx context.Context, key string) (*StoredToken, error) {
+	data, err := ioutil.ReadFile(s.path(key))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var token StoredToken
+	if err := json.Unmarshal(data, &token); err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+// Set ...
+func (s *FileTokenStore) Set(ctx context.Context, key string, token StoredToken) error {
+	data, err := json.Marshal(token)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := ioutil.TempFile(s.dir, "token-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), s.path(key))
+}
+
+// Delete ...
+func (s *FileTokenStore) Delete(ctx context.Context, key string) error {
+	err := os.Remove(s.path(key))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+// Lock ...
+func (s *FileTokenStore) Lock(ctx context.Context, key string, ttl time.Duration) (func(), bool, error) {
+	lockPath := s.path(key) + ".lock"
+
+	for attempt := 0; attempt < 2; attempt++ {
+		file, err := os.OpenFile(lockPath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+		if err == nil {
+			file.Close()
+			return func() { os.Remove(lockPath) }, true, nil
+		}
+		if !os.IsExist(err) {
+			return nil, false, err
+		}
+
+		// Remove a trava abandonada por um detentor que não a liberou dentro do ttl
+		info, statErr := os.Stat(lockPath)
+		if statErr != nil || time.Since(info.ModTime()) < ttl {
+			return nil, false, nil
+		}
+		os.Remove(lockPath)
+	}
+	return nil, false, nil
+}
+
+// VerifyTokenStore ... valida que um TokenStore respeita o contrato esperado pelo SDK
+func VerifyTokenStore(ctx context.Context, store TokenStore) error {
+	key := "celcoin-sdk:verify:" + NewRequestID()
+	defer store.Delete(ctx, key)
+
+	token, err := store.Get(ctx, key)
+	if err != nil {
+		return fmt.Errorf("get missing key: %w", err)
+	}
+	if token != nil {
+		return errors.New("get missing key: expected nil token")
+	}
+
+	expected := StoredToken{AccessToken: "verify-token", ExpiresAt: time.Now().Add(time.Minute).UTC().Truncate(time.Second)}
+	if err := store.Set(ctx, key, expected); err != nil {
+		return fmt.Errorf("set: %w", err)
+	}
+
+	token, err = store.Get(ctx, key)
+	if err != nil {
+		return fmt.Errorf("get: %w", err)
+	}
+	if token == nil || token.AccessToken != expected.AccessToken || !token.ExpiresAt.Equal(expected.ExpiresAt) {
+		return fmt.Errorf("get: expected %+v, got %+v", expected, token)
+	}
+
+	unlock, acquired, err := store.Lock(ctx, key, time.Minute)
+	if err != nil || !acquired {
+		return fmt.Errorf("lock: expected to acquire free lock (err: %v)", err)
+	}
+
+	_, acquired, err = store.Lock(ctx, key, time.Minute)
+	if err != nil {
+		return fmt.Errorf("lock: %w", err)
+	}
+	if acquired {
+		return errors.New("lock: acquired a lock that is already held")
+	}
+
+	unlock()
+	unlock, acquired, err = store.Lock(ctx, key, time.Minute)
+	if err != nil || !acquired {
+		return fmt.Errorf("lock: expected to acquire released lock (err: %v)", err)
+	}
+	unlock()
+
+	if err := store.Delete(ctx, key); err != nil {
+		return fmt.Errorf("delete: %w", err)
+	}
+	token, err = store.Get(ctx, key)
+	if err != nil || token != nil {
+		return fmt.Errorf("delete: expected token to be removed (err: %v)", err)
+	}
+
+	return nil
+}
+
+// tokenStoreKey ... chave do token da sessão no TokenStore
+func tokenStoreKey(session *Session) string {
+	clientID, _ := session.clientCredentials()
+	return tokenStoreKeyFor(clientID)
+}
+
+// tokenStoreKeyFor ...
+func tokenStoreKeyFor(clientID string) string {
+	return "celcoin-sdk:token:" + clientID
+}
+
+// tokenStore ... retorna o TokenStore da sessão, usando o cache da sessão como padrão
+func (s *Session) tokenStore() TokenStore {
+	if s.TokenStore != nil {
+		return s.TokenStore
+	}
+	if s.Cache == (cache.Cache{}) {
+		return NewMemoryTokenStore(nil)
+	}
+	return NewMemoryTokenStore(&s.Cache)
+}
+
+// obtainToken ... retorna um token do TokenStore válido por pelo menos margin ou obtém um novo.
+// Apenas o detentor da trava chama fetch; os demais aguardam o token gravado por ele.
+// Falhas ao gravar no store não impedem o uso do token, mas são registradas: sem o store, cada réplica obtém o seu.
+func obtainToken(ctx context.Context, logger Logger, store TokenStore, key string, margin time.Duration,
+	fetch func() (string, time.Time, error)) (*StoredToken, error) {
+
+	waitUntil := time.Now().Add(tokenLockTTL)
+
+	for {
+		if token, err := store.Get(ctx, key); err == nil && token.validFor(margin) {
+			return token, nil
+		}
+
+		unlock, acquired, err := store.Lock(ctx, key, tokenLockTTL)
+		if err != nil || acquired || time.Now().After(waitUntil) {
+			// Sem trava (erro no store ou detentor travado por tempo demais) o token é obtido diretamente
+			if acquired {
+				defer unlock()
+				if token, err := store.Get(ctx, key); err == nil && token.validFor(margin) {
+					return token, nil
+				}
+			}
+
+			accessToken, expiration, err := fetch()
+			if err != nil {
+				return nil, err
+			}
+
+			token := &StoredToken{AccessToken: accessToken, ExpiresAt: expiration}
+			if err := store.Set(ctx, key, *token); err != nil {
+				logger.WithError(err).Warn("error saving celcoin token to the token store")
+			}
+			return token, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(tokenLockPollInterval):
+		}
+	}
+}
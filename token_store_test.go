@@ -0,0 +1,174 @@
+package celcoin_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/contbank/celcoin-sdk"
+	"github.com/patrickmn/go-cache"
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+// TokenStoreTestSuite ...
+type TokenStoreTestSuite struct {
+	suite.Suite
+	assert     *assert.Assertions
+	ctx        context.Context
+	tokenCalls int32
+	server     *httptest.Server
+}
+
+// TestTokenStoreTestSuite ...
+func TestTokenStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(TokenStoreTestSuite))
+}
+
+// SetupTest ...
+func (s *TokenStoreTestSuite) SetupTest() {
+	s.assert = assert.New(s.T())
+	s.ctx = context.Background()
+	s.tokenCalls = 0
+
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, celcoin.LoginPath) {
+			call := atomic.AddInt32(&s.tokenCalls, 1)
+			time.Sleep(50 * time.Millisecond)
+			fmt.Fprintf(w, `{"access_token":"token-%d","expires_in":3600}`, call)
+			return
+		}
+		w.Write([]byte(r.Header.Get("Authorization")))
+	}))
+}
+
+// TearDownTest ...
+func (s *TokenStoreTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *TokenStoreTestSuite) newSession(store celcoin.TokenStore) *celcoin.Session {
+	session, err := celcoin.NewSession(celcoin.Config{
+		ClientID:      celcoin.String("test-client-id"),
+		ClientSecret:  celcoin.String("test-client-secret"),
+		Mtls:          celcoin.Bool(false),
+		APIEndpoint:   celcoin.String(s.server.URL),
+		LoginEndpoint: celcoin.String(s.server.URL),
+		TokenStore:    store,
+	})
+	s.Require().NoError(err)
+	return session
+}
+
+// TestMemoryTokenStoreContract ...
+func (s *TokenStoreTestSuite) TestMemoryTokenStoreContract() {
+	s.assert.NoError(celcoin.VerifyTokenStore(s.ctx, celcoin.NewMemoryTokenStore(nil)))
+}
+
+// TestFileTokenStoreContract ...
+func (s *TokenStoreTestSuite) TestFileTokenStoreContract() {
+	store, err := celcoin.NewFileTokenStore(s.T().TempDir())
+	s.Require().NoError(err)
+	s.assert.NoError(celcoin.VerifyTokenStore(s.ctx, store))
+}
+
+// TestReplicasShareToken ...
+func (s *TokenStoreTestSuite) TestReplicasShareToken() {
+	store, err := celcoin.NewFileTokenStore(s.T().TempDir())
+	s.Require().NoError(err)
+
+	// Cada réplica tem sua própria sessão e transporte, mas todas usam o mesmo store
+	const replicas = 10
+	clients := make([]*http.Client, replicas)
+	for i := range clients {
+		clients[i], err = celcoin.CreateOAuth2HTTPClient(s.newSession(store))
+		s.Require().NoError(err)
+	}
+
+	var wg sync.WaitGroup
+	var failures int32
+	for _, client := range clients {
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func(client *http.Client) {
+				defer wg.Done()
+				resp, err := client.Get(s.server.URL + "/api")
+				if err != nil {
+					atomic.AddInt32(&failures, 1)
+					return
+				}
+				resp.Body.Close()
+			}(client)
+		}
+	}
+	wg.Wait()
+
+	s.assert.Equal(int32(0), atomic.LoadInt32(&failures))
+	s.assert.Equal(int32(1), atomic.LoadInt32(&s.tokenCalls))
+}
+
+// TestAuthenticationUsesTokenStore ...
+func (s *TokenStoreTestSuite) TestAuthenticationUsesTokenStore() {
+	store := celcoin.NewMemoryTokenStore(cache.New(time.Minute, time.Minute))
+	session := s.newSession(store)
+
+	client, err := celcoin.CreateOAuth2HTTPClient(session)
+	s.Require().NoError(err)
+
+	resp, err := client.Get(s.server.URL + "/api")
+	s.Require().NoError(err)
+	resp.Body.Close()
+
+	authentication := celcoin.NewAuthentication(&http.Client{}, *session)
+	token, err := authentication.Token(s.ctx)
+
+	s.assert.NoError(err)
+	s.assert.Equal("Bearer token-1", token)
+	s.assert.Equal(int32(1), atomic.LoadInt32(&s.tokenCalls))
+}
+
+// failingTokenStore ... store compartilhado indisponível para gravação
+type failingTokenStore struct {
+	*celcoin.MemoryTokenStore
+}
+
+// Set ...
+func (failingTokenStore) Set(context.Context, string, celcoin.StoredToken) error {
+	return errors.New("store unavailable")
+}
+
+// TestTokenStoreSetFailureIsLogged ...
+func (s *TokenStoreTestSuite) TestTokenStoreSetFailureIsLogged() {
+	logger, hook := test.NewNullLogger()
+	session := s.newSession(failingTokenStore{celcoin.NewMemoryTokenStore(nil)})
+	session.Logger = celcoin.NewLogrusLogger(logger)
+
+	token, err := celcoin.NewAuthentication(&http.Client{}, *session).Token(s.ctx)
+	s.Require().NoError(err)
+	s.assert.Equal("Bearer token-1", token)
+
+	entry := hook.LastEntry()
+	s.Require().NotNil(entry)
+	s.assert.Equal(logrus.WarnLevel, entry.Level)
+	s.assert.Equal("error saving celcoin token to the token store", entry.Message)
+	s.assert.EqualError(entry.Data[logrus.ErrorKey].(error), "store unavailable")
+}
+
+// TestMemoryTokenStoreIgnoresForeignValues ...
+func (s *TokenStoreTestSuite) TestMemoryTokenStoreIgnoresForeignValues() {
+	shared := cache.New(time.Minute, time.Minute)
+	shared.Set("celcoin-sdk:token:test-client-id", "not a token", time.Minute)
+
+	token, err := celcoin.NewMemoryTokenStore(shared).Get(s.ctx, "celcoin-sdk:token:test-client-id")
+	s.assert.NoError(err)
+	s.assert.Nil(token)
+}
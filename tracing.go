@@ -0,0 +1,78 @@
+package celcoin
+
+import (
+	"context"
+	"net/http"
+)
+
+// Tracer ... abstração mínima de um tracer no estilo OpenTelemetry; um adaptador para o otel.Tracer tem poucas linhas.
+// Tracers que também implementam TracePropagator recebem os cabeçalhos das chamadas HTTP para propagar o trace.
+type Tracer interface {
+	Start(ctx context.Context, name string) (context.Context, Span)
+}
+
+// Span ...
+type Span interface {
+	SetAttribute(key string, value interface{})
+	RecordError(err error)
+	End()
+}
+
+// TracePropagator ... injeta o contexto de trace (ex.: traceparent) nos cabeçalhos enviados à Celcoin
+type TracePropagator interface {
+	Inject(ctx context.Context, header http.Header)
+}
+
+type spanKey struct{}
+
+// tracingObserver ...
+type tracingObserver struct {
+	tracer Tracer
+}
+
+// NewTracingObserver ... Observer que abre um span por operação e um span filho por chamada HTTP
+func NewTracingObserver(tracer Tracer) Observer {
+	return &tracingObserver{tracer: tracer}
+}
+
+// Start ...
+func (o *tracingObserver) Start(ctx context.Context, observation *Observation) context.Context {
+	name := "celcoin." + observation.Service + "." + observation.Operation
+	if observation.Kind == ObservationHTTP {
+		name = "HTTP " + observation.Method + " " + observation.Endpoint
+	}
+
+	ctx, span := o.tracer.Start(ctx, name)
+	span.SetAttribute("celcoin.service", observation.Service)
+	span.SetAttribute("celcoin.operation", observation.Operation)
+	span.SetAttribute("celcoin.endpoint", observation.Endpoint)
+	if len(observation.Tenant) > 0 {
+		span.SetAttribute("celcoin.tenant", observation.Tenant)
+	}
+	if observation.Kind == ObservationHTTP {
+		span.SetAttribute("http.method", observation.Method)
+		if propagator, ok := o.tracer.(TracePropagator); ok && observation.Header != nil {
+			propagator.Inject(ctx, observation.Header)
+		}
+	}
+	if requestID := RequestIDFrom(ctx); len(requestID) > 0 {
+		span.SetAttribute("request_id", requestID)
+	}
+	return context.WithValue(ctx, spanKey{}, span)
+}
+
+// End ...
+func (o *tracingObserver) End(ctx context.Context, observation *Observation) {
+	span, ok := ctx.Value(spanKey{}).(Span)
+	if !ok {
+		return
+	}
+	if observation.StatusCode > 0 {
+		span.SetAttribute("http.status_code", observation.StatusCode)
+	}
+	if observation.Err != nil {
+		span.SetAttribute("celcoin.error_code", observation.ErrorCode)
+		span.RecordError(observation.Err)
+	}
+	span.End()
+}
@@ -12,9 +12,14 @@ import (
 	"strings"
 
 	"github.com/contbank/grok"
-	"github.com/sirupsen/logrus"
 )
 
+// TransfersInterface define a interface para as transferências entre contas.
+type TransfersInterface interface {
+	CreateTransfer(ctx context.Context, correlationID string, model TransfersRequest) (*TransfersResponse, error)
+	FindTransferByCode(ctx context.Context, requestID *string, transferAuthenticationCode string, transferRequestID string, isInternalTransfer *bool) (*TransfersResponse, error)
+}
+
 // Transfers ...
 type Transfers struct {
 	session        Session
@@ -22,20 +27,28 @@ type Transfers struct {
 	httpClient     *LoggingHTTPClient
 }
 
+var _ TransfersInterface = (*Transfers)(nil)
+
 // NewTransfers ...
-func NewTransfers(httpClient *http.Client, session Session) *Transfers {
+func NewTransfers(httpClient *http.Client, session Session) TransfersInterface {
 	return &Transfers{
 		session:        session,
-		httpClient:     NewLoggingHTTPClient(httpClient),
+		httpClient:     newSessionLoggingHTTPClient(httpClient, session),
 		authentication: NewAuthentication(httpClient, session),
 	}
 }
 
 // CreateTransfer ...
 func (t *Transfers) CreateTransfer(ctx context.Context, correlationID string,
-	model TransfersRequest) (*TransfersResponse, error) {
-	logrus.
-		WithFields(logrus.Fields{
+	model TransfersRequest) (_ *TransfersResponse, err error) {
+	if len(correlationID) > 0 {
+		ctx = WithRequestID(ctx, correlationID)
+	}
+	ctx, op := t.session.startOperation(ctx, "transfers", "CreateTransfer", "")
+	defer op.end(&err)
+	logger := op.logger
+	logger.
+		WithFields(Fields{
 			"correlation_id": correlationID,
 		}).
 		Info("create transfer")
@@ -45,15 +58,16 @@ func (t *Transfers) CreateTransfer(ctx context.Context, correlationID string,
 // createTransferOperation ...
 func (t *Transfers) createTransferOperation(ctx context.Context, requestID string,
 	model TransfersRequest) (*TransfersResponse, error) {
+	logger := loggerFrom(ctx, t.session.logger("transfers"))
 
-	fields := logrus.Fields{
+	fields := Fields{
 		"request_id": requestID,
 		"model":      model,
 	}
 
 	err := grok.Validator.Struct(model)
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error validating model")
 		return nil, grok.FromValidationErros(err)
 	}
@@ -72,33 +86,32 @@ func (t *Transfers) createTransferOperation(ctx context.Context, requestID strin
 
 	endpoint, err := t.getTransfersAPIEndpoint(requestID, nil, nil, isInternalTransfer)
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error getting api endpoint")
 		return nil, err
 	}
-	logrus.WithField("endpoint", *endpoint).Info("Endpoint built successfully")
+	logger.WithField("endpoint", *endpoint).Debug("Endpoint built successfully")
 
 	reqbyte, err := json.Marshal(model)
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error marshal model")
 		return nil, err
 	}
 
 	req, err := http.NewRequestWithContext(ctx, "POST", *endpoint, bytes.NewReader(reqbyte))
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error new request")
 		return nil, err
 	}
 
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Add("api-version", t.session.APIVersion)
-	req.Header.Add("x-correlation-id", requestID)
 
 	resp, err := t.httpClient.Do(req)
 	if err != nil {
-		logrus.
+		logger.
 			WithFields(fields).
 			WithError(err).
 			Error("error http client")
@@ -117,7 +130,7 @@ func (t *Transfers) createTransferOperation(ctx context.Context, requestID strin
 
 	err = json.Unmarshal(respBody, &body)
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error unmarshal")
 		return nil, err
 	}
@@ -132,7 +145,7 @@ func (t *Transfers) createTransferOperation(ctx context.Context, requestID strin
 
 	// error
 	if body.Error != nil {
-		logrus.WithFields(fields).Error("body error - createTransferOperation")
+		logger.WithFields(fields).Error("body error - createTransferOperation")
 		var httpErrorStatus int
 		httpErrorStatus, err = strconv.Atoi(body.Status)
 		if err != nil {
@@ -142,7 +155,7 @@ func (t *Transfers) createTransferOperation(ctx context.Context, requestID strin
 			body.Error.ErrorCode+" - "+body.Error.Message)
 	}
 
-	logrus.WithFields(fields).
+	logger.WithFields(fields).
 		Error("default error transfer - createTransferOperation")
 
 	return nil, ErrDefaultTransfers
@@ -150,7 +163,13 @@ func (t *Transfers) createTransferOperation(ctx context.Context, requestID strin
 
 // FindTransferByCode ...
 func (t *Transfers) FindTransferByCode(ctx context.Context, requestID *string,
-	transferAuthenticationCode string, transferRequestID string, isInternalTransfer *bool) (*TransfersResponse, error) {
+	transferAuthenticationCode string, transferRequestID string, isInternalTransfer *bool) (_ *TransfersResponse, err error) {
+	if requestID != nil {
+		ctx = WithRequestID(ctx, *requestID)
+	}
+	ctx, op := t.session.startOperation(ctx, "transfers", "FindTransferByCode", "")
+	defer op.end(&err)
+	logger := op.logger
 
 	if requestID == nil {
 		return nil, ErrInvalidCorrelationID
@@ -158,27 +177,27 @@ func (t *Transfers) FindTransferByCode(ctx context.Context, requestID *string,
 		return nil, ErrInvalidTransferAuthenticationCode
 	}
 
-	fields := logrus.Fields{
+	fields := Fields{
 		"request_id":                   requestID,
 		"transfer_authentication_code": transferAuthenticationCode,
 		"transfer_request_id":          transferRequestID,
 	}
 
 	if isInternalTransfer != nil && *isInternalTransfer == true {
-		logrus.WithFields(fields).Info("find transfer by code - internal flag")
+		logger.WithFields(fields).Info("find transfer by code - internal flag")
 		return t.findInternalOrExternalTransferByCode(ctx, requestID, transferAuthenticationCode, transferRequestID, true)
 	} else if isInternalTransfer != nil && *isInternalTransfer == false {
-		logrus.WithFields(fields).Info("find transfer by code - external flag")
+		logger.WithFields(fields).Info("find transfer by code - external flag")
 		return t.findInternalOrExternalTransferByCode(ctx, requestID, transferAuthenticationCode, transferRequestID, false)
 	} else {
-		logrus.WithFields(fields).Info("find transfer by code - internal")
+		logger.WithFields(fields).Info("find transfer by code - internal")
 		var resp *TransfersResponse = nil
 		resp, err := t.findInternalOrExternalTransferByCode(ctx, requestID, transferAuthenticationCode, transferRequestID, true)
 		if err != nil || (resp != nil && resp.Error != nil) {
-			logrus.WithFields(fields).Info("find transfer by code - external")
+			logger.WithFields(fields).Info("find transfer by code - external")
 			resp, err = t.findInternalOrExternalTransferByCode(ctx, requestID, transferAuthenticationCode, transferRequestID, false)
 			if err != nil || (resp != nil && resp.Error != nil) {
-				logrus.WithFields(fields).WithError(err).
+				logger.WithFields(fields).WithError(err).
 					Error("default error transfer - FindTransferByCode")
 				return nil, ErrDefaultFindTransfers
 			}
@@ -191,6 +210,7 @@ func (t *Transfers) FindTransferByCode(ctx context.Context, requestID *string,
 // findInternalOrExternalTransferByCode ...
 func (t *Transfers) findInternalOrExternalTransferByCode(ctx context.Context, requestID *string,
 	transferAuthenticationCode string, transferRequestID string, isInternalTransfer bool) (*TransfersResponse, error) {
+	logger := loggerFrom(ctx, t.session.logger("transfers"))
 
 	if requestID == nil {
 		return nil, ErrInvalidCorrelationID
@@ -198,7 +218,7 @@ func (t *Transfers) findInternalOrExternalTransferByCode(ctx context.Context, re
 		return nil, ErrInvalidTransferAuthenticationCode
 	}
 
-	fields := logrus.Fields{
+	fields := Fields{
 		"request_id":                   requestID,
 		"transfer_authentication_code": transferAuthenticationCode,
 		"transfer_request_id":          transferRequestID,
@@ -216,7 +236,6 @@ func (t *Transfers) findInternalOrExternalTransferByCode(ctx context.Context, re
 	}
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Add("api-version", t.session.APIVersion)
-	req.Header.Add("x-correlation-id", *requestID)
 
 	resp, err := t.httpClient.Do(req)
 	if err != nil {
@@ -236,7 +255,7 @@ func (t *Transfers) findInternalOrExternalTransferByCode(ctx context.Context, re
 
 	err = json.Unmarshal(respBody, &body)
 	if err != nil {
-		logrus.WithFields(fields).WithError(err).
+		logger.WithFields(fields).WithError(err).
 			Error("error unmarshal")
 		return nil, err
 	}
@@ -248,7 +267,7 @@ func (t *Transfers) findInternalOrExternalTransferByCode(ctx context.Context, re
 
 	// error
 	if body.Error != nil {
-		logrus.WithFields(fields).Error("body error - FindTransferByCode")
+		logger.WithFields(fields).Error("body error - FindTransferByCode")
 		var httpErrorStatus int
 		httpErrorStatus, err = strconv.Atoi(body.Status)
 		if err != nil {
@@ -258,7 +277,7 @@ func (t *Transfers) findInternalOrExternalTransferByCode(ctx context.Context, re
 			body.Error.ErrorCode+" - "+body.Error.Message)
 	}
 
-	logrus.WithFields(fields).
+	logger.WithFields(fields).
 		Error("default error transfer - findInternalOrExternalTransferByCode")
 
 	return nil, ErrDefaultFindTransfers
@@ -267,11 +286,12 @@ func (t *Transfers) findInternalOrExternalTransferByCode(ctx context.Context, re
 // getTransfersAPIEndpoint
 func (t *Transfers) getTransfersAPIEndpoint(correlationID string,
 	transferAuthenticationCode *string, transferRequestID *string, isInternalTransfer bool) (*string, error) {
+	logger := t.session.logger("transfers")
 
 	u, err := url.Parse(t.session.APIEndpoint)
 	if err != nil {
-		logrus.
-			WithFields(logrus.Fields{
+		logger.
+			WithFields(Fields{
 				"correlation_id":               correlationID,
 				"transfer_authentication_code": transferAuthenticationCode,
 				"transfer_request_id":          transferRequestID,
@@ -182,17 +182,14 @@ func RandStringBytes(n int) string {
 	return string(b)
 }
 
-// GetRequestID ...
+// GetRequestID ... mantido por compatibilidade; prefira RequestIDFrom
 func GetRequestID(ctx context.Context) string {
-	requestID, _ := ctx.Value("Request-Id").(string)
-	return requestID
+	return RequestIDFrom(ctx)
 }
 
 // GenerateNewRequestID
 func GenerateNewRequestID(ctx context.Context) context.Context {
-	requestID := uuid.New().String()
-	ctx = context.WithValue(ctx, requestIDKey("Request-Id"), requestID)
-	return ctx
+	return withLegacyRequestID(ctx, NewRequestID())
 }
 
 var GetClientID = func() string {
@@ -252,9 +249,7 @@ func setRequestHeader(request *http.Request, token string, apiVersion *string, h
 
 // NewContextRequestID ...
 func NewContextRequestID(ctx context.Context) context.Context {
-	requestID := uuid.New().String()
-	ctx = context.WithValue(ctx, "Request-Id", requestID)
-	return ctx
+	return withLegacyRequestID(ctx, NewRequestID())
 }
 
 // NewRequestID ...
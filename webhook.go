@@ -1,18 +1,13 @@
 package celcoin
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
-	"io/ioutil"
 	"net/http"
 	"net/url"
-	"path"
 
 	"github.com/contbank/grok"
-	"github.com/sirupsen/logrus"
 )
 
 // Webhooks define a interface para operações relacionadas a webhooks.
@@ -35,482 +30,201 @@ type WebhooksService struct {
 	authentication *Authentication
 }
 
+var _ Webhooks = (*WebhooksService)(nil)
+
 // NewWebhooks cria uma nova instância de WebhooksService.
 func NewWebhooks(httpClient *http.Client, session Session) Webhooks {
 	return &WebhooksService{
 		session:        session,
-		httpClient:     NewLoggingHTTPClient(httpClient),
+		httpClient:     newSessionLoggingHTTPClient(httpClient, session),
 		authentication: NewAuthentication(httpClient, session),
 	}
 }
 
-// Método genérico para construir URLs
-func (s *WebhooksService) buildEndpoint(basePath string, queryParams map[string]string, pathParams ...string) (*string, error) {
-	u, err := url.Parse(s.session.APIEndpoint)
-	if err != nil {
-		logrus.WithError(err).Error("Error parsing API endpoint")
-		return nil, err
-	}
+// webhookErrors ... erros das APIs de webhook
+var webhookErrors = envelopeErrors{find: ignoreMessage(FindWebhookError), fallback: ErrDefaultWebhook}
 
-	// Construção do caminho completo
-	fullPath := path.Join(basePath, path.Join(pathParams...))
-	u.Path = path.Join(u.Path, fullPath)
-
-	// Adição de query parameters
-	if queryParams != nil {
-		q := u.Query()
-		for key, value := range queryParams {
-			if value != "" {
-				q.Set(key, value)
-			}
-		}
-		u.RawQuery = q.Encode()
-	}
+// webhookSuccessStatus ...
+var webhookSuccessStatus = []int{http.StatusOK, http.StatusAccepted}
 
-	endpoint := u.String()
-	logrus.WithField("endpoint", endpoint).Info("Endpoint built successfully")
-	return &endpoint, nil
+// apiVersionHeader ...
+func (s *WebhooksService) apiVersionHeader() map[string]string {
+	return map[string]string{"api-version": s.session.APIVersion}
 }
 
 // CreateSubscription faz a chamada à API para cadastrar um webhook
-func (s *WebhooksService) CreateSubscription(ctx context.Context, req WebhookSubscriptionRequest) (*WebhookSubscriptionResponse, error) {
-	fields := logrus.Fields{
+func (s *WebhooksService) CreateSubscription(ctx context.Context, req WebhookSubscriptionRequest) (_ *WebhookSubscriptionResponse, err error) {
+	ctx, op := s.session.startOperation(ctx, "webhooks", "CreateSubscription", WebhookPath)
+	defer op.end(&err)
+	logger := op.logger
+	fields := Fields{
 		"request": req,
 	}
-	logrus.
+	logger.
 		WithFields(fields).
 		Info("Create Subscription")
 
-	err := grok.Validator.Struct(req)
+	err = grok.Validator.Struct(req)
 	if err != nil {
-		logrus.
+		logger.
 			WithFields(fields).
 			WithError(err).
 			Error("error validating model")
 		return nil, grok.FromValidationErros(err)
 	}
-	endpoint, err := s.buildEndpoint(WebhookPath, nil, "subscription")
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error building endpoint for CreateSubscription")
-		return nil, err
-	}
-
-	logrus.WithField("endpoint", *endpoint).Info("Calling CreatePixKey")
-
-	payload, err := json.Marshal(req)
-	if err != nil {
-		logrus.
-			WithFields(fields).
-			WithError(err).
-			Error("error serializing request")
-
-		return nil, fmt.Errorf("error serializing request: %v", err)
-	}
-	httpReq, err := http.NewRequestWithContext(ctx, "POST", *endpoint, bytes.NewReader(payload))
-	if err != nil {
-		logrus.
-			WithFields(fields).
-			WithError(err).
-			Error("error creating http request")
-		return nil, fmt.Errorf("error creating http request: %v", err)
-	}
-
-	httpReq.Header.Add("Content-Type", "application/json")
-	//httpReq.Header.Add("api-version", s.session.APIVersion)
-
-	resp, err := s.httpClient.Do(httpReq)
-	if err != nil {
-		logrus.
-			WithFields(fields).
-			WithError(err).
-			Error("error http client")
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	respBody, _ := ioutil.ReadAll(resp.Body)
-
-	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusAccepted {
-		var response WebhookSubscriptionResponse
-		if err := json.Unmarshal(respBody, &response); err != nil {
-			logrus.WithFields(fields).WithError(err).
-				Error("error decoding json response")
-			return nil, ErrDefaultWebhook
-		}
-		return &response, nil
-	}
-
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, ErrEntryNotFound
-	}
-
-	var errResponse *ErrorDefaultResponse
-	if err := json.Unmarshal(respBody, &errResponse); err != nil {
-		logrus.WithFields(fields).WithError(err).
-			Error("error decoding json response")
-		return nil, ErrDefaultWebhook
-	}
-
-	if errResponse.Error != nil {
-		err := FindWebhookError(*errResponse.Error.ErrorCode, &resp.StatusCode)
-		logrus.WithField("celcoin_error", errResponse.Error).
-			WithFields(fields).WithError(err).
-			Error("celcoin create subscription error")
-		return nil, err
-	}
-
-	return nil, ErrDefaultWebhook
 
+	return execute[WebhookSubscriptionResponse](ctx, s.httpClient, s.session, apiRequest{
+		method:     http.MethodPost,
+		path:       WebhookPath,
+		pathParams: []string{"subscription"},
+		body:       req,
+		header:     jsonHeader,
+		success:    webhookSuccessStatus,
+		notFound:   ErrEntryNotFound,
+		errors:     webhookErrors,
+		fields:     fields,
+	})
 }
 
 // CreateSubscriptionDda faz a chamada à API para cadastrar um webhook dda
-func (s *WebhooksService) CreateSubscriptionDda(ctx context.Context, req WebhookSubscriptionDdaRequest) (*WebhookSubscriptionDdaResponse, error) {
-	fields := logrus.Fields{
+func (s *WebhooksService) CreateSubscriptionDda(ctx context.Context, req WebhookSubscriptionDdaRequest) (_ *WebhookSubscriptionDdaResponse, err error) {
+	ctx, op := s.session.startOperation(ctx, "webhooks", "CreateSubscriptionDda", WebhookDdaPath)
+	defer op.end(&err)
+	logger := op.logger
+	fields := Fields{
 		"request": req,
 	}
-	logrus.
+	logger.
 		WithFields(fields).
 		Info("Create Subscription")
 
-	err := grok.Validator.Struct(req)
+	err = grok.Validator.Struct(req)
 	if err != nil {
-		logrus.
+		logger.
 			WithFields(fields).
 			WithError(err).
 			Error("error validating model")
 		return nil, grok.FromValidationErros(err)
 	}
-	endpoint, err := s.buildEndpoint(WebhookDdaPath, nil, "register")
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error building endpoint for CreateSubscriptionDda")
-		return nil, err
-	}
-
-	logrus.WithField("endpoint", *endpoint).Info("Calling CreateSubscriptionDda")
-
-	payload, err := json.Marshal(req)
-	if err != nil {
-		logrus.
-			WithFields(fields).
-			WithError(err).
-			Error("error serializing request")
-
-		return nil, fmt.Errorf("error serializing request: %v", err)
-	}
-	httpReq, err := http.NewRequestWithContext(ctx, "POST", *endpoint, bytes.NewReader(payload))
-	if err != nil {
-		logrus.
-			WithFields(fields).
-			WithError(err).
-			Error("error creating http request")
-		return nil, fmt.Errorf("error creating http request: %v", err)
-	}
-
-	httpReq.Header.Add("Content-Type", "application/json")
-	//httpReq.Header.Add("api-version", s.session.APIVersion)
-
-	resp, err := s.httpClient.Do(httpReq)
-	if err != nil {
-		logrus.
-			WithFields(fields).
-			WithError(err).
-			Error("error http client")
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	respBody, _ := ioutil.ReadAll(resp.Body)
-
-	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusAccepted || resp.StatusCode == http.StatusCreated {
-		var response WebhookSubscriptionDdaResponse
-		if err := json.Unmarshal(respBody, &response); err != nil {
-			logrus.WithFields(fields).WithError(err).
-				Error("error decoding json response")
-			return nil, ErrDefaultWebhook
-		}
-		return &response, nil
-	}
-
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, ErrEntryNotFound
-	}
-
-	var errResponse *ErrorDefaultResponse
-	if err := json.Unmarshal(respBody, &errResponse); err != nil {
-		logrus.WithFields(fields).WithError(err).
-			Error("error decoding json response")
-		return nil, ErrDefaultWebhook
-	}
-
-	if errResponse.Error != nil {
-		err := FindWebhookError(*errResponse.Error.ErrorCode, &resp.StatusCode)
-		logrus.WithField("celcoin_error", errResponse.Error).
-			WithFields(fields).WithError(err).
-			Error("celcoin create subscription error")
-		return nil, err
-	}
-
-	return nil, ErrDefaultWebhook
 
+	return execute[WebhookSubscriptionDdaResponse](ctx, s.httpClient, s.session, apiRequest{
+		method:     http.MethodPost,
+		path:       WebhookDdaPath,
+		pathParams: []string{"register"},
+		body:       req,
+		header:     jsonHeader,
+		notFound:   ErrEntryNotFound,
+		errors:     webhookErrors,
+		fields:     fields,
+	})
 }
 
 // GetSubscriptions faz a chamada à API para consultar os webhooks cadastrados
-func (s *WebhooksService) GetSubscriptions(ctx context.Context, entity string, active *bool) (*WebhookQueryResponse, error) {
-	fields := logrus.Fields{
+func (s *WebhooksService) GetSubscriptions(ctx context.Context, entity string, active *bool) (_ *WebhookQueryResponse, err error) {
+	ctx, op := s.session.startOperation(ctx, "webhooks", "GetSubscriptions", WebhookPath)
+	defer op.end(&err)
+	logger := op.logger
+	fields := Fields{
 		"entity": entity,
 		"active": active,
 	}
-	logrus.WithFields(fields).Info("Get Subscription")
+	logger.WithFields(fields).Info("Get Subscription")
 
 	// Configuração dos parâmetros da query string
-	queryParams := map[string]string{}
-	if entity != "" {
-		queryParams["entity"] = entity
+	queryParams := map[string]string{
+		"entity": entity,
 	}
 	if active != nil {
 		queryParams["active"] = fmt.Sprintf("%t", *active)
 	}
 
-	// Construção do endpoint com o método buildEndpoint
-	endpoint, err := s.buildEndpoint(WebhookPath, queryParams, "subscription")
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error building endpoint")
-		return nil, err
-	}
-
-	logrus.WithField("endpoint", *endpoint).Info("Endpoint built successfully")
-
-	httpReq, err := http.NewRequest("GET", *endpoint, nil)
-	if err != nil {
-		logrus.
-			WithFields(fields).
-			WithError(err).
-			Error("error creating http request")
-		return nil, fmt.Errorf("error creating http request: %v", err)
-	}
-
-	httpReq.Header.Add("api-version", s.session.APIVersion)
-
-	resp, err := s.httpClient.Do(httpReq)
-	if err != nil {
-		logrus.
-			WithFields(fields).
-			WithError(err).
-			Error("error http client")
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	respBody, _ := ioutil.ReadAll(resp.Body)
-
-	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusAccepted {
-		var response WebhookQueryResponse
-		if err := json.Unmarshal(respBody, &response); err != nil {
-			logrus.WithFields(fields).WithError(err).
-				Error("error decoding json response")
-			return nil, ErrDefaultWebhook
-		}
-		return &response, nil
-	}
-
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, ErrEntryNotFound
-	}
-
-	var errResponse *ErrorDefaultResponse
-	if err := json.Unmarshal(respBody, &errResponse); err != nil {
-		logrus.WithFields(fields).WithError(err).
-			Error("error decoding json response")
-		return nil, ErrDefaultWebhook
-	}
-
-	if errResponse.Error != nil {
-		err := FindWebhookError(*errResponse.Error.ErrorCode, &resp.StatusCode)
-		logrus.WithField("celcoin_error", errResponse.Error).
-			WithFields(fields).WithError(err).
-			Error("celcoin get balance error")
-		return nil, err
-	}
-
-	return nil, ErrDefaultWebhook
+	return execute[WebhookQueryResponse](ctx, s.httpClient, s.session, apiRequest{
+		method:     http.MethodGet,
+		path:       WebhookPath,
+		pathParams: []string{"subscription"},
+		query:      queryParams,
+		header:     s.apiVersionHeader(),
+		success:    webhookSuccessStatus,
+		notFound:   ErrEntryNotFound,
+		errors:     webhookErrors,
+		fields:     fields,
+	})
 }
 
 // UpdateSubscription faz a chamada à API para atualizar um webhook existente
-func (s *WebhooksService) UpdateSubscription(ctx context.Context, entity string, req WebhookUpdateRequest) (*WebhookUpdateResponse, error) {
-	fields := logrus.Fields{
+func (s *WebhooksService) UpdateSubscription(ctx context.Context, entity string, req WebhookUpdateRequest) (_ *WebhookUpdateResponse, err error) {
+	ctx, op := s.session.startOperation(ctx, "webhooks", "UpdateSubscription", WebhookPath)
+	defer op.end(&err)
+	logger := op.logger
+	fields := Fields{
 		"entity":  entity,
 		"request": req,
 	}
-	logrus.WithFields(fields).Info("Update Subscription")
+	logger.WithFields(fields).Info("Update Subscription")
 
 	// Validação do modelo de requisição
-	err := grok.Validator.Struct(req)
+	err = grok.Validator.Struct(req)
 	if err != nil {
-		logrus.
+		logger.
 			WithFields(fields).
 			WithError(err).
 			Error("Error validating model")
 		return nil, grok.FromValidationErros(err)
 	}
 
-	// Construção do endpoint com buildEndpoint
-	endpoint, err := s.buildEndpoint(WebhookPath, nil, "subscription", entity)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error building endpoint")
-		return nil, err
-	}
-
-	logrus.WithField("endpoint", *endpoint).Info("Endpoint built successfully")
-
-	payload, err := json.Marshal(req)
-	if err != nil {
-		logrus.
-			WithFields(fields).
-			WithError(err).
-			Error("error serializing request")
-		return nil, fmt.Errorf("error serializing request: %v", err)
-	}
-
-	httpReq, err := http.NewRequest("PUT", *endpoint, bytes.NewBuffer(payload))
-	if err != nil {
-		return nil, fmt.Errorf("erro ao criar a requisição HTTP: %v", err)
-	}
-
-	httpReq.Header.Add("api-version", s.session.APIVersion)
-
-	resp, err := s.httpClient.Do(httpReq)
-	if err != nil {
-		logrus.
-			WithFields(fields).
-			WithError(err).
-			Error("error http client")
-		return nil, err
-	}
-	defer resp.Body.Close()
-	respBody, _ := ioutil.ReadAll(resp.Body)
-
-	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusAccepted {
-		var response WebhookUpdateResponse
-		if err := json.Unmarshal(respBody, &response); err != nil {
-			logrus.WithFields(fields).WithError(err).
-				Error("error decoding json response")
-			return nil, ErrDefaultWebhook
-		}
-		return &response, nil
-	}
-
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, ErrEntryNotFound
-	}
-
-	var errResponse *ErrorDefaultResponse
-	if err := json.Unmarshal(respBody, &errResponse); err != nil {
-		logrus.WithFields(fields).WithError(err).
-			Error("error decoding json response")
-		return nil, ErrDefaultWebhook
-	}
-
-	if errResponse.Error != nil {
-		err := FindWebhookError(*errResponse.Error.ErrorCode, &resp.StatusCode)
-		logrus.WithField("celcoin_error", errResponse.Error).
-			WithFields(fields).WithError(err).
-			Error("celcoin create subscription error")
-		return nil, err
-	}
-
-	return nil, ErrDefaultWebhook
+	return execute[WebhookUpdateResponse](ctx, s.httpClient, s.session, apiRequest{
+		method:     http.MethodPut,
+		path:       WebhookPath,
+		pathParams: []string{"subscription", entity},
+		body:       req,
+		header:     s.apiVersionHeader(),
+		success:    webhookSuccessStatus,
+		notFound:   ErrEntryNotFound,
+		errors:     webhookErrors,
+		fields:     fields,
+	})
 }
 
 // DeleteSubscription faz a chamada à API para excluir um webhook existente
-func (s *WebhooksService) DeleteSubscription(ctx context.Context, entity string, subscriptionID string) (*WebhookDeleteResponse, error) {
-	fields := logrus.Fields{
+func (s *WebhooksService) DeleteSubscription(ctx context.Context, entity string, subscriptionID string) (_ *WebhookDeleteResponse, err error) {
+	ctx, op := s.session.startOperation(ctx, "webhooks", "DeleteSubscription", WebhookPath)
+	defer op.end(&err)
+	logger := op.logger
+	fields := Fields{
 		"entity":          entity,
 		"subscription_id": subscriptionID,
 	}
-	logrus.WithFields(fields).Info("Delete Subscription")
-
-	// Parâmetros de consulta (query params)
-	queryParams := map[string]string{
-		"SubscriptionId": subscriptionID,
-	}
-
-	// Construção do endpoint com buildEndpoint
-	endpoint, err := s.buildEndpoint(WebhookPath, queryParams, "subscription", entity)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error building endpoint")
-		return nil, err
-	}
-
-	logrus.WithField("endpoint", *endpoint).Info("Endpoint built successfully")
-
-	httpReq, err := http.NewRequest("DELETE", *endpoint, nil)
-	if err != nil {
-		logrus.
-			WithFields(fields).
-			WithError(err).
-			Error("error creating http request")
-		return nil, fmt.Errorf("error creating http request: %v", err)
-	}
-
-	httpReq.Header.Add("api-version", s.session.APIVersion)
-
-	resp, err := s.httpClient.Do(httpReq)
-	if err != nil {
-		logrus.
-			WithFields(fields).
-			WithError(err).
-			Error("error http client")
-		return nil, err
-	}
-	defer resp.Body.Close()
-	respBody, _ := ioutil.ReadAll(resp.Body)
-
-	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusAccepted {
-		var response WebhookDeleteResponse
-		if err := json.Unmarshal(respBody, &response); err != nil {
-			logrus.WithFields(fields).WithError(err).
-				Error("error decoding json response")
-			return nil, ErrDefaultWebhook
-		}
-		return &response, nil
-	}
-
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, ErrEntryNotFound
-	}
-
-	var errResponse *ErrorDefaultResponse
-	if err := json.Unmarshal(respBody, &errResponse); err != nil {
-		logrus.WithFields(fields).WithError(err).
-			Error("error decoding json response")
-		return nil, ErrDefaultWebhook
-	}
-
-	if errResponse.Error != nil {
-		err := FindWebhookError(*errResponse.Error.ErrorCode, &resp.StatusCode)
-		logrus.WithField("celcoin_error", errResponse.Error).
-			WithFields(fields).WithError(err).
-			Error("celcoin create subscription error")
-		return nil, err
-	}
-
-	return nil, ErrDefaultWebhook
-
+	logger.WithFields(fields).Info("Delete Subscription")
+
+	return execute[WebhookDeleteResponse](ctx, s.httpClient, s.session, apiRequest{
+		method:     http.MethodDelete,
+		path:       WebhookPath,
+		pathParams: []string{"subscription", entity},
+		query:      map[string]string{"SubscriptionId": subscriptionID},
+		header:     s.apiVersionHeader(),
+		success:    webhookSuccessStatus,
+		notFound:   ErrEntryNotFound,
+		errors:     webhookErrors,
+		fields:     fields,
+	})
 }
 
 // GetWebhookReplayCount realiza a consulta de quantidade de webhooks enviados
-func (s *WebhooksService) GetWebhookReplayCount(ctx context.Context, entity, dateFrom, dateTo string, optionalParams map[string]string) (*WebhookReplayResponse, error) {
-	fields := logrus.Fields{
+func (s *WebhooksService) GetWebhookReplayCount(ctx context.Context, entity, dateFrom, dateTo string, optionalParams map[string]string) (_ *WebhookReplayResponse, err error) {
+	ctx, op := s.session.startOperation(ctx, "webhooks", "GetWebhookReplayCount", WebhookPath)
+	defer op.end(&err)
+	logger := op.logger
+	fields := Fields{
 		"entity":          entity,
 		"date_from":       dateFrom,
 		"date_to":         dateTo,
 		"optional_params": optionalParams,
 	}
-	logrus.WithFields(fields).Info("Get Webhook Replay Count")
+	logger.WithFields(fields).Info("Get Webhook Replay Count")
 
 	// Validação dos parâmetros obrigatórios
 	if entity == "" || dateFrom == "" || dateTo == "" {
-		logrus.
+		logger.
 			WithFields(fields).
 			Error("the parameters (entity, dateFrom, dateTo) must be provided")
 		return nil, errors.New("the parameters (entity, dateFrom, dateTo) must be provided")
@@ -521,339 +235,133 @@ func (s *WebhooksService) GetWebhookReplayCount(ctx context.Context, entity, dat
 		"DateFrom": url.QueryEscape(dateFrom),
 		"DateTo":   url.QueryEscape(dateTo),
 	}
-
-	// Inclui parâmetros opcionais, se presentes
 	for key, value := range optionalParams {
 		if value != "" {
 			queryParams[key] = value
 		}
 	}
 
-	// Construção do endpoint utilizando buildEndpoint
-	endpoint, err := s.buildEndpoint(WebhookPath, queryParams, "replay", entity)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error building endpoint")
-		return nil, err
-	}
-
-	logrus.WithField("endpoint", *endpoint).Info("Endpoint built successfully")
-
-	httpReq, err := http.NewRequest("GET", *endpoint, nil)
-	if err != nil {
-		logrus.
-			WithFields(fields).
-			WithError(err).
-			Error("error creating http request")
-		return nil, fmt.Errorf("error creating http request: %v", err)
-	}
-
-	httpReq.Header.Add("api-version", s.session.APIVersion)
-
-	resp, err := s.httpClient.Do(httpReq)
-	if err != nil {
-		logrus.
-			WithFields(fields).
-			WithError(err).
-			Error("error http client")
-		return nil, err
-	}
-	defer resp.Body.Close()
-	respBody, _ := ioutil.ReadAll(resp.Body)
-
-	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusAccepted {
-		var response WebhookReplayResponse
-		if err := json.Unmarshal(respBody, &response); err != nil {
-			logrus.WithFields(fields).WithError(err).
-				Error("error decoding json response")
-			return nil, ErrDefaultWebhook
-		}
-		return &response, nil
-	}
-
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, ErrEntryNotFound
-	}
-
-	var errResponse *ErrorDefaultResponse
-	if err := json.Unmarshal(respBody, &errResponse); err != nil {
-		logrus.WithFields(fields).WithError(err).
-			Error("error decoding json response")
-		return nil, ErrDefaultWebhook
-	}
-
-	if errResponse.Error != nil {
-		err := FindWebhookError(*errResponse.Error.ErrorCode, &resp.StatusCode)
-		logrus.WithField("celcoin_error", errResponse.Error).
-			WithFields(fields).WithError(err).
-			Error("celcoin create subscription error")
-		return nil, err
-	}
-
-	return nil, ErrDefaultWebhook
+	return execute[WebhookReplayResponse](ctx, s.httpClient, s.session, apiRequest{
+		method:     http.MethodGet,
+		path:       WebhookPath,
+		pathParams: []string{"replay", entity},
+		query:      queryParams,
+		header:     s.apiVersionHeader(),
+		success:    webhookSuccessStatus,
+		notFound:   ErrEntryNotFound,
+		errors:     webhookErrors,
+		fields:     fields,
+	})
 }
 
 // GetWebhookReplay realiza a consulta para recuperar os detalhes dos webhooks enviados
-func (s *WebhooksService) GetWebhookReplay(ctx context.Context, entity, dateFrom, dateTo string, onlyPending bool) (*WebhookReplayResponse, error) {
-	fields := logrus.Fields{
+func (s *WebhooksService) GetWebhookReplay(ctx context.Context, entity, dateFrom, dateTo string, onlyPending bool) (_ *WebhookReplayResponse, err error) {
+	ctx, op := s.session.startOperation(ctx, "webhooks", "GetWebhookReplay", WebhookPath)
+	defer op.end(&err)
+	logger := op.logger
+	fields := Fields{
 		"entity":       entity,
 		"date_from":    dateFrom,
 		"date_to":      dateTo,
 		"only_pending": onlyPending,
 	}
-	logrus.WithFields(fields).Info("Get Webhook Replay")
+	logger.WithFields(fields).Info("Get Webhook Replay")
 
 	// Validação dos parâmetros obrigatórios
 	if entity == "" || dateFrom == "" || dateTo == "" {
-		logrus.
+		logger.
 			WithFields(fields).
 			Error("the parameters (entity, dateFrom, dateTo) must be provided")
 		return nil, errors.New("the parameters (entity, dateFrom, dateTo) must be provided")
 	}
 
-	// Construção dos parâmetros de consulta
-	queryParams := map[string]string{
-		"DateFrom":    url.QueryEscape(dateFrom),
-		"DateTo":      url.QueryEscape(dateTo),
-		"OnlyPending": fmt.Sprintf("%t", onlyPending),
-	}
-
-	// Construção do endpoint usando buildEndpoint
-	endpoint, err := s.buildEndpoint(WebhookPath, queryParams, "replay", entity)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error building endpoint")
-		return nil, err
-	}
-
-	logrus.WithField("endpoint", *endpoint).Info("Endpoint built successfully")
-
-	httpReq, err := http.NewRequest("GET", *endpoint, nil)
-	if err != nil {
-		logrus.
-			WithFields(fields).
-			WithError(err).
-			Error("error creating http request")
-		return nil, fmt.Errorf("error creating http request: %v", err)
-	}
-
-	httpReq.Header.Add("api-version", s.session.APIVersion)
-
-	resp, err := s.httpClient.Do(httpReq)
-	if err != nil {
-		logrus.
-			WithFields(fields).
-			WithError(err).
-			Error("error http client")
-		return nil, err
-	}
-
-	defer resp.Body.Close()
-
-	respBody, _ := ioutil.ReadAll(resp.Body)
-
-	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusAccepted {
-		var response WebhookReplayResponse
-		if err := json.Unmarshal(respBody, &response); err != nil {
-			logrus.WithFields(fields).WithError(err).
-				Error("error decoding json response")
-			return nil, ErrDefaultWebhook
-		}
-		return &response, nil
-	}
-
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, ErrEntryNotFound
-	}
-
-	var errResponse *ErrorDefaultResponse
-	if err := json.Unmarshal(respBody, &errResponse); err != nil {
-		logrus.WithFields(fields).WithError(err).
-			Error("error decoding json response")
-		return nil, ErrDefaultWebhook
-	}
-
-	if errResponse.Error != nil {
-		err := FindWebhookError(*errResponse.Error.ErrorCode, &resp.StatusCode)
-		logrus.WithField("celcoin_error", errResponse.Error).
-			WithFields(fields).WithError(err).
-			Error("celcoin create subscription error")
-		return nil, err
-	}
-
-	return nil, ErrDefaultWebhook
+	return execute[WebhookReplayResponse](ctx, s.httpClient, s.session, apiRequest{
+		method:     http.MethodGet,
+		path:       WebhookPath,
+		pathParams: []string{"replay", entity},
+		query: map[string]string{
+			"DateFrom":    url.QueryEscape(dateFrom),
+			"DateTo":      url.QueryEscape(dateTo),
+			"OnlyPending": fmt.Sprintf("%t", onlyPending),
+		},
+		header:   s.apiVersionHeader(),
+		success:  webhookSuccessStatus,
+		notFound: ErrEntryNotFound,
+		errors:   webhookErrors,
+		fields:   fields,
+	})
 }
 
 // GetWebhookReplaySendCount realiza a consulta para recuperar a quantidade de webhooks enviados
-func (s *WebhooksService) GetWebhookReplaySendCount(ctx context.Context, entity, dateFrom, dateTo string) (*WebhookReplayCountResponse, error) {
-	fields := logrus.Fields{
+func (s *WebhooksService) GetWebhookReplaySendCount(ctx context.Context, entity, dateFrom, dateTo string) (_ *WebhookReplayCountResponse, err error) {
+	ctx, op := s.session.startOperation(ctx, "webhooks", "GetWebhookReplaySendCount", WebhookPath)
+	defer op.end(&err)
+	logger := op.logger
+	fields := Fields{
 		"entity":    entity,
 		"date_from": dateFrom,
 		"date_to":   dateTo,
 	}
-	logrus.WithFields(fields).Info("Get Webhook Replay Send Count")
+	logger.WithFields(fields).Info("Get Webhook Replay Send Count")
 
 	// Validação dos parâmetros obrigatórios
 	if entity == "" || dateFrom == "" || dateTo == "" {
-		logrus.
+		logger.
 			WithFields(fields).
 			Error("the parameters (entity, dateFrom, dateTo) must be provided")
 		return nil, errors.New("the parameters (entity, dateFrom, dateTo) must be provided")
 	}
 
-	// Construção dos parâmetros de consulta
-	queryParams := map[string]string{
-		"DateFrom": url.QueryEscape(dateFrom),
-		"DateTo":   url.QueryEscape(dateTo),
-	}
-
-	// Construção do endpoint usando buildEndpoint
-	endpoint, err := s.buildEndpoint(WebhookPath, queryParams, "replay", entity, "count")
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error building endpoint")
-		return nil, err
-	}
-
-	logrus.WithField("endpoint", *endpoint).Info("Endpoint built successfully")
-
-	httpReq, err := http.NewRequest("GET", *endpoint, nil)
-	if err != nil {
-		logrus.
-			WithFields(fields).
-			WithError(err).
-			Error("error creating http request")
-		return nil, fmt.Errorf("error creating http request: %v", err)
-	}
-
-	httpReq.Header.Add("api-version", s.session.APIVersion)
-
-	resp, err := s.httpClient.Do(httpReq)
-	if err != nil {
-		logrus.
-			WithFields(fields).
-			WithError(err).
-			Error("error http client")
-		return nil, err
-	}
-
-	defer resp.Body.Close()
-	respBody, _ := ioutil.ReadAll(resp.Body)
-
-	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusAccepted {
-		var response WebhookReplayCountResponse
-		if err := json.Unmarshal(respBody, &response); err != nil {
-			logrus.WithFields(fields).WithError(err).
-				Error("error decoding json response")
-			return nil, ErrDefaultWebhook
-		}
-		return &response, nil
-	}
-
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, ErrEntryNotFound
-	}
-
-	var errResponse *ErrorDefaultResponse
-	if err := json.Unmarshal(respBody, &errResponse); err != nil {
-		logrus.WithFields(fields).WithError(err).
-			Error("error decoding json response")
-		return nil, ErrDefaultWebhook
-	}
-
-	if errResponse.Error != nil {
-		err := FindWebhookError(*errResponse.Error.ErrorCode, &resp.StatusCode)
-		logrus.WithField("celcoin_error", errResponse.Error).
-			WithFields(fields).WithError(err).
-			Error("celcoin create subscription error")
-		return nil, err
-	}
-
-	return nil, ErrDefaultWebhook
+	return execute[WebhookReplayCountResponse](ctx, s.httpClient, s.session, apiRequest{
+		method:     http.MethodGet,
+		path:       WebhookPath,
+		pathParams: []string{"replay", entity, "count"},
+		query: map[string]string{
+			"DateFrom": url.QueryEscape(dateFrom),
+			"DateTo":   url.QueryEscape(dateTo),
+		},
+		header:   s.apiVersionHeader(),
+		success:  webhookSuccessStatus,
+		notFound: ErrEntryNotFound,
+		errors:   webhookErrors,
+		fields:   fields,
+	})
 }
 
 // ReplayMessageFromWebhook reenvia o webhook com base nos parâmetros fornecidos
-func (s *WebhooksService) ReplayMessageFromWebhook(ctx context.Context, entity, webhookID, dateFrom, dateTo string, onlyPending bool, filter WebhookReplayRequest) (*WebhookReplayResponse, error) {
-	fields := logrus.Fields{
+func (s *WebhooksService) ReplayMessageFromWebhook(ctx context.Context, entity, webhookID, dateFrom, dateTo string, onlyPending bool, filter WebhookReplayRequest) (_ *WebhookReplayResponse, err error) {
+	ctx, op := s.session.startOperation(ctx, "webhooks", "ReplayMessageFromWebhook", WebhookPath)
+	defer op.end(&err)
+	logger := op.logger
+	fields := Fields{
 		"entity":       entity,
 		"webhook_id":   webhookID,
 		"date_from":    dateFrom,
 		"date_to":      dateTo,
 		"only_pending": onlyPending,
 	}
-	logrus.WithFields(fields).Info("Replay Message from Webhook")
+	logger.WithFields(fields).Info("Replay Message from Webhook")
 
 	// Validação dos parâmetros obrigatórios
 	if entity == "" || webhookID == "" {
-		logrus.WithFields(fields).Error("the parameters (entity, webhookID) must be provided")
+		logger.WithFields(fields).Error("the parameters (entity, webhookID) must be provided")
 		return nil, errors.New("the parameters (entity, webhookID) must be provided")
 	}
 
-	// Criação dos parâmetros de consulta
-	queryParams := map[string]string{
-		"webhookId":   webhookID,
-		"DateFrom":    url.QueryEscape(dateFrom),
-		"DateTo":      url.QueryEscape(dateTo),
-		"OnlyPending": fmt.Sprintf("%t", onlyPending),
-	}
-
-	// Construção do endpoint utilizando buildEndpoint
-	endpoint, err := s.buildEndpoint(WebhookPath, queryParams, "replay", entity)
-	if err != nil {
-		logrus.WithFields(fields).WithError(err).Error("Error building endpoint")
-		return nil, err
-	}
-
-	logrus.WithField("endpoint", *endpoint).Info("Endpoint built successfully")
-
-	httpReq, err := http.NewRequest("PUT", *endpoint, nil)
-	if err != nil {
-		logrus.
-			WithFields(fields).
-			WithError(err).
-			Error("error creating http request")
-		return nil, fmt.Errorf("error creating http request: %v", err)
-	}
-
-	httpReq.Header.Add("api-version", s.session.APIVersion)
-
-	resp, err := s.httpClient.Do(httpReq)
-	if err != nil {
-		logrus.
-			WithFields(fields).
-			WithError(err).
-			Error("error http client")
-		return nil, err
-	}
-
-	defer resp.Body.Close()
-	respBody, _ := ioutil.ReadAll(resp.Body)
-
-	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusAccepted {
-		var response WebhookReplayResponse
-		if err := json.Unmarshal(respBody, &response); err != nil {
-			logrus.WithFields(fields).WithError(err).
-				Error("error decoding json response")
-			return nil, ErrDefaultWebhook
-		}
-		return &response, nil
-	}
-
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, ErrEntryNotFound
-	}
-
-	var errResponse *ErrorDefaultResponse
-	if err := json.Unmarshal(respBody, &errResponse); err != nil {
-		logrus.WithFields(fields).WithError(err).
-			Error("error decoding json response")
-		return nil, ErrDefaultWebhook
-	}
-
-	if errResponse.Error != nil {
-		err := FindWebhookError(*errResponse.Error.ErrorCode, &resp.StatusCode)
-		logrus.WithField("celcoin_error", errResponse.Error).
-			WithFields(fields).WithError(err).
-			Error("celcoin create subscription error")
-		return nil, err
-	}
-
-	return nil, ErrDefaultWebhook
+	return execute[WebhookReplayResponse](ctx, s.httpClient, s.session, apiRequest{
+		method:     http.MethodPut,
+		path:       WebhookPath,
+		pathParams: []string{"replay", entity},
+		query: map[string]string{
+			"webhookId":   webhookID,
+			"DateFrom":    url.QueryEscape(dateFrom),
+			"DateTo":      url.QueryEscape(dateTo),
+			"OnlyPending": fmt.Sprintf("%t", onlyPending),
+		},
+		header:   s.apiVersionHeader(),
+		success:  webhookSuccessStatus,
+		notFound: ErrEntryNotFound,
+		errors:   webhookErrors,
+		fields:   fields,
+	})
 }
@@ -8,7 +8,9 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/contbank/celcoin-sdk"
 	"github.com/jarcoal/httpmock"
@@ -176,3 +178,119 @@ func (s *WebhookTestSuite) TestCreateSubscriptionInvalidRequest() {
 	s.assert.Error(err, "validation failed for entity")
 	s.assert.Nil(response, "esperava uma resposta nula em caso de erro")
 }
+
+// mockServerClient inicia um MockServer e um Client apontando para ele.
+func (s *WebhookTestSuite) mockServerClient() (*celcoin.MockServer, *celcoin.Client) {
+	server := celcoin.NewMockServer()
+	s.T().Cleanup(server.Close)
+
+	client, err := celcoin.NewClient(server.Config())
+	s.Require().NoError(err)
+	return server, client
+}
+
+// TestCreateSubscriptionEmptyServiceUnavailable testa um 503 sem corpo na criação da assinatura.
+func (s *WebhookTestSuite) TestCreateSubscriptionEmptyServiceUnavailable() {
+	server, client := s.mockServerClient()
+	server.Fault(http.MethodPost, celcoin.WebhookPath+"/subscription",
+		celcoin.MockEmptyResponse(http.StatusServiceUnavailable)).Times(1)
+
+	request := celcoin.WebhookSubscriptionRequest{
+		Entity:     celcoin.MockEntityPixPaymentIn,
+		WebhookURL: "https://example.com/webhooks",
+		Auth:       celcoin.WebhookAuth{Login: "hook", Password: "secret", Type: "basic"},
+	}
+
+	// POST sem chave de idempotência não é repetido pelo SDK
+	var meta celcoin.ResponseMeta
+	response, err := client.Webhooks.CreateSubscription(celcoin.WithResponseMeta(s.ctx, &meta), request)
+	s.Require().Error(err)
+	s.assert.Nil(response)
+	s.Require().NotNil(meta.Error)
+	s.assert.Equal(celcoin.ErrorClassRetryable, meta.Error.Class)
+
+	response, err = client.Webhooks.CreateSubscription(s.ctx, request)
+	s.Require().NoError(err)
+	s.assert.NotEmpty(response.Body.SubscriptionId)
+
+	requests := server.ReceivedRequests(http.MethodPost, celcoin.WebhookPath+"/subscription")
+	s.Require().Len(requests, 2)
+	s.assert.Equal(http.StatusServiceUnavailable, requests[0].Status)
+	s.assert.True(strings.HasPrefix(requests[0].Header.Get("Authorization"), "Bearer "))
+
+	var sent celcoin.WebhookSubscriptionRequest
+	s.Require().NoError(requests[1].Decode(&sent))
+	s.assert.Equal(request.Entity, sent.Entity)
+}
+
+// TestGetSubscriptionsLatency testa a desistência do cliente diante da latência da Celcoin.
+func (s *WebhookTestSuite) TestGetSubscriptionsLatency() {
+	server, client := s.mockServerClient()
+	server.Fault(http.MethodGet, celcoin.WebhookPath+"/subscription", celcoin.MockLatency(time.Second))
+
+	ctx, cancel := context.WithTimeout(s.ctx, 100*time.Millisecond)
+	defer cancel()
+	response, err := client.Webhooks.GetSubscriptions(ctx, celcoin.MockEntityPixPaymentIn, nil)
+	s.Require().Error(err)
+	s.assert.Nil(response)
+
+	requests := server.ReceivedRequests(http.MethodGet, celcoin.WebhookPath+"/subscription")
+	s.Require().Len(requests, 1)
+	s.assert.Zero(requests[0].Status)
+}
+
+// TestWebhookDeliveredAfterSettlement testa o webhook gerado apenas na transição para CONFIRMED.
+func (s *WebhookTestSuite) TestWebhookDeliveredAfterSettlement() {
+	server, client := s.mockServerClient()
+	server.SetSettlementDelay(200 * time.Millisecond)
+	payer := server.AddAccount(celcoin.MockAccount{DocumentNumber: "12345678909", Name: "Maria Pagadora", Balance: 100})
+
+	var mutex sync.Mutex
+	var received []celcoin.MockWebhookEvent
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var event celcoin.MockWebhookEvent
+		body, _ := io.ReadAll(r.Body)
+		s.assert.NoError(json.Unmarshal(body, &event))
+		mutex.Lock()
+		received = append(received, event)
+		mutex.Unlock()
+	}))
+	defer receiver.Close()
+
+	_, err := client.Webhooks.CreateSubscription(s.ctx, celcoin.WebhookSubscriptionRequest{
+		Entity:     celcoin.MockEntitySpbTransferOut,
+		WebhookURL: receiver.URL,
+		Auth:       celcoin.WebhookAuth{Login: "hook", Password: "secret", Type: "basic"},
+	})
+	s.Require().NoError(err)
+
+	transfer, err := client.Transfers.CreateTransfer(s.ctx, "ted-1", celcoin.TransfersRequest{
+		Amount:     40,
+		ClientCode: "ted-1",
+		DebitParty: celcoin.TransfersDebitPartyRequest{AccountNumber: payer.Account, BankISPB: celcoin.CelcoinBankISPB},
+		CreditParty: celcoin.TransfersCreditPartyRequest{
+			BankISPB:      "60701190",
+			AccountNumber: "123456",
+			AccountBranch: "0001",
+			Identifier:    "98765432100",
+			AccountName:   "Joao Recebedor",
+			AccountType:   "CC",
+			PersonType:    celcoin.NaturalPersonType,
+		},
+		ClientFinality: celcoin.TransfersBetweenSameOwnershipClientFinality,
+	})
+	s.Require().NoError(err)
+	s.assert.Equal("PROCESSING", transfer.Status)
+	s.assert.Empty(server.WebhookEvents(celcoin.MockEntitySpbTransferOut))
+
+	s.Eventually(func() bool {
+		mutex.Lock()
+		defer mutex.Unlock()
+		return len(received) == 1
+	}, 2*time.Second, 10*time.Millisecond)
+	s.assert.Equal("CONFIRMED", received[0].Status)
+
+	found, err := client.Transfers.FindTransferByCode(s.ctx, celcoin.String("ted-1"), transfer.Body.ID, "ted-1", nil)
+	s.Require().NoError(err)
+	s.assert.Equal("CONFIRMED", found.Status)
+}